@@ -0,0 +1,150 @@
+package bidi
+
+import (
+	"sort"
+)
+
+// KMaxBracketDepth
+// en: Size of the stack used to pair brackets (BD16)
+//
+// pt_br: Tamanho da pilha usada para emparelhar colchetes (BD16)
+const KMaxBracketDepth = 63
+
+// kBrackets
+// en: Opening bracket of each closing bracket used by rule N0
+//
+// pt_br: Colchete de abertura de cada colchete de fechamento usado pela regra N0
+var kBrackets = map[rune]rune{
+	')': '(', ']': '[', '}': '{',
+	0x0F3B: 0x0F3A, 0x0F3D: 0x0F3C, 0x169C: 0x169B, 0x2046: 0x2045,
+	0x207E: 0x207D, 0x208E: 0x208D, 0x2309: 0x2308, 0x230B: 0x230A,
+	0x232A: 0x2329, 0x2769: 0x2768, 0x276B: 0x276A, 0x276D: 0x276C,
+	0x276F: 0x276E, 0x2771: 0x2770, 0x2773: 0x2772, 0x2775: 0x2774,
+	0x27C6: 0x27C5, 0x27E7: 0x27E6, 0x27E9: 0x27E8, 0x27EB: 0x27EA,
+	0x27ED: 0x27EC, 0x27EF: 0x27EE, 0x2984: 0x2983, 0x2986: 0x2985,
+	0x2988: 0x2987, 0x298A: 0x2989, 0x298C: 0x298B, 0x2990: 0x298F,
+	0x298E: 0x298D, 0x2992: 0x2991, 0x2994: 0x2993, 0x2996: 0x2995,
+	0x2998: 0x2997, 0x29D9: 0x29D8, 0x29DB: 0x29DA, 0x29FD: 0x29FC,
+	0x2E23: 0x2E22, 0x2E25: 0x2E24, 0x2E27: 0x2E26, 0x2E29: 0x2E28,
+	0x3009: 0x3008, 0x300B: 0x300A, 0x300D: 0x300C, 0x300F: 0x300E,
+	0x3011: 0x3010, 0x3015: 0x3014, 0x3017: 0x3016, 0x3019: 0x3018,
+	0x301B: 0x301A, 0xFE5A: 0xFE59, 0xFE5C: 0xFE5B, 0xFE5E: 0xFE5D,
+	0xFF09: 0xFF08, 0xFF3D: 0xFF3B, 0xFF5D: 0xFF5B, 0xFF60: 0xFF5F,
+	0xFF63: 0xFF62,
+}
+
+var kOpeningBrackets map[rune]bool
+
+func init() {
+	kOpeningBrackets = make(map[rune]bool, len(kBrackets))
+	for _, opening := range kBrackets {
+		kOpeningBrackets[opening] = true
+	}
+}
+
+type bracketPair struct {
+	opening int
+	closing int
+}
+
+// brackets
+// en: Resolves paired brackets to the direction of their content or context, rule
+// N0, so that "(" and ")" end up on the same side of the text they enclose
+//
+// pt_br: Resolve colchetes emparelhados para a direção do seu conteúdo ou
+// contexto, regra N0, para que "(" e ")" fiquem do mesmo lado do texto que
+// envolvem
+func (el *sequence) brackets(paragraph *Paragraph) {
+	type opener struct {
+		character rune
+		position  int
+	}
+	stack := make([]opener, 0)
+	pairs := make([]bracketPair, 0)
+
+search:
+	for i, index := range el.indexes {
+		if el.types[i] != KClassON {
+			continue
+		}
+		character := paragraph.runes[index]
+		if kOpeningBrackets[character] {
+			if len(stack) == KMaxBracketDepth {
+				break search
+			}
+			stack = append(stack, opener{character: character, position: i})
+			continue
+		}
+		opening, isClosing := kBrackets[character]
+		if !isClosing {
+			continue
+		}
+		for j := len(stack) - 1; j >= 0; j -= 1 {
+			if stack[j].character == opening {
+				pairs = append(pairs, bracketPair{opening: stack[j].position, closing: i})
+				stack = stack[:j]
+				break
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].opening < pairs[j].opening })
+
+	embedding := directionOfLevel(el.level)
+	for _, pair := range pairs {
+		inside := KClassON
+		for i := pair.opening + 1; i < pair.closing; i += 1 {
+			direction := el.strongAt(i)
+			if direction == KClassON {
+				continue
+			}
+			inside = direction
+			if direction == embedding {
+				break
+			}
+		}
+		if inside == KClassON {
+			continue
+		}
+
+		direction := embedding
+		if inside != embedding {
+			context := el.sos
+			for i := pair.opening - 1; i >= 0; i -= 1 {
+				if strong := el.strongAt(i); strong != KClassON {
+					context = strong
+					break
+				}
+			}
+			if context == inside {
+				direction = inside
+			}
+		}
+		el.setBracket(paragraph, pair.opening, direction)
+		el.setBracket(paragraph, pair.closing, direction)
+	}
+}
+
+// strongAt
+// en: Returns L or R for strong types and numbers, which act as R, or ON
+//
+// pt_br: Retorna L ou R para tipos fortes e números, que agem como R, ou ON
+func (el *sequence) strongAt(i int) Class {
+	switch el.types[i] {
+	case KClassL:
+		return KClassL
+	case KClassR, KClassEN, KClassAN:
+		return KClassR
+	}
+	return KClassON
+}
+
+// setBracket
+// en: Changes the type of a bracket and of the marks that follow it
+//
+// pt_br: Muda o tipo de um colchete e das marcas que o seguem
+func (el *sequence) setBracket(paragraph *Paragraph, position int, direction Class) {
+	el.types[position] = direction
+	for i := position + 1; i < len(el.indexes) && paragraph.classes[el.indexes[i]] == KClassNSM; i += 1 {
+		el.types[i] = direction
+	}
+}
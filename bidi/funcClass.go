@@ -0,0 +1,226 @@
+package bidi
+
+import (
+	"unicode"
+)
+
+// Class
+// en: Bidirectional character type of the Unicode Bidirectional Algorithm (UAX #9)
+//
+// pt_br: Tipo bidirecional de caractere do Algoritmo Bidirecional Unicode (UAX #9)
+type Class int
+
+const (
+	KClassL Class = iota
+	KClassR
+	KClassAL
+	KClassEN
+	KClassES
+	KClassET
+	KClassAN
+	KClassCS
+	KClassNSM
+	KClassBN
+	KClassB
+	KClassS
+	KClassWS
+	KClassON
+	KClassLRE
+	KClassLRO
+	KClassRLE
+	KClassRLO
+	KClassPDF
+	KClassLRI
+	KClassRLI
+	KClassFSI
+	KClassPDI
+)
+
+type classRange struct {
+	low   rune
+	high  rune
+	class Class
+}
+
+// kClassRanges
+// en: Characters whose class can not be derived from the unicode package. The
+// table is searched in order, so exceptions come before the blocks that contain
+// them
+//
+// pt_br: Caracteres cuja classe não pode ser derivada do pacote unicode. A tabela é
+// pesquisada em ordem, então as exceções vêm antes dos blocos que as contêm
+var kClassRanges = []classRange{
+	{0x000A, 0x000A, KClassB},
+	{0x000D, 0x000D, KClassB},
+	{0x001C, 0x001E, KClassB},
+	{0x0085, 0x0085, KClassB},
+	{0x2029, 0x2029, KClassB},
+	{0x0009, 0x0009, KClassS},
+	{0x000B, 0x000B, KClassS},
+	{0x001F, 0x001F, KClassS},
+	{0x000C, 0x000C, KClassWS},
+	{0x0020, 0x0020, KClassWS},
+	{0x1680, 0x1680, KClassWS},
+	{0x2000, 0x200A, KClassWS},
+	{0x2028, 0x2028, KClassWS},
+	{0x205F, 0x205F, KClassWS},
+	{0x3000, 0x3000, KClassWS},
+	{0x202A, 0x202A, KClassLRE},
+	{0x202B, 0x202B, KClassRLE},
+	{0x202C, 0x202C, KClassPDF},
+	{0x202D, 0x202D, KClassLRO},
+	{0x202E, 0x202E, KClassRLO},
+	{0x2066, 0x2066, KClassLRI},
+	{0x2067, 0x2067, KClassRLI},
+	{0x2068, 0x2068, KClassFSI},
+	{0x2069, 0x2069, KClassPDI},
+	{0x200E, 0x200E, KClassL},
+	{0x200F, 0x200F, KClassR},
+	{0x061C, 0x061C, KClassAL},
+	{0x0000, 0x0008, KClassBN},
+	{0x000E, 0x001B, KClassBN},
+	{0x007F, 0x009F, KClassBN},
+	{0x00AD, 0x00AD, KClassBN},
+	{0x180E, 0x180E, KClassBN},
+	{0x200B, 0x200D, KClassBN},
+	{0x2060, 0x2065, KClassBN},
+	{0xFEFF, 0xFEFF, KClassBN},
+	{0x0030, 0x0039, KClassEN},
+	{0x00B2, 0x00B3, KClassEN},
+	{0x00B9, 0x00B9, KClassEN},
+	{0x06F0, 0x06F9, KClassEN},
+	{0x2070, 0x2070, KClassEN},
+	{0x2074, 0x2079, KClassEN},
+	{0x2080, 0x2089, KClassEN},
+	{0x2488, 0x249B, KClassEN},
+	{0xFF10, 0xFF19, KClassEN},
+	{0x002B, 0x002B, KClassES},
+	{0x002D, 0x002D, KClassES},
+	{0x207A, 0x207B, KClassES},
+	{0x208A, 0x208B, KClassES},
+	{0x2212, 0x2212, KClassES},
+	{0xFB29, 0xFB29, KClassES},
+	{0xFE62, 0xFE63, KClassES},
+	{0xFF0B, 0xFF0B, KClassES},
+	{0xFF0D, 0xFF0D, KClassES},
+	{0x0023, 0x0025, KClassET},
+	{0x00A2, 0x00A5, KClassET},
+	{0x00B0, 0x00B1, KClassET},
+	{0x058F, 0x058F, KClassET},
+	{0x0609, 0x060A, KClassET},
+	{0x066A, 0x066A, KClassET},
+	{0x09F2, 0x09F3, KClassET},
+	{0x0E3F, 0x0E3F, KClassET},
+	{0x2030, 0x2034, KClassET},
+	{0x20A0, 0x20CF, KClassET},
+	{0x212E, 0x212E, KClassET},
+	{0x2213, 0x2213, KClassET},
+	{0xFE5F, 0xFE5F, KClassET},
+	{0xFE69, 0xFE6A, KClassET},
+	{0xFF03, 0xFF05, KClassET},
+	{0xFFE0, 0xFFE1, KClassET},
+	{0xFFE5, 0xFFE6, KClassET},
+	{0x002C, 0x002C, KClassCS},
+	{0x002E, 0x002F, KClassCS},
+	{0x003A, 0x003A, KClassCS},
+	{0x00A0, 0x00A0, KClassCS},
+	{0x060C, 0x060C, KClassCS},
+	{0x202F, 0x202F, KClassCS},
+	{0x2044, 0x2044, KClassCS},
+	{0xFE50, 0xFE50, KClassCS},
+	{0xFE52, 0xFE52, KClassCS},
+	{0xFE55, 0xFE55, KClassCS},
+	{0xFF0C, 0xFF0C, KClassCS},
+	{0xFF0E, 0xFF0F, KClassCS},
+	{0xFF1A, 0xFF1A, KClassCS},
+	{0x0600, 0x0605, KClassAN},
+	{0x0660, 0x0669, KClassAN},
+	{0x066B, 0x066C, KClassAN},
+	{0x06DD, 0x06DD, KClassAN},
+	{0x0890, 0x0891, KClassAN},
+	{0x08E2, 0x08E2, KClassAN},
+	{0x10D30, 0x10D39, KClassAN},
+	{0x10E60, 0x10E7E, KClassAN},
+}
+
+// kRightToLeftBlocks
+// en: Blocks of right to left scripts; the marks inside them are NSM
+//
+// pt_br: Blocos de escritas da direita para a esquerda; as marcas dentro deles são
+// NSM
+var kRightToLeftBlocks = []classRange{
+	{0x0590, 0x05FF, KClassR},   // Hebrew
+	{0x0600, 0x07BF, KClassAL},  // Arabic, Syriac, Arabic Supplement, Thaana
+	{0x07C0, 0x085F, KClassR},   // NKo, Samaritan, Mandaic
+	{0x0860, 0x08FF, KClassAL},  // Syriac Supplement, Arabic Extended
+	{0xFB1D, 0xFB4F, KClassR},   // Hebrew presentation forms
+	{0xFB50, 0xFDCF, KClassAL},  // Arabic presentation forms A
+	{0xFDF0, 0xFDFF, KClassAL},  // Arabic presentation forms A
+	{0xFE70, 0xFEFE, KClassAL},  // Arabic presentation forms B
+	{0x10800, 0x10CFF, KClassR}, // Cypriot to Old Hungarian
+	{0x10D00, 0x10D3F, KClassAL},
+	{0x10D40, 0x10EBF, KClassR},
+	{0x10EC0, 0x10EFF, KClassAL},
+	{0x10F00, 0x10F2F, KClassR},
+	{0x10F30, 0x10F6F, KClassAL},
+	{0x10F70, 0x10FFF, KClassR},
+	{0x1E800, 0x1EC6F, KClassR},
+	{0x1EC70, 0x1ECBF, KClassAL},
+	{0x1ECC0, 0x1ECFF, KClassR},
+	{0x1ED00, 0x1ED4F, KClassAL},
+	{0x1ED50, 0x1EDFF, KClassR},
+	{0x1EE00, 0x1EEFF, KClassAL},
+	{0x1EF00, 0x1EFFF, KClassR},
+}
+
+// ClassOf
+// en: Returns the bidirectional class of the character. Letters of right to left
+// scripts are R or AL, the other letters and spacing marks are L and the
+// remaining symbols and punctuation are ON
+//
+// pt_br: Retorna a classe bidirecional do caractere. Letras de escritas da direita
+// para a esquerda são R ou AL, as outras letras e marcas com espaço são L e os
+// demais símbolos e pontuação são ON
+func ClassOf(character rune) Class {
+	for _, item := range kClassRanges {
+		if character >= item.low && character <= item.high {
+			return item.class
+		}
+	}
+	if unicode.In(character, unicode.Mn, unicode.Me) {
+		return KClassNSM
+	}
+	for _, item := range kRightToLeftBlocks {
+		if character >= item.low && character <= item.high {
+			return item.class
+		}
+	}
+	if unicode.In(character, unicode.Cf) {
+		return KClassBN
+	}
+	if unicode.IsLetter(character) || unicode.IsDigit(character) || unicode.In(character, unicode.Mc, unicode.Nl, unicode.Co) {
+		return KClassL
+	}
+	return KClassON
+}
+
+// HasRightToLeft
+// en: Returns true if the text has any character that needs the bidirectional
+// algorithm: right to left letters, Arabic digits or explicit formatting
+// characters
+//
+// pt_br: Retorna true se o texto tem algum caractere que precisa do algoritmo
+// bidirecional: letras da direita para a esquerda, dígitos árabes ou caracteres
+// de formatação explícita
+func HasRightToLeft(text string) bool {
+	for _, character := range text {
+		if character < 0x0590 {
+			continue
+		}
+		switch ClassOf(character) {
+		case KClassR, KClassAL, KClassAN, KClassRLE, KClassRLO, KClassRLI, KClassFSI:
+			return true
+		}
+	}
+	return false
+}
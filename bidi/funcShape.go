@@ -0,0 +1,246 @@
+package bidi
+
+import (
+	"unicode"
+)
+
+type joining int
+
+const (
+	kJoiningNone joining = iota
+	kJoiningRight
+	kJoiningDual
+	kJoiningCausing
+	kJoiningTransparent
+)
+
+// arabicForms
+// en: Presentation forms of a letter: isolated, final, initial and medial. Letters
+// that only join to the right have no initial and medial forms
+//
+// pt_br: Formas de apresentação de uma letra: isolada, final, inicial e medial.
+// Letras que só se ligam à direita não têm formas inicial e medial
+type arabicForms [4]rune
+
+const (
+	kFormIsolated = iota
+	kFormFinal
+	kFormInitial
+	kFormMedial
+)
+
+// kArabicForms
+// en: Presentation forms of the Arabic letters, including the letters of Persian
+// and Urdu
+//
+// pt_br: Formas de apresentação das letras árabes, incluindo as letras do persa e
+// do urdu
+var kArabicForms = map[rune]arabicForms{
+	0x0621: {0xFE80},
+	0x0622: {0xFE81, 0xFE82},
+	0x0623: {0xFE83, 0xFE84},
+	0x0624: {0xFE85, 0xFE86},
+	0x0625: {0xFE87, 0xFE88},
+	0x0626: {0xFE89, 0xFE8A, 0xFE8B, 0xFE8C},
+	0x0627: {0xFE8D, 0xFE8E},
+	0x0628: {0xFE8F, 0xFE90, 0xFE91, 0xFE92},
+	0x0629: {0xFE93, 0xFE94},
+	0x062A: {0xFE95, 0xFE96, 0xFE97, 0xFE98},
+	0x062B: {0xFE99, 0xFE9A, 0xFE9B, 0xFE9C},
+	0x062C: {0xFE9D, 0xFE9E, 0xFE9F, 0xFEA0},
+	0x062D: {0xFEA1, 0xFEA2, 0xFEA3, 0xFEA4},
+	0x062E: {0xFEA5, 0xFEA6, 0xFEA7, 0xFEA8},
+	0x062F: {0xFEA9, 0xFEAA},
+	0x0630: {0xFEAB, 0xFEAC},
+	0x0631: {0xFEAD, 0xFEAE},
+	0x0632: {0xFEAF, 0xFEB0},
+	0x0633: {0xFEB1, 0xFEB2, 0xFEB3, 0xFEB4},
+	0x0634: {0xFEB5, 0xFEB6, 0xFEB7, 0xFEB8},
+	0x0635: {0xFEB9, 0xFEBA, 0xFEBB, 0xFEBC},
+	0x0636: {0xFEBD, 0xFEBE, 0xFEBF, 0xFEC0},
+	0x0637: {0xFEC1, 0xFEC2, 0xFEC3, 0xFEC4},
+	0x0638: {0xFEC5, 0xFEC6, 0xFEC7, 0xFEC8},
+	0x0639: {0xFEC9, 0xFECA, 0xFECB, 0xFECC},
+	0x063A: {0xFECD, 0xFECE, 0xFECF, 0xFED0},
+	0x0641: {0xFED1, 0xFED2, 0xFED3, 0xFED4},
+	0x0642: {0xFED5, 0xFED6, 0xFED7, 0xFED8},
+	0x0643: {0xFED9, 0xFEDA, 0xFEDB, 0xFEDC},
+	0x0644: {0xFEDD, 0xFEDE, 0xFEDF, 0xFEE0},
+	0x0645: {0xFEE1, 0xFEE2, 0xFEE3, 0xFEE4},
+	0x0646: {0xFEE5, 0xFEE6, 0xFEE7, 0xFEE8},
+	0x0647: {0xFEE9, 0xFEEA, 0xFEEB, 0xFEEC},
+	0x0648: {0xFEED, 0xFEEE},
+	0x0649: {0xFEEF, 0xFEF0, 0xFBE8, 0xFBE9},
+	0x064A: {0xFEF1, 0xFEF2, 0xFEF3, 0xFEF4},
+	0x0671: {0xFB50, 0xFB51},
+	0x0679: {0xFB66, 0xFB67, 0xFB68, 0xFB69},
+	0x067E: {0xFB56, 0xFB57, 0xFB58, 0xFB59},
+	0x0686: {0xFB7A, 0xFB7B, 0xFB7C, 0xFB7D},
+	0x0688: {0xFB88, 0xFB89},
+	0x0691: {0xFB8C, 0xFB8D},
+	0x0698: {0xFB8A, 0xFB8B},
+	0x06A9: {0xFB8E, 0xFB8F, 0xFB90, 0xFB91},
+	0x06AF: {0xFB92, 0xFB93, 0xFB94, 0xFB95},
+	0x06BA: {0xFB9E, 0xFB9F},
+	0x06BE: {0xFBAA, 0xFBAB, 0xFBAC, 0xFBAD},
+	0x06C1: {0xFBA6, 0xFBA7, 0xFBA8, 0xFBA9},
+	0x06CC: {0xFBFC, 0xFBFD, 0xFBFE, 0xFBFF},
+	0x06D2: {0xFBAE, 0xFBAF},
+}
+
+// kLamAlef
+// en: Isolated and final forms of the mandatory ligatures of lam with alef
+//
+// pt_br: Formas isolada e final das ligaduras obrigatórias de lam com alef
+var kLamAlef = map[rune][2]rune{
+	0x0622: {0xFEF5, 0xFEF6},
+	0x0623: {0xFEF7, 0xFEF8},
+	0x0625: {0xFEF9, 0xFEFA},
+	0x0627: {0xFEFB, 0xFEFC},
+}
+
+const kLam = 0x0644
+
+func joiningOf(character rune) joining {
+	if forms, found := kArabicForms[character]; found {
+		if forms[kFormInitial] != 0 {
+			return kJoiningDual
+		}
+		if forms[kFormFinal] != 0 {
+			return kJoiningRight
+		}
+		return kJoiningNone
+	}
+	switch {
+	case character == 0x0640 || character == 0x200D:
+		return kJoiningCausing
+	case unicode.In(character, unicode.Mn, unicode.Me) || character == 0x200B:
+		return kJoiningTransparent
+	}
+	return kJoiningNone
+}
+
+// Shape
+// en: Replaces the Arabic letters by the presentation form that matches their
+// neighbours (isolated, final, initial or medial) and joins lam with alef, for
+// fonts and platforms without shaping. Works on text in logical order, before
+// Paragraph.Visual()
+//
+//	sources: Index, in the characters of the text, of each shaped character; the
+//	         lam-alef ligature has the index of the lam
+//
+// pt_br: Substitui as letras árabes pela forma de apresentação que combina com os
+// seus vizinhos (isolada, final, inicial ou medial) e junta lam com alef, para
+// fontes e plataformas sem shaping. Funciona com texto em ordem lógica, antes de
+// Paragraph.Visual()
+//
+//	sources: Índice, nos caracteres do texto, de cada caractere transformado; a
+//	         ligadura lam-alef tem o índice do lam
+func Shape(text string) (shaped string, sources []int) {
+	runes := []rune(text)
+	types := make([]joining, len(runes))
+	for i, character := range runes {
+		types[i] = joiningOf(character)
+	}
+
+	// en: neighbour that joins, skipping the transparent marks
+	// pt_br: vizinho que se liga, pulando as marcas transparentes
+	neighbour := func(i, step int) joining {
+		for i += step; i >= 0 && i < len(runes); i += step {
+			if types[i] != kJoiningTransparent {
+				return types[i]
+			}
+		}
+		return kJoiningNone
+	}
+
+	output := make([]rune, 0, len(runes))
+	sources = make([]int, 0, len(runes))
+	for i := 0; i < len(runes); i += 1 {
+		character := runes[i]
+		kind := types[i]
+		if kind != kJoiningDual && kind != kJoiningRight {
+			output = append(output, character)
+			sources = append(sources, i)
+			continue
+		}
+
+		previous := neighbour(i, -1)
+		joinsPrevious := previous == kJoiningDual || previous == kJoiningCausing
+
+		if character == kLam {
+			next := i + 1
+			for next < len(runes) && types[next] == kJoiningTransparent {
+				next += 1
+			}
+			if next < len(runes) {
+				if ligature, found := kLamAlef[runes[next]]; found {
+					form := ligature[0]
+					if joinsPrevious {
+						form = ligature[1]
+					}
+					output = append(output, form)
+					sources = append(sources, i)
+					for j := i + 1; j < next; j += 1 {
+						output = append(output, runes[j])
+						sources = append(sources, j)
+					}
+					i = next
+					continue
+				}
+			}
+		}
+
+		following := neighbour(i, 1)
+		joinsNext := kind == kJoiningDual && (following == kJoiningDual || following == kJoiningRight || following == kJoiningCausing)
+
+		forms := kArabicForms[character]
+		form := forms[kFormIsolated]
+		switch {
+		case joinsPrevious && joinsNext:
+			form = forms[kFormMedial]
+		case joinsPrevious:
+			form = forms[kFormFinal]
+		case joinsNext:
+			form = forms[kFormInitial]
+		}
+		if form == 0 {
+			form = forms[kFormIsolated]
+		}
+		output = append(output, form)
+		sources = append(sources, i)
+	}
+	return string(output), sources
+}
+
+// Visual
+// en: Shapes and reorders a text for FillText() in platforms without
+// bidirectional support. Each line, split at "\n", is a paragraph
+//
+//	direction: Base direction of the paragraphs
+//
+// pt_br: Aplica shaping e reordena um texto para FillText() em plataformas sem
+// suporte bidirecional. Cada linha, dividida em "\n", é um parágrafo
+//
+//	direction: Direção base dos parágrafos
+func Visual(text string, direction Direction) string {
+	if direction != KDirectionRTL && !HasRightToLeft(text) {
+		return text
+	}
+
+	output := make([]rune, 0, len(text))
+	start := 0
+	for i := 0; i <= len(text); i += 1 {
+		if i < len(text) && text[i] != '\n' {
+			continue
+		}
+		shaped, _ := Shape(text[start:i])
+		paragraph := NewParagraph(shaped, direction)
+		output = append(output, []rune(paragraph.Visual(0, len(shaped)))...)
+		if i < len(text) {
+			output = append(output, '\n')
+		}
+		start = i + 1
+	}
+	return string(output)
+}
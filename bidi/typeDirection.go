@@ -0,0 +1,35 @@
+package bidi
+
+// Direction
+// en: Base direction of a paragraph
+//
+// pt_br: Direção base de um parágrafo
+type Direction int
+
+const (
+	// KDirectionAuto
+	// en: The direction comes from the first strong character of the paragraph,
+	// left to right when there is none. Maps to the "inherit" value of the canvas
+	//
+	// pt_br: A direção vem do primeiro caractere forte do parágrafo, da esquerda
+	// para a direita quando não há nenhum. Corresponde ao valor "inherit" do canvas
+	KDirectionAuto Direction = iota
+	KDirectionLTR
+	KDirectionRTL
+)
+
+// String
+// en: Returns the value of the direction property of the canvas: "inherit", "ltr"
+// or "rtl"
+//
+// pt_br: Retorna o valor da propriedade direction do canvas: "inherit", "ltr" ou
+// "rtl"
+func (el Direction) String() string {
+	switch el {
+	case KDirectionLTR:
+		return "ltr"
+	case KDirectionRTL:
+		return "rtl"
+	}
+	return "inherit"
+}
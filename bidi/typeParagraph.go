@@ -0,0 +1,433 @@
+package bidi
+
+// KMaxDepth
+// en: Maximum explicit embedding level of the Unicode Bidirectional Algorithm
+//
+// pt_br: Nível máximo de incorporação explícita do Algoritmo Bidirecional Unicode
+const KMaxDepth = 125
+
+// Run
+// en: Sequence of characters with the same level, returned by Paragraph.Runs() in
+// visual order. Runs with odd levels are drawn from right to left
+//
+//	Start, End: Byte offsets of the run in the text of the paragraph
+//	Level: Resolved embedding level
+//
+// pt_br: Sequência de caracteres com o mesmo nível, retornada por
+// Paragraph.Runs() em ordem visual. Runs com níveis ímpares são desenhados da
+// direita para a esquerda
+//
+//	Start, End: Deslocamentos em bytes do run no texto do parágrafo
+//	Level: Nível de incorporação resolvido
+type Run struct {
+	Start int
+	End   int
+	Level int
+}
+
+// IsRightToLeft
+// en: Returns true if the run is drawn from right to left
+//
+// pt_br: Retorna true se o run é desenhado da direita para a esquerda
+func (el Run) IsRightToLeft() bool {
+	return el.Level&1 == 1
+}
+
+// Paragraph
+// en: Paragraph with the embedding levels resolved by the Unicode Bidirectional
+// Algorithm (UAX #9): explicit embeddings, overrides and isolates, weak and
+// neutral types, bracket pairs and implicit levels. The text must not contain
+// paragraph separators; split the text at "\n" first
+//
+// pt_br: Parágrafo com os níveis de incorporação resolvidos pelo Algoritmo
+// Bidirecional Unicode (UAX #9): incorporações explícitas, substituições e
+// isolamentos, tipos fracos e neutros, pares de colchetes e níveis implícitos. O
+// texto não deve conter separadores de parágrafo; divida o texto em "\n" antes
+type Paragraph struct {
+	text    string
+	runes   []rune
+	offsets []int
+	classes []Class
+	types   []Class
+	levels  []int
+	removed []bool
+	match   []int
+	level   int
+}
+
+// NewParagraph
+// en: Resolves the levels of the text
+//
+//	direction: Base direction; KDirectionAuto uses the first strong character
+//
+// pt_br: Resolve os níveis do texto
+//
+//	direction: Direção base; KDirectionAuto usa o primeiro caractere forte
+func NewParagraph(text string, direction Direction) *Paragraph {
+	paragraph := &Paragraph{text: text}
+	for offset, character := range text {
+		paragraph.runes = append(paragraph.runes, character)
+		paragraph.offsets = append(paragraph.offsets, offset)
+		paragraph.classes = append(paragraph.classes, ClassOf(character))
+	}
+	paragraph.offsets = append(paragraph.offsets, len(text))
+
+	length := len(paragraph.runes)
+	paragraph.types = make([]Class, length)
+	paragraph.levels = make([]int, length)
+	paragraph.removed = make([]bool, length)
+	copy(paragraph.types, paragraph.classes)
+
+	paragraph.matchIsolates()
+	switch direction {
+	case KDirectionRTL:
+		paragraph.level = 1
+	case KDirectionAuto:
+		if paragraph.firstStrong(0, length) == KClassR {
+			paragraph.level = 1
+		}
+	}
+
+	paragraph.explicit()
+	for _, sequence := range paragraph.sequences() {
+		sequence.resolve(paragraph)
+	}
+	return paragraph
+}
+
+// GetText
+// en: Returns the text of the paragraph
+//
+// pt_br: Retorna o texto do parágrafo
+func (el *Paragraph) GetText() string {
+	return el.text
+}
+
+// GetDirection
+// en: Returns the resolved base direction, KDirectionLTR or KDirectionRTL
+//
+// pt_br: Retorna a direção base resolvida, KDirectionLTR ou KDirectionRTL
+func (el *Paragraph) GetDirection() Direction {
+	if el.level == 1 {
+		return KDirectionRTL
+	}
+	return KDirectionLTR
+}
+
+// GetLevel
+// en: Returns the level of the character at the byte offset, before the line rules
+//
+// pt_br: Retorna o nível do caractere no deslocamento em bytes, antes das regras de
+// linha
+func (el *Paragraph) GetLevel(offset int) int {
+	index := el.index(offset)
+	if index >= len(el.levels) {
+		return el.level
+	}
+	return el.levels[index]
+}
+
+// Runs
+// en: Returns the runs of the line between the byte offsets start and end, in
+// visual order, after the line rules: trailing spaces take the paragraph level
+// and the runs are reversed from the highest level to the lowest odd level
+//
+// pt_br: Retorna os runs da linha entre os deslocamentos em bytes start e end, em
+// ordem visual, depois das regras de linha: espaços finais recebem o nível do
+// parágrafo e os runs são invertidos do nível mais alto até o menor nível ímpar
+func (el *Paragraph) Runs(start, end int) []Run {
+	first, last := el.index(start), el.index(end)
+	levels := el.lineLevels(first, last)
+
+	runs := make([]Run, 0)
+	for i := first; i < last; i += 1 {
+		level := levels[i-first]
+		if len(runs) != 0 && runs[len(runs)-1].Level == level {
+			runs[len(runs)-1].End = el.offsets[i+1]
+			continue
+		}
+		runs = append(runs, Run{Start: el.offsets[i], End: el.offsets[i+1], Level: level})
+	}
+
+	highest, lowestOdd := 0, KMaxDepth+2
+	for _, run := range runs {
+		if run.Level > highest {
+			highest = run.Level
+		}
+		if run.Level&1 == 1 && run.Level < lowestOdd {
+			lowestOdd = run.Level
+		}
+	}
+	for level := highest; level >= lowestOdd; level -= 1 {
+		for i := 0; i < len(runs); {
+			if runs[i].Level < level {
+				i += 1
+				continue
+			}
+			j := i
+			for j < len(runs) && runs[j].Level >= level {
+				j += 1
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				runs[a], runs[b] = runs[b], runs[a]
+			}
+			i = j
+		}
+	}
+	return runs
+}
+
+// Visual
+// en: Returns the line between the byte offsets start and end in visual order,
+// with the mirrored glyphs of the right to left runs, ready for FillText() in
+// platforms without bidirectional support. Explicit formatting characters are
+// removed
+//
+// pt_br: Retorna a linha entre os deslocamentos em bytes start e end em ordem
+// visual, com os glifos espelhados dos runs da direita para a esquerda, pronta
+// para FillText() em plataformas sem suporte bidirecional. Caracteres de
+// formatação explícita são removidos
+func (el *Paragraph) Visual(start, end int) string {
+	visual := make([]rune, 0, el.index(end)-el.index(start))
+	for _, run := range el.Runs(start, end) {
+		first, last := el.index(run.Start), el.index(run.End)
+		if !run.IsRightToLeft() {
+			for i := first; i < last; i += 1 {
+				if !isFormatting(el.classes[i]) {
+					visual = append(visual, el.runes[i])
+				}
+			}
+			continue
+		}
+		for i := last - 1; i >= first; i -= 1 {
+			if isFormatting(el.classes[i]) {
+				continue
+			}
+			character := el.runes[i]
+			if mirrored, found := Mirror(character); found {
+				character = mirrored
+			}
+			visual = append(visual, character)
+		}
+	}
+	return string(visual)
+}
+
+// index
+// en: Returns the index of the character at the byte offset, or of the next one
+//
+// pt_br: Retorna o índice do caractere no deslocamento em bytes, ou do próximo
+func (el *Paragraph) index(offset int) int {
+	low, high := 0, len(el.runes)
+	for low < high {
+		middle := (low + high) / 2
+		if el.offsets[middle] < offset {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low
+}
+
+// matchIsolates
+// en: Finds the PDI that closes each isolate initiator (BD9)
+//
+// pt_br: Encontra o PDI que fecha cada iniciador de isolamento (BD9)
+func (el *Paragraph) matchIsolates() {
+	el.match = make([]int, len(el.runes))
+	stack := make([]int, 0)
+	for i, class := range el.classes {
+		el.match[i] = -1
+		switch class {
+		case KClassLRI, KClassRLI, KClassFSI:
+			stack = append(stack, i)
+		case KClassPDI:
+			if len(stack) != 0 {
+				opener := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				el.match[opener] = i
+				el.match[i] = opener
+			}
+		}
+	}
+}
+
+// firstStrong
+// en: Returns KClassL or KClassR for the first strong character between the
+// indexes, skipping isolates (P2), or KClassON when there is none
+//
+// pt_br: Retorna KClassL ou KClassR para o primeiro caractere forte entre os
+// índices, pulando isolamentos (P2), ou KClassON quando não há nenhum
+func (el *Paragraph) firstStrong(start, end int) Class {
+	for i := start; i < end; i += 1 {
+		switch el.classes[i] {
+		case KClassL:
+			return KClassL
+		case KClassR, KClassAL:
+			return KClassR
+		case KClassLRI, KClassRLI, KClassFSI:
+			if el.match[i] == -1 {
+				return KClassON
+			}
+			i = el.match[i]
+		}
+	}
+	return KClassON
+}
+
+type status struct {
+	level    int
+	override Class
+	isolate  bool
+}
+
+// explicit
+// en: Applies the explicit embeddings, overrides and isolates (X1 to X9)
+//
+// pt_br: Aplica as incorporações, substituições e isolamentos explícitos (X1 a
+// X9)
+func (el *Paragraph) explicit() {
+	stack := []status{{level: el.level, override: KClassON}}
+	overflowIsolates, overflowEmbeddings, validIsolates := 0, 0, 0
+
+	next := func(rtl bool) int {
+		level := stack[len(stack)-1].level
+		if rtl {
+			return level + 1 | 1
+		}
+		return (level + 2) &^ 1
+	}
+
+	for i, class := range el.classes {
+		top := stack[len(stack)-1]
+		switch class {
+		case KClassRLE, KClassLRE, KClassRLO, KClassLRO:
+			el.levels[i] = top.level
+			el.removed[i] = true
+			level := next(class == KClassRLE || class == KClassRLO)
+			if level <= KMaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				override := KClassON
+				if class == KClassRLO {
+					override = KClassR
+				} else if class == KClassLRO {
+					override = KClassL
+				}
+				stack = append(stack, status{level: level, override: override})
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings += 1
+			}
+
+		case KClassRLI, KClassLRI, KClassFSI:
+			el.levels[i] = top.level
+			if top.override != KClassON {
+				el.types[i] = top.override
+			}
+			rtl := class == KClassRLI
+			if class == KClassFSI {
+				end := el.match[i]
+				if end == -1 {
+					end = len(el.classes)
+				}
+				rtl = el.firstStrong(i+1, end) == KClassR
+			}
+			level := next(rtl)
+			if level <= KMaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates += 1
+				stack = append(stack, status{level: level, override: KClassON, isolate: true})
+			} else {
+				overflowIsolates += 1
+			}
+
+		case KClassPDI:
+			if overflowIsolates > 0 {
+				overflowIsolates -= 1
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates -= 1
+			}
+			top = stack[len(stack)-1]
+			el.levels[i] = top.level
+			if top.override != KClassON {
+				el.types[i] = top.override
+			}
+
+		case KClassPDF:
+			el.levels[i] = top.level
+			el.removed[i] = true
+			if overflowIsolates > 0 {
+				break
+			}
+			if overflowEmbeddings > 0 {
+				overflowEmbeddings -= 1
+			} else if !top.isolate && len(stack) >= 2 {
+				stack = stack[:len(stack)-1]
+			}
+
+		case KClassB:
+			el.levels[i] = el.level
+
+		case KClassBN:
+			el.levels[i] = top.level
+			el.removed[i] = true
+
+		default:
+			el.levels[i] = top.level
+			if top.override != KClassON {
+				el.types[i] = top.override
+			}
+		}
+	}
+}
+
+// lineLevels
+// en: Returns the levels of the characters of a line after rule L1. Removed
+// characters take the level of the previous character
+//
+// pt_br: Retorna os níveis dos caracteres de uma linha depois da regra L1.
+// Caracteres removidos recebem o nível do caractere anterior
+func (el *Paragraph) lineLevels(first, last int) []int {
+	levels := make([]int, last-first)
+	previous := el.level
+	for i := first; i < last; i += 1 {
+		levels[i-first] = el.levels[i]
+		if el.removed[i] {
+			levels[i-first] = previous
+		}
+		previous = levels[i-first]
+	}
+
+	trailing := true
+	for i := last - 1; i >= first; i -= 1 {
+		switch el.classes[i] {
+		case KClassS, KClassB:
+			levels[i-first] = el.level
+			trailing = true
+		case KClassWS, KClassLRI, KClassRLI, KClassFSI, KClassPDI:
+			if trailing {
+				levels[i-first] = el.level
+			}
+		default:
+			if el.removed[i] {
+				if trailing {
+					levels[i-first] = el.level
+				}
+				continue
+			}
+			trailing = false
+		}
+	}
+	return levels
+}
+
+func isFormatting(class Class) bool {
+	switch class {
+	case KClassLRE, KClassRLE, KClassLRO, KClassRLO, KClassPDF, KClassLRI, KClassRLI, KClassFSI, KClassPDI:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,279 @@
+package bidi
+
+// sequence
+// en: Isolating run sequence (BD13): level runs joined across isolates, the unit
+// of the weak, neutral and implicit rules
+//
+// pt_br: Sequência isolada de runs (BD13): runs de nível unidos através de
+// isolamentos, a unidade das regras fracas, neutras e implícitas
+type sequence struct {
+	indexes []int
+	types   []Class
+	level   int
+	sos     Class
+	eos     Class
+}
+
+// sequences
+// en: Splits the characters that were not removed by X9 in isolating run sequences
+// (X10)
+//
+// pt_br: Divide os caracteres que não foram removidos por X9 em sequências
+// isoladas de runs (X10)
+func (el *Paragraph) sequences() []*sequence {
+	runs := make([][]int, 0)
+	runOf := make([]int, len(el.runes))
+	for i := range el.runes {
+		if el.removed[i] {
+			continue
+		}
+		last := len(runs) - 1
+		if last == -1 || el.levels[runs[last][len(runs[last])-1]] != el.levels[i] {
+			runs = append(runs, []int{i})
+		} else {
+			runs[last] = append(runs[last], i)
+		}
+		runOf[i] = len(runs) - 1
+	}
+
+	list := make([]*sequence, 0, len(runs))
+	for _, run := range runs {
+		first := run[0]
+		if el.classes[first] == KClassPDI && el.match[first] != -1 {
+			continue
+		}
+
+		indexes := append([]int{}, run...)
+		for {
+			last := indexes[len(indexes)-1]
+			if !isIsolateInitiator(el.classes[last]) || el.match[last] == -1 || el.removed[el.match[last]] {
+				break
+			}
+			indexes = append(indexes, runs[runOf[el.match[last]]]...)
+		}
+		list = append(list, el.newSequence(indexes))
+	}
+	return list
+}
+
+func (el *Paragraph) newSequence(indexes []int) *sequence {
+	first, last := indexes[0], indexes[len(indexes)-1]
+	level := el.levels[first]
+
+	before := el.level
+	for i := first - 1; i >= 0; i -= 1 {
+		if !el.removed[i] {
+			before = el.levels[i]
+			break
+		}
+	}
+	after := el.level
+	if !isIsolateInitiator(el.classes[last]) {
+		for i := last + 1; i < len(el.runes); i += 1 {
+			if !el.removed[i] {
+				after = el.levels[i]
+				break
+			}
+		}
+	}
+
+	types := make([]Class, len(indexes))
+	for i, index := range indexes {
+		types[i] = el.types[index]
+	}
+	return &sequence{
+		indexes: indexes,
+		types:   types,
+		level:   level,
+		sos:     directionOfLevel(max(level, before)),
+		eos:     directionOfLevel(max(level, after)),
+	}
+}
+
+// resolve
+// en: Applies the weak (W1 to W7), bracket (N0), neutral (N1, N2) and implicit
+// (I1, I2) rules and stores the levels in the paragraph
+//
+// pt_br: Aplica as regras fracas (W1 a W7), de colchetes (N0), neutras (N1, N2) e
+// implícitas (I1, I2) e guarda os níveis no parágrafo
+func (el *sequence) resolve(paragraph *Paragraph) {
+	el.weak()
+	el.brackets(paragraph)
+	el.neutral()
+
+	for i, index := range el.indexes {
+		level := el.level
+		switch {
+		case level&1 == 0 && el.types[i] == KClassR:
+			level += 1
+		case level&1 == 0 && (el.types[i] == KClassAN || el.types[i] == KClassEN):
+			level += 2
+		case level&1 == 1 && (el.types[i] == KClassL || el.types[i] == KClassAN || el.types[i] == KClassEN):
+			level += 1
+		}
+		paragraph.levels[index] = level
+		paragraph.types[index] = el.types[i]
+	}
+}
+
+// weak
+// en: Resolves the weak types, rules W1 to W7
+//
+// pt_br: Resolve os tipos fracos, regras W1 a W7
+func (el *sequence) weak() {
+	types := el.types
+
+	// W1
+	for i, class := range types {
+		if class != KClassNSM {
+			continue
+		}
+		if i == 0 {
+			types[i] = el.sos
+		} else if isIsolateInitiator(types[i-1]) || types[i-1] == KClassPDI {
+			types[i] = KClassON
+		} else {
+			types[i] = types[i-1]
+		}
+	}
+
+	// W2 and W3
+	strong := el.sos
+	for i, class := range types {
+		switch class {
+		case KClassL, KClassR, KClassAL:
+			strong = class
+		case KClassEN:
+			if strong == KClassAL {
+				types[i] = KClassAN
+			}
+		}
+	}
+	for i, class := range types {
+		if class == KClassAL {
+			types[i] = KClassR
+		}
+	}
+
+	// W4
+	for i := 1; i < len(types)-1; i += 1 {
+		previous, next := types[i-1], types[i+1]
+		if types[i] == KClassES && previous == KClassEN && next == KClassEN {
+			types[i] = KClassEN
+		} else if types[i] == KClassCS && previous == next && (previous == KClassEN || previous == KClassAN) {
+			types[i] = previous
+		}
+	}
+
+	// W5
+	for i := 0; i < len(types); {
+		if types[i] != KClassET {
+			i += 1
+			continue
+		}
+		end := i
+		for end < len(types) && types[end] == KClassET {
+			end += 1
+		}
+		if (i > 0 && types[i-1] == KClassEN) || (end < len(types) && types[end] == KClassEN) {
+			for j := i; j < end; j += 1 {
+				types[j] = KClassEN
+			}
+		}
+		i = end
+	}
+
+	// W6
+	for i, class := range types {
+		if class == KClassES || class == KClassET || class == KClassCS {
+			types[i] = KClassON
+		}
+	}
+
+	// W7
+	strong = el.sos
+	for i, class := range types {
+		switch class {
+		case KClassL, KClassR:
+			strong = class
+		case KClassEN:
+			if strong == KClassL {
+				types[i] = KClassL
+			}
+		}
+	}
+}
+
+// neutral
+// en: Resolves the neutral and isolate types, rules N1 and N2
+//
+// pt_br: Resolve os tipos neutros e de isolamento, regras N1 e N2
+func (el *sequence) neutral() {
+	types := el.types
+	embedding := directionOfLevel(el.level)
+	for i := 0; i < len(types); {
+		if !isNeutral(types[i]) {
+			i += 1
+			continue
+		}
+		end := i
+		for end < len(types) && isNeutral(types[end]) {
+			end += 1
+		}
+
+		before, after := el.sos, el.eos
+		if i > 0 {
+			before = strongDirection(types[i-1])
+		}
+		if end < len(types) {
+			after = strongDirection(types[end])
+		}
+		direction := embedding
+		if before == after {
+			direction = before
+		}
+		for j := i; j < end; j += 1 {
+			types[j] = direction
+		}
+		i = end
+	}
+}
+
+func isIsolateInitiator(class Class) bool {
+	return class == KClassLRI || class == KClassRLI || class == KClassFSI
+}
+
+func isNeutral(class Class) bool {
+	switch class {
+	case KClassB, KClassS, KClassWS, KClassON, KClassLRI, KClassRLI, KClassFSI, KClassPDI:
+		return true
+	}
+	return false
+}
+
+// strongDirection
+// en: Returns the direction of a resolved type for the neutral rules; numbers act
+// as R
+//
+// pt_br: Retorna a direção de um tipo resolvido para as regras neutras; números
+// agem como R
+func strongDirection(class Class) Class {
+	if class == KClassL {
+		return KClassL
+	}
+	return KClassR
+}
+
+func directionOfLevel(level int) Class {
+	if level&1 == 1 {
+		return KClassR
+	}
+	return KClassL
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,117 @@
+package bitmapFont
+
+import (
+	"math"
+
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+)
+
+// Drawer
+// en: Part of IDraw used to draw the glyphs
+//
+// pt_br: Parte de IDraw usada para desenhar os glifos
+type Drawer interface {
+	DrawImage(image interface{}, value ...interface{})
+}
+
+// MeasureText
+// en: Returns the metrics of a single line of text with the scale applied, with the
+// meaning of the TextMetrics of the browsers for textBaseline alphabetic. Font
+// implements textLayout.Measurer
+//
+// pt_br: Retorna as medidas de uma única linha de texto com a escala aplicada, com
+// o significado do TextMetrics dos navegadores para textBaseline alphabetic. Font
+// implementa textLayout.Measurer
+func (el *Font) MeasureText(text string) (metrics iotmakerPlatformTextMetrics.TextMetrics) {
+	ascent := float64(el.Common.Base) * el.scale
+	descent := float64(el.Common.LineHeight-el.Common.Base) * el.scale
+	metrics.FontBoundingBoxAscent = ascent
+	metrics.FontBoundingBoxDescent = descent
+	metrics.EmHeightAscent = ascent
+	metrics.EmHeightDescent = descent
+	metrics.HangingBaseline = 0.8 * ascent
+	metrics.IdeographicBaseline = -descent
+
+	left, right := math.Inf(1), math.Inf(-1)
+	top, bottom := math.Inf(1), math.Inf(-1)
+	metrics.Width = el.walk(text, func(char Char, x float64) {
+		if char.Width == 0 || char.Height == 0 {
+			return
+		}
+		left = math.Min(left, x+float64(char.XOffset)*el.scale)
+		right = math.Max(right, x+float64(char.XOffset+char.Width)*el.scale)
+		top = math.Min(top, float64(char.YOffset-el.Common.Base)*el.scale)
+		bottom = math.Max(bottom, float64(char.YOffset+char.Height-el.Common.Base)*el.scale)
+	})
+	if left <= right {
+		metrics.ActualBoundingBoxLeft = -left
+		metrics.ActualBoundingBoxRight = right
+		metrics.ActualBoundingBoxAscent = -top
+		metrics.ActualBoundingBoxDescent = bottom
+	}
+	return
+}
+
+// Draw
+// en: Draws a single line of text with the pen at (x, y), where y is the baseline,
+// and returns the width drawn. The destination of each glyph is rounded to whole
+// pixels to keep pixel art sharp
+//
+// pt_br: Desenha uma única linha de texto com a caneta em (x, y), onde y é a linha
+// de base, e retorna a largura desenhada. O destino de cada glifo é arredondado
+// para pixels inteiros para manter a pixel art nítida
+func (el *Font) Draw(platform Drawer, text string, x, y float64) (width float64) {
+	return el.draw(platform, text, x, y, 1)
+}
+
+// draw
+// en: Draws the text with an extra horizontal factor, used by maxWidth
+//
+// pt_br: Desenha o texto com um fator horizontal extra, usado por maxWidth
+func (el *Font) draw(platform Drawer, text string, x, y, factor float64) (width float64) {
+	top := y - float64(el.Common.Base)*el.scale
+	width = el.walk(text, func(char Char, pen float64) {
+		if char.Width == 0 || char.Height == 0 || char.Page >= len(el.images) || el.images[char.Page] == nil {
+			return
+		}
+		left := math.Round(x + (pen+float64(char.XOffset)*el.scale)*factor)
+		right := math.Round(x + (pen+float64(char.XOffset+char.Width)*el.scale)*factor)
+		upper := math.Round(top + float64(char.YOffset)*el.scale)
+		lower := math.Round(top + float64(char.YOffset+char.Height)*el.scale)
+		platform.DrawImage(
+			el.images[char.Page],
+			char.X, char.Y, char.Width, char.Height,
+			int(left), int(upper), int(right-left), int(lower-upper),
+		)
+	})
+	return width * factor
+}
+
+// walk
+// en: Calls fn for each glyph of the text with the pen position, relative to the
+// start, and returns the advance of the text. Kerning is applied between
+// characters; line breaks are drawn as spaces, like in FillText()
+//
+// pt_br: Chama fn para cada glifo do texto com a posição da caneta, relativa ao
+// início, e retorna o avanço do texto. O kerning é aplicado entre caracteres;
+// quebras de linha são desenhadas como espaços, como em FillText()
+func (el *Font) walk(text string, fn func(char Char, x float64)) float64 {
+	pen := 0.0
+	previous := rune(-1)
+	for _, character := range text {
+		if character == '\n' || character == '\r' || character == '\t' {
+			character = ' '
+		}
+		char, found := el.glyph(character)
+		if !found {
+			continue
+		}
+		if previous != -1 {
+			pen += float64(el.Kerning(previous, char.Id)) * el.scale
+		}
+		fn(char, pen)
+		pen += float64(char.XAdvance) * el.scale
+		previous = char.Id
+	}
+	return pen
+}
@@ -0,0 +1,146 @@
+package bitmapFont
+
+import (
+	"fmt"
+	"image"
+	_ "image/png"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// PageLoader
+// en: Loads the page image with the file name of the descriptor and returns a value
+// accepted by IDraw.DrawImage()
+//
+// pt_br: Carrega a imagem de página com o nome de arquivo do descritor e retorna um
+// valor aceito por IDraw.DrawImage()
+type PageLoader func(file string) (image interface{}, err error)
+
+// NewHtmlLoader
+// en: Returns a loader that creates the page images with IHtml.NewImage(), waiting
+// for the load
+//
+//	html: Platform used to create the images
+//	parent: Parent element passed to NewImage()
+//	base: URL prefixed to the file names of the descriptor, like "fonts/"
+//
+// pt_br: Retorna um carregador que cria as imagens de página com IHtml.NewImage(),
+// esperando o carregamento
+//
+//	html: Plataforma usada para criar as imagens
+//	parent: Elemento pai passado para NewImage()
+//	base: URL prefixada aos nomes de arquivo do descritor, como "fonts/"
+func NewHtmlLoader(html iotmakerPlatformIDraw.IHtml, parent interface{}, base string) PageLoader {
+	return func(file string) (interface{}, error) {
+		return html.NewImage(parent, map[string]interface{}{"src": base + file}, true), nil
+	}
+}
+
+// NewFileLoader
+// en: Returns a loader that decodes the page images from fsys, which accepts an
+// embed.FS or os.DirFS(), as image.Image for headless backends. PNG is supported;
+// register other decoders with a blank import
+//
+//	dir: Directory of the descriptor inside fsys; the file names are relative to it
+//
+// pt_br: Retorna um carregador que decodifica as imagens de página a partir de
+// fsys, que aceita um embed.FS ou os.DirFS(), como image.Image para backends sem
+// navegador. PNG é suportado; registre outros decodificadores com um import em
+// branco
+//
+//	dir: Diretório do descritor dentro de fsys; os nomes de arquivo são relativos
+//	     a ele
+func NewFileLoader(fsys fs.FS, dir string) PageLoader {
+	return func(file string) (interface{}, error) {
+		name := path.Join(dir, strings.ReplaceAll(file, "\\", "/"))
+		reader, err := fsys.Open(name)
+		if err != nil {
+			return nil, fmt.Errorf("bitmapFont: %v", err)
+		}
+		defer reader.Close()
+
+		decoded, _, err := image.Decode(reader)
+		if err != nil {
+			return nil, fmt.Errorf("bitmapFont: %v: %v", name, err)
+		}
+		return decoded, nil
+	}
+}
+
+// LoadFile
+// en: Reads and parses the descriptor at path, then loads its pages from the same
+// directory with NewFileLoader()
+//
+// pt_br: Lê e interpreta o descritor em path, depois carrega as suas páginas do
+// mesmo diretório com NewFileLoader()
+func LoadFile(path string) (font *Font, err error) {
+	var data []byte
+	data, err = os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("bitmapFont: %v", err)
+	}
+	font, err = Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	err = font.LoadPages(NewFileLoader(os.DirFS(filepath.Dir(path)), "."))
+	return
+}
+
+// LoadFS
+// en: Reads and parses the descriptor name from fsys, which accepts an embed.FS,
+// then loads its pages from the same directory
+//
+// pt_br: Lê e interpreta o descritor name de fsys, que aceita um embed.FS, depois
+// carrega as suas páginas do mesmo diretório
+func LoadFS(fsys fs.FS, name string) (font *Font, err error) {
+	var data []byte
+	data, err = fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("bitmapFont: %v", err)
+	}
+	font, err = Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	err = font.LoadPages(NewFileLoader(fsys, path.Dir(name)))
+	return
+}
+
+// LoadPages
+// en: Loads every page image of the descriptor with the loader. Must be called
+// before drawing
+//
+// pt_br: Carrega todas as imagens de página do descritor com o carregador. Deve ser
+// chamada antes de desenhar
+func (el *Font) LoadPages(loader PageLoader) (err error) {
+	images := make([]interface{}, len(el.Pages))
+	for id, file := range el.Pages {
+		if file == "" {
+			continue
+		}
+		images[id], err = loader(file)
+		if err != nil {
+			return
+		}
+	}
+	el.images = images
+	return nil
+}
+
+// SetPage
+// en: Defines the image of a page directly, for images created by other means
+//
+// pt_br: Define a imagem de uma página diretamente, para imagens criadas por outros
+// meios
+func (el *Font) SetPage(id int, image interface{}) {
+	for len(el.images) <= id {
+		el.images = append(el.images, nil)
+	}
+	el.images[id] = image
+}
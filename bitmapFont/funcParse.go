@@ -0,0 +1,23 @@
+package bitmapFont
+
+import (
+	"bytes"
+)
+
+// Parse
+// en: Parses a descriptor in any of the BMFont formats: text, XML or binary. The
+// format is detected from the content
+//
+// pt_br: Interpreta um descritor em qualquer um dos formatos do BMFont: texto, XML
+// ou binário. O formato é detectado pelo conteúdo
+func Parse(data []byte) (font *Font, err error) {
+	if bytes.HasPrefix(data, []byte("BMF")) {
+		return ParseBinary(data)
+	}
+
+	text := bytes.TrimLeft(data, "\xef\xbb\xbf \t\r\n")
+	if bytes.HasPrefix(text, []byte("<")) {
+		return ParseXML(data)
+	}
+	return ParseText(text)
+}
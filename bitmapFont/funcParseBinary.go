@@ -0,0 +1,149 @@
+package bitmapFont
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	kBlockInfo    = 1
+	kBlockCommon  = 2
+	kBlockPages   = 3
+	kBlockChars   = 4
+	kBlockKerning = 5
+
+	kCharSize    = 20
+	kKerningSize = 10
+)
+
+// ParseBinary
+// en: Parses a descriptor in the binary format of BMFont, version 3
+//
+// pt_br: Interpreta um descritor no formato binário do BMFont, versão 3
+func ParseBinary(data []byte) (font *Font, err error) {
+	if len(data) < 4 || string(data[:3]) != "BMF" {
+		return nil, fmt.Errorf("bitmapFont: not a binary descriptor")
+	}
+	if data[3] != 3 {
+		return nil, fmt.Errorf("bitmapFont: unsupported binary version %v", data[3])
+	}
+
+	font = newFont()
+	data = data[4:]
+	for len(data) != 0 {
+		if len(data) < 5 {
+			return nil, fmt.Errorf("bitmapFont: truncated block header")
+		}
+		kind := data[0]
+		size := int(binary.LittleEndian.Uint32(data[1:5]))
+		if size < 0 || size > len(data)-5 {
+			return nil, fmt.Errorf("bitmapFont: truncated block %v", kind)
+		}
+		block := data[5 : 5+size]
+		data = data[5+size:]
+
+		switch kind {
+		case kBlockInfo:
+			err = font.parseBinaryInfo(block)
+		case kBlockCommon:
+			err = font.parseBinaryCommon(block)
+		case kBlockPages:
+			font.parseBinaryPages(block)
+		case kBlockChars:
+			font.parseBinaryChars(block)
+		case kBlockKerning:
+			font.parseBinaryKerning(block)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return font, font.check()
+}
+
+func (el *Font) parseBinaryInfo(block []byte) error {
+	if len(block) < 14 {
+		return fmt.Errorf("bitmapFont: truncated info block")
+	}
+	flags := block[2]
+	el.Info = Info{
+		Size:     int(int16(binary.LittleEndian.Uint16(block[0:]))),
+		Smooth:   flags&0x80 != 0,
+		Unicode:  flags&0x40 != 0,
+		Italic:   flags&0x20 != 0,
+		Bold:     flags&0x10 != 0,
+		Charset:  fmt.Sprint(block[3]),
+		StretchH: int(binary.LittleEndian.Uint16(block[4:])),
+		AA:       int(block[6]),
+		Padding:  [4]int{int(block[7]), int(block[8]), int(block[9]), int(block[10])},
+		Spacing:  [2]int{int(block[11]), int(block[12])},
+		Outline:  int(block[13]),
+	}
+	face := block[14:]
+	if end := bytes.IndexByte(face, 0); end != -1 {
+		face = face[:end]
+	}
+	el.Info.Face = string(face)
+	return nil
+}
+
+func (el *Font) parseBinaryCommon(block []byte) error {
+	if len(block) < 15 {
+		return fmt.Errorf("bitmapFont: truncated common block")
+	}
+	el.Common = Common{
+		LineHeight: int(binary.LittleEndian.Uint16(block[0:])),
+		Base:       int(binary.LittleEndian.Uint16(block[2:])),
+		ScaleW:     int(binary.LittleEndian.Uint16(block[4:])),
+		ScaleH:     int(binary.LittleEndian.Uint16(block[6:])),
+		Pages:      int(binary.LittleEndian.Uint16(block[8:])),
+		Packed:     block[10]&0x01 != 0,
+	}
+	return nil
+}
+
+// parseBinaryPages
+// en: Reads the page names, a sequence of zero terminated strings
+//
+// pt_br: Lê os nomes das páginas, uma sequência de strings terminadas em zero
+func (el *Font) parseBinaryPages(block []byte) {
+	for len(block) != 0 {
+		end := bytes.IndexByte(block, 0)
+		if end == -1 {
+			end = len(block)
+		}
+		el.Pages = append(el.Pages, string(block[:end]))
+		if end == len(block) {
+			return
+		}
+		block = block[end+1:]
+	}
+}
+
+func (el *Font) parseBinaryChars(block []byte) {
+	for ; len(block) >= kCharSize; block = block[kCharSize:] {
+		el.AddChar(Char{
+			Id:       rune(binary.LittleEndian.Uint32(block[0:])),
+			X:        int(binary.LittleEndian.Uint16(block[4:])),
+			Y:        int(binary.LittleEndian.Uint16(block[6:])),
+			Width:    int(binary.LittleEndian.Uint16(block[8:])),
+			Height:   int(binary.LittleEndian.Uint16(block[10:])),
+			XOffset:  int(int16(binary.LittleEndian.Uint16(block[12:]))),
+			YOffset:  int(int16(binary.LittleEndian.Uint16(block[14:]))),
+			XAdvance: int(int16(binary.LittleEndian.Uint16(block[16:]))),
+			Page:     int(block[18]),
+			Channel:  int(block[19]),
+		})
+	}
+}
+
+func (el *Font) parseBinaryKerning(block []byte) {
+	for ; len(block) >= kKerningSize; block = block[kKerningSize:] {
+		el.AddKerning(
+			rune(binary.LittleEndian.Uint32(block[0:])),
+			rune(binary.LittleEndian.Uint32(block[4:])),
+			int(int16(binary.LittleEndian.Uint16(block[8:]))),
+		)
+	}
+}
@@ -0,0 +1,206 @@
+package bitmapFont
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseText
+// en: Parses a descriptor in the text format of BMFont
+//
+// pt_br: Interpreta um descritor no formato texto do BMFont
+func ParseText(data []byte) (font *Font, err error) {
+	font = newFont()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	number := 0
+	for scanner.Scan() {
+		number += 1
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var tag string
+		var attributes map[string]string
+		tag, attributes, err = splitLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("bitmapFont: line %v: %v", number, err)
+		}
+		err = font.setBlock(tag, attributes)
+		if err != nil {
+			return nil, fmt.Errorf("bitmapFont: line %v: %v", number, err)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("bitmapFont: %v", err)
+	}
+	return font, font.check()
+}
+
+// splitLine
+// en: Splits a line like `char id=65 x=0` in its tag and its attributes. Values
+// can be quoted
+//
+// pt_br: Divide uma linha como `char id=65 x=0` na sua tag e nos seus atributos.
+// Valores podem estar entre aspas
+func splitLine(line string) (tag string, attributes map[string]string, err error) {
+	attributes = make(map[string]string)
+	end := strings.IndexAny(line, " \t")
+	if end == -1 {
+		return line, attributes, nil
+	}
+	tag = line[:end]
+	line = line[end:]
+
+	for {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			return
+		}
+		equal := strings.IndexByte(line, '=')
+		if equal == -1 {
+			return "", nil, fmt.Errorf("expected key=value at %q", line)
+		}
+		key := line[:equal]
+		line = line[equal+1:]
+
+		var value string
+		if strings.HasPrefix(line, `"`) {
+			closing := strings.IndexByte(line[1:], '"')
+			if closing == -1 {
+				return "", nil, fmt.Errorf("missing '\"' in %v", key)
+			}
+			value = line[1 : closing+1]
+			line = line[closing+2:]
+		} else {
+			end = strings.IndexAny(line, " \t")
+			if end == -1 {
+				end = len(line)
+			}
+			value = line[:end]
+			line = line[end:]
+		}
+		attributes[key] = value
+	}
+}
+
+// setBlock
+// en: Fills the font with the attributes of one block of the text or XML format
+//
+// pt_br: Preenche a fonte com os atributos de um bloco do formato texto ou XML
+func (el *Font) setBlock(tag string, attributes map[string]string) (err error) {
+	values := attributeReader{attributes: attributes}
+	switch tag {
+	case "info":
+		el.Info = Info{
+			Face:     attributes["face"],
+			Size:     values.int("size"),
+			Bold:     values.int("bold") != 0,
+			Italic:   values.int("italic") != 0,
+			Charset:  attributes["charset"],
+			Unicode:  values.int("unicode") != 0,
+			StretchH: values.int("stretchH"),
+			Smooth:   values.int("smooth") != 0,
+			AA:       values.int("aa"),
+			Outline:  values.int("outline"),
+		}
+		copy(el.Info.Padding[:], values.list("padding"))
+		copy(el.Info.Spacing[:], values.list("spacing"))
+	case "common":
+		el.Common = Common{
+			LineHeight: values.int("lineHeight"),
+			Base:       values.int("base"),
+			ScaleW:     values.int("scaleW"),
+			ScaleH:     values.int("scaleH"),
+			Pages:      values.int("pages"),
+			Packed:     values.int("packed") != 0,
+		}
+	case "page":
+		id := values.int("id")
+		if values.err == nil && (id < 0 || id > 0xff) {
+			return fmt.Errorf("invalid page id %v", id)
+		}
+		for len(el.Pages) <= id {
+			el.Pages = append(el.Pages, "")
+		}
+		el.Pages[id] = attributes["file"]
+	case "char":
+		channel := 15
+		if _, found := attributes["chnl"]; found {
+			channel = values.int("chnl")
+		}
+		el.AddChar(Char{
+			Id:       rune(values.int("id")),
+			X:        values.int("x"),
+			Y:        values.int("y"),
+			Width:    values.int("width"),
+			Height:   values.int("height"),
+			XOffset:  values.int("xoffset"),
+			YOffset:  values.int("yoffset"),
+			XAdvance: values.int("xadvance"),
+			Page:     values.int("page"),
+			Channel:  channel,
+		})
+	case "kerning":
+		el.AddKerning(rune(values.int("first")), rune(values.int("second")), values.int("amount"))
+	}
+	return values.err
+}
+
+// check
+// en: Verifies that the font has the blocks needed to draw
+//
+// pt_br: Verifica se a fonte tem os blocos necessários para desenhar
+func (el *Font) check() error {
+	if el.Common.LineHeight <= 0 {
+		return fmt.Errorf("bitmapFont: missing or invalid common block")
+	}
+	for _, char := range el.chars {
+		if char.Page < 0 || char.Page >= len(el.Pages) {
+			return fmt.Errorf("bitmapFont: char %v uses missing page %v", char.Id, char.Page)
+		}
+	}
+	return nil
+}
+
+// attributeReader
+// en: Converts attribute values keeping the first error
+//
+// pt_br: Converte valores de atributos guardando o primeiro erro
+type attributeReader struct {
+	attributes map[string]string
+	err        error
+}
+
+func (el *attributeReader) int(key string) int {
+	text, found := el.attributes[key]
+	if !found || el.err != nil {
+		return 0
+	}
+	value, err := strconv.Atoi(strings.TrimSpace(text))
+	if err != nil {
+		el.err = fmt.Errorf("invalid %v %q", key, text)
+	}
+	return value
+}
+
+func (el *attributeReader) list(key string) []int {
+	text, found := el.attributes[key]
+	if !found || el.err != nil {
+		return nil
+	}
+	parts := strings.Split(text, ",")
+	list := make([]int, len(parts))
+	for i := 0; i != len(parts); i += 1 {
+		value, err := strconv.Atoi(strings.TrimSpace(parts[i]))
+		if err != nil {
+			el.err = fmt.Errorf("invalid %v %q", key, text)
+			return nil
+		}
+		list[i] = value
+	}
+	return list
+}
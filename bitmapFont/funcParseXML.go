@@ -0,0 +1,41 @@
+package bitmapFont
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// ParseXML
+// en: Parses a descriptor in the XML format of BMFont
+//
+// pt_br: Interpreta um descritor no formato XML do BMFont
+func ParseXML(data []byte) (font *Font, err error) {
+	font = newFont()
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		var token xml.Token
+		token, err = decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bitmapFont: %v", err)
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, attribute := range element.Attr {
+			attributes[attribute.Name.Local] = attribute.Value
+		}
+		err = font.setBlock(element.Name.Local, attributes)
+		if err != nil {
+			return nil, fmt.Errorf("bitmapFont: offset %v: %v", decoder.InputOffset(), err)
+		}
+	}
+	return font, font.check()
+}
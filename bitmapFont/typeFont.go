@@ -0,0 +1,227 @@
+package bitmapFont
+
+import (
+	"math"
+)
+
+// Info
+// en: Block "info" of the descriptor, with the settings used to generate the font
+//
+// pt_br: Bloco "info" do descritor, com as configurações usadas para gerar a fonte
+type Info struct {
+	Face     string
+	Size     int
+	Bold     bool
+	Italic   bool
+	Charset  string
+	Unicode  bool
+	StretchH int
+	Smooth   bool
+	AA       int
+	Padding  [4]int
+	Spacing  [2]int
+	Outline  int
+}
+
+// Common
+// en: Block "common" of the descriptor
+//
+//	LineHeight: Distance between two lines, in pixels
+//	Base: Distance from the top of the line to the baseline, in pixels
+//	ScaleW, ScaleH: Size of the page images
+//	Pages: Number of page images
+//
+// pt_br: Bloco "common" do descritor
+//
+//	LineHeight: Distância entre duas linhas, em pixels
+//	Base: Distância do topo da linha até a linha de base, em pixels
+//	ScaleW, ScaleH: Tamanho das imagens de página
+//	Pages: Número de imagens de página
+type Common struct {
+	LineHeight int
+	Base       int
+	ScaleW     int
+	ScaleH     int
+	Pages      int
+	Packed     bool
+}
+
+// Char
+// en: Position of a glyph inside its page image and how it is placed on the line
+//
+//	X, Y, Width, Height: Rectangle of the glyph in the page image
+//	XOffset, YOffset: Offset from the pen position to the upper-left corner of
+//	                  the glyph, with YOffset counted from the top of the line
+//	XAdvance: Distance the pen moves after the glyph
+//	Page: Index of the page image
+//	Channel: Colour channels that hold the glyph; 15 means all channels
+//
+// pt_br: Posição de um glifo na sua imagem de página e como ele é colocado na linha
+//
+//	X, Y, Width, Height: Retângulo do glifo na imagem de página
+//	XOffset, YOffset: Deslocamento da posição da caneta até o canto superior
+//	                  esquerdo do glifo, com YOffset contado a partir do topo da
+//	                  linha
+//	XAdvance: Distância que a caneta anda depois do glifo
+//	Page: Índice da imagem de página
+//	Channel: Canais de cor que contêm o glifo; 15 significa todos os canais
+type Char struct {
+	Id       rune
+	X        int
+	Y        int
+	Width    int
+	Height   int
+	XOffset  int
+	YOffset  int
+	XAdvance int
+	Page     int
+	Channel  int
+}
+
+type kerningPair struct {
+	first  rune
+	second rune
+}
+
+// Font
+// en: Bitmap font in the BMFont (AngelCode) format. Create it with Parse(),
+// ParseText(), ParseXML(), ParseBinary() or LoadFile(), then load the page images
+// with LoadPages() before drawing
+//
+// pt_br: Fonte bitmap no formato BMFont (AngelCode). Crie com Parse(), ParseText(),
+// ParseXML(), ParseBinary() ou LoadFile(), depois carregue as imagens de página
+// com LoadPages() antes de desenhar
+type Font struct {
+	Info   Info
+	Common Common
+
+	// en: File names of the page images, indexed by page id
+	//
+	// pt_br: Nomes dos arquivos das imagens de página, indexados pelo id da página
+	Pages []string
+
+	chars    map[rune]Char
+	kernings map[kerningPair]int
+	images   []interface{}
+	scale    float64
+	fallback rune
+}
+
+func newFont() *Font {
+	return &Font{
+		Pages:    make([]string, 0),
+		chars:    make(map[rune]Char),
+		kernings: make(map[kerningPair]int),
+		scale:    1,
+		fallback: '?',
+	}
+}
+
+// AddChar
+// en: Adds or replaces a glyph
+//
+// pt_br: Adiciona ou substitui um glifo
+func (el *Font) AddChar(char Char) {
+	el.chars[char.Id] = char
+}
+
+// AddKerning
+// en: Adds or replaces the kerning between two characters, in pixels of the font
+//
+// pt_br: Adiciona ou substitui o kerning entre dois caracteres, em pixels da fonte
+func (el *Font) AddKerning(first, second rune, amount int) {
+	el.kernings[kerningPair{first: first, second: second}] = amount
+}
+
+// GetChar
+// en: Returns the glyph of the character
+//
+// pt_br: Retorna o glifo do caractere
+func (el *Font) GetChar(character rune) (char Char, found bool) {
+	char, found = el.chars[character]
+	return
+}
+
+// GetNumChars
+// en: Returns the number of glyphs in the font
+//
+// pt_br: Retorna o número de glifos da fonte
+func (el *Font) GetNumChars() int {
+	return len(el.chars)
+}
+
+// Kerning
+// en: Returns the kerning between two characters, in pixels of the font, without
+// the scale
+//
+// pt_br: Retorna o kerning entre dois caracteres, em pixels da fonte, sem a escala
+func (el *Font) Kerning(first, second rune) int {
+	return el.kernings[kerningPair{first: first, second: second}]
+}
+
+// SetFallback
+// en: Defines the character drawn in place of characters missing in the font. The
+// default is '?'; use -1 to skip missing characters
+//
+// pt_br: Define o caractere desenhado no lugar de caracteres que faltam na fonte. O
+// padrão é '?'; use -1 para pular caracteres que faltam
+func (el *Font) SetFallback(character rune) {
+	el.fallback = character
+}
+
+// SetScale
+// en: Defines the scale used by drawing and measurement. Integer scales keep pixel
+// art sharp
+//
+// pt_br: Define a escala usada pelo desenho e pelas medidas. Escalas inteiras
+// mantêm a pixel art nítida
+func (el *Font) SetScale(scale float64) {
+	if scale <= 0 {
+		scale = 1
+	}
+	el.scale = scale
+}
+
+// GetScale
+// en: Returns the scale used by drawing and measurement
+//
+// pt_br: Retorna a escala usada pelo desenho e pelas medidas
+func (el *Font) GetScale() float64 {
+	return el.scale
+}
+
+// SetSize
+// en: Defines the scale so that the font is drawn with the size in pixels, like
+// font.Font.Size
+//
+// pt_br: Define a escala para que a fonte seja desenhada com o tamanho em pixels,
+// como font.Font.Size
+func (el *Font) SetSize(size float64) {
+	if el.Info.Size == 0 {
+		el.SetScale(1)
+		return
+	}
+	el.SetScale(size / math.Abs(float64(el.Info.Size)))
+}
+
+// GetLineHeight
+// en: Returns the distance between two lines with the scale applied
+//
+// pt_br: Retorna a distância entre duas linhas com a escala aplicada
+func (el *Font) GetLineHeight() float64 {
+	return float64(el.Common.LineHeight) * el.scale
+}
+
+// glyph
+// en: Returns the glyph used for the character, applying the fallback
+//
+// pt_br: Retorna o glifo usado para o caractere, aplicando a alternativa
+func (el *Font) glyph(character rune) (char Char, found bool) {
+	if char, found = el.chars[character]; found {
+		return
+	}
+	if el.fallback != -1 {
+		char, found = el.chars[el.fallback]
+	}
+	return
+}
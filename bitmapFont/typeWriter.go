@@ -0,0 +1,62 @@
+package bitmapFont
+
+import (
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+)
+
+// Writer
+// en: Binds a font to a platform, with the FillText() and MeasureText() methods of
+// IDraw, so the bitmap font can be used in place of the platform by textLayout and
+// by other code written for IDraw
+//
+// pt_br: Liga uma fonte a uma plataforma, com os métodos FillText() e MeasureText()
+// de IDraw, para que a fonte bitmap possa ser usada no lugar da plataforma por
+// textLayout e por outro código escrito para IDraw
+type Writer struct {
+	font     *Font
+	platform Drawer
+}
+
+// NewWriter
+// en: Returns a writer that draws with the font on the platform
+//
+// pt_br: Retorna um escritor que desenha com a fonte na plataforma
+func NewWriter(font *Font, platform Drawer) *Writer {
+	return &Writer{font: font, platform: platform}
+}
+
+// GetFont
+// en: Returns the font of the writer
+//
+// pt_br: Retorna a fonte do escritor
+func (el *Writer) GetFont() *Font {
+	return el.font
+}
+
+// MeasureText
+// en: Same as Font.MeasureText()
+//
+// pt_br: O mesmo que Font.MeasureText()
+func (el *Writer) MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics {
+	return el.font.MeasureText(text)
+}
+
+// FillText
+// en: Draws the text with the baseline at y, like IDraw.FillText()
+//
+//	maxWidth: [optional] The maximum allowed width of the text; wider texts are
+//	          compressed horizontally
+//
+// pt_br: Desenha o texto com a linha de base em y, como IDraw.FillText()
+//
+//	maxWidth: [opcional] A largura máxima permitida do texto; textos mais largos
+//	          são comprimidos horizontalmente
+func (el *Writer) FillText(text string, x, y int, maxWidth ...int) {
+	factor := 1.0
+	if len(maxWidth) != 0 && maxWidth[0] > 0 {
+		if width := el.font.MeasureText(text).Width; width > float64(maxWidth[0]) {
+			factor = float64(maxWidth[0]) / width
+		}
+	}
+	el.font.draw(el.platform, text, float64(x), float64(y), factor)
+}
@@ -0,0 +1,113 @@
+package chart
+
+import (
+	"math"
+)
+
+// Downsample
+// en: Reduces the points to threshold points with the Largest Triangle Three
+// Buckets algorithm, of Sveinn Steinarsson, which keeps the peaks and the shape
+// of the line. The first and the last points are always kept; gaps, NaN values,
+// are kept and the runs between them are reduced separately
+//
+//	threshold: Number of points wanted; below 3, or above the number of points,
+//	           the points are returned unchanged
+//
+// pt_br: Reduz os pontos para threshold pontos com o algoritmo Largest Triangle
+// Three Buckets, de Sveinn Steinarsson, que mantém os picos e a forma da linha. O
+// primeiro e o último pontos são sempre mantidos; lacunas, valores NaN, são
+// mantidas e os trechos entre elas são reduzidos separadamente
+//
+//	threshold: Número de pontos desejado; abaixo de 3, ou acima do número de
+//	           pontos, os pontos são retornados sem alteração
+func Downsample(points []Point, threshold int) []Point {
+	if threshold < 3 || len(points) <= threshold {
+		return points
+	}
+
+	runs := make([][]Point, 0)
+	start := -1
+	valid := 0
+	for i, point := range points {
+		if isGap(point.Value) {
+			if start != -1 {
+				runs = append(runs, points[start:i])
+				start = -1
+			}
+			runs = append(runs, points[i:i+1])
+			continue
+		}
+		valid += 1
+		if start == -1 {
+			start = i
+		}
+	}
+	if start != -1 {
+		runs = append(runs, points[start:])
+	}
+
+	sampled := make([]Point, 0, threshold+len(runs))
+	for _, run := range runs {
+		if len(run) == 1 && isGap(run[0].Value) {
+			sampled = append(sampled, run[0])
+			continue
+		}
+		// en: each run receives a share of the threshold proportional to its size
+		// pt_br: cada trecho recebe uma parte de threshold proporcional ao seu
+		// tamanho
+		share := int(math.Round(float64(threshold) * float64(len(run)) / float64(valid)))
+		sampled = append(sampled, lttb(run, share)...)
+	}
+	return sampled
+}
+
+// lttb
+// en: Largest Triangle Three Buckets over points without gaps
+//
+// pt_br: Largest Triangle Three Buckets sobre pontos sem lacunas
+func lttb(points []Point, threshold int) []Point {
+	if threshold < 3 || len(points) <= threshold {
+		return points
+	}
+
+	sampled := make([]Point, 0, threshold)
+	sampled = append(sampled, points[0])
+	origin := points[0].Time
+	x := func(point Point) float64 {
+		return float64(point.Time.Sub(origin))
+	}
+
+	bucket := float64(len(points)-2) / float64(threshold-2)
+	selected := 0
+	for i := 0; i < threshold-2; i += 1 {
+		// en: average of the next bucket, the third vertex of the triangle
+		// pt_br: média do próximo bucket, o terceiro vértice do triângulo
+		nextStart := int(math.Floor(float64(i+1)*bucket)) + 1
+		nextEnd := int(math.Floor(float64(i+2)*bucket)) + 1
+		if nextEnd > len(points) {
+			nextEnd = len(points)
+		}
+		averageX, averageY := 0.0, 0.0
+		for _, point := range points[nextStart:nextEnd] {
+			averageX += x(point)
+			averageY += point.Value
+		}
+		count := float64(nextEnd - nextStart)
+		averageX /= count
+		averageY /= count
+
+		start := int(math.Floor(float64(i)*bucket)) + 1
+		end := nextStart
+		anchorX, anchorY := x(points[selected]), points[selected].Value
+		largest := -1.0
+		for j := start; j < end; j += 1 {
+			area := math.Abs((anchorX-averageX)*(points[j].Value-anchorY) - (anchorX-x(points[j]))*(averageY-anchorY))
+			if area > largest {
+				largest = area
+				selected = j
+			}
+		}
+		sampled = append(sampled, points[selected])
+	}
+	return append(sampled, points[len(points)-1])
+}
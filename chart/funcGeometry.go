@@ -0,0 +1,88 @@
+package chart
+
+import (
+	"image/color"
+	"math"
+)
+
+// clip
+// en: Cuts a line, with x growing, at left and right, interpolating the points on
+// the borders
+//
+// pt_br: Corta uma linha, com x crescente, em left e right, interpolando os pontos
+// nas bordas
+func clip(points [][2]float64, left, right float64) [][2]float64 {
+	clipped := make([][2]float64, 0, len(points))
+	at := func(a, b [2]float64, x float64) [2]float64 {
+		if b[0] == a[0] {
+			return [2]float64{x, b[1]}
+		}
+		return [2]float64{x, a[1] + (b[1]-a[1])*(x-a[0])/(b[0]-a[0])}
+	}
+	for i, point := range points {
+		if i > 0 {
+			previous := points[i-1]
+			if previous[0] < left && point[0] > left {
+				clipped = append(clipped, at(previous, point, left))
+			}
+		}
+		if point[0] >= left && point[0] <= right {
+			clipped = append(clipped, point)
+		}
+		if i > 0 {
+			previous := points[i-1]
+			if previous[0] < right && point[0] > right {
+				clipped = append(clipped, at(previous, point, right))
+			}
+		}
+	}
+	return clipped
+}
+
+// fontMetrics
+// en: Returns ascent and descent of the current font, with the same fallbacks of
+// textLayout
+//
+// pt_br: Retorna ascendente e descendente da fonte atual, com as mesmas
+// alternativas de textLayout
+func fontMetrics(platform Platform) (ascent, descent float64) {
+	reference := platform.MeasureText("Mg")
+	ascent, descent = reference.FontBoundingBoxAscent, reference.FontBoundingBoxDescent
+	if ascent+descent <= 0 {
+		ascent, descent = reference.ActualBoundingBoxAscent, reference.ActualBoundingBoxDescent
+	}
+	if ascent+descent <= 0 {
+		em := reference.Width / 1.4
+		ascent, descent = 0.8*em, 0.2*em
+	}
+	return
+}
+
+// defaultColor
+// en: Returns fallback when value is the zero color
+//
+// pt_br: Retorna fallback quando value é a cor zero
+func defaultColor(value, fallback color.RGBA) color.RGBA {
+	if value == (color.RGBA{}) {
+		return fallback
+	}
+	return value
+}
+
+// crisp
+// en: Moves a coordinate to the center of the pixel, so lines of 1 pixel are not
+// blurred
+//
+// pt_br: Move uma coordenada para o centro do pixel, para que linhas de 1 pixel
+// não fiquem borradas
+func crisp(value float64) float64 {
+	return math.Floor(value) + 0.5
+}
+
+func round(value float64) int {
+	return int(math.Round(value))
+}
+
+func clamp(value, minimum, maximum float64) float64 {
+	return math.Max(minimum, math.Min(maximum, value))
+}
@@ -0,0 +1,217 @@
+package chart
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// kTimeSteps
+// en: Intervals of the ticks of the time axis, up to one day; longer ranges use
+// nice multiples of days
+//
+// pt_br: Intervalos das marcas do eixo de tempo, até um dia; faixas maiores usam
+// múltiplos agradáveis de dias
+var kTimeSteps = []time.Duration{
+	time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond,
+	10 * time.Millisecond, 20 * time.Millisecond, 50 * time.Millisecond,
+	100 * time.Millisecond, 200 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour,
+}
+
+// NiceNumber
+// en: Returns a number close to value of the form 1, 2 or 5 times a power of ten,
+// the "nice numbers" of Paul Heckbert
+//
+//	round: Rounds to the closest nice number; when false, returns the smallest
+//	       nice number not smaller than value
+//
+// pt_br: Retorna um número próximo de value da forma 1, 2 ou 5 vezes uma potência
+// de dez, os "números agradáveis" de Paul Heckbert
+//
+//	round: Arredonda para o número agradável mais próximo; quando false, retorna
+//	       o menor número agradável não menor do que value
+func NiceNumber(value float64, round bool) float64 {
+	if value <= 0 {
+		return 0
+	}
+	exponent := math.Floor(math.Log10(value))
+	fraction := value / math.Pow(10, exponent)
+	var nice float64
+	if round {
+		switch {
+		case fraction < 1.5:
+			nice = 1
+		case fraction < 3:
+			nice = 2
+		case fraction < 7:
+			nice = 5
+		default:
+			nice = 10
+		}
+	} else {
+		switch {
+		case fraction <= 1:
+			nice = 1
+		case fraction <= 2:
+			nice = 2
+		case fraction <= 5:
+			nice = 5
+		default:
+			nice = 10
+		}
+	}
+	return nice * math.Pow(10, exponent)
+}
+
+// ValueTicks
+// en: Returns about count ticks, multiples of a nice step, that cover the range
+// from minimum to maximum. The first and the last ticks are the limits of the
+// scaled axis
+//
+// pt_br: Retorna cerca de count marcas, múltiplos de um passo agradável, que
+// cobrem a faixa de minimum a maximum. A primeira e a última marcas são os
+// limites do eixo escalado
+func ValueTicks(minimum, maximum float64, count int) (ticks []float64, step float64) {
+	if count < 2 {
+		count = 2
+	}
+	if maximum < minimum {
+		minimum, maximum = maximum, minimum
+	}
+	if maximum == minimum {
+		margin := math.Max(math.Abs(minimum)*0.1, 1)
+		minimum, maximum = minimum-margin, maximum+margin
+	}
+
+	step = NiceNumber((maximum-minimum)/float64(count-1), true)
+	first := math.Floor(minimum/step) * step
+	last := math.Ceil(maximum/step) * step
+	ticks = make([]float64, 0, count+1)
+	for i := 0; ; i += 1 {
+		// en: multiplication instead of accumulation, against rounding errors
+		// pt_br: multiplicação em vez de acumulação, contra erros de arredondamento
+		value := first + float64(i)*step
+		if value > last+step/2 {
+			break
+		}
+		if math.Abs(value) < step*1e-9 {
+			value = 0
+		}
+		ticks = append(ticks, value)
+	}
+	return
+}
+
+// TimeTicks
+// en: Returns at most count ticks between start and end, aligned to the step in
+// the location given: seconds on whole seconds, hours on whole hours, days at
+// midnight
+//
+// pt_br: Retorna no máximo count marcas entre start e end, alinhadas ao passo na
+// localização dada: segundos em segundos inteiros, horas em horas inteiras, dias
+// à meia noite
+func TimeTicks(start, end time.Time, count int, location *time.Location) (ticks []time.Time, step time.Duration) {
+	if count < 1 {
+		count = 1
+	}
+	if location == nil {
+		location = time.Local
+	}
+	span := end.Sub(start)
+	if span <= 0 {
+		return []time.Time{start}, 0
+	}
+
+	for _, candidate := range kTimeSteps {
+		if span/candidate < time.Duration(count) {
+			step = candidate
+			break
+		}
+	}
+	if step == 0 {
+		days := NiceNumber(span.Hours()/24/float64(count), false)
+		step = time.Duration(math.Max(1, math.Round(days))) * 24 * time.Hour
+	}
+
+	var first time.Time
+	if step >= 24*time.Hour {
+		local := start.In(location)
+		first = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	} else {
+		// en: aligned in local time, for time zones with offsets that are not whole
+		// hours
+		// pt_br: alinhado no horário local, para fusos horários com deslocamentos
+		// que não são horas inteiras
+		_, offset := start.In(location).Zone()
+		shift := time.Duration(offset) * time.Second
+		first = start.Add(shift).Truncate(step).Add(-shift)
+	}
+	ticks = make([]time.Time, 0, count+1)
+	for tick := first; !tick.After(end); tick = tick.Add(step) {
+		if !tick.Before(start) {
+			ticks = append(ticks, tick)
+		}
+	}
+	return
+}
+
+// TimeLayout
+// en: Returns the layout of time.Format() that fits ticks with the step given
+//
+// pt_br: Retorna o layout de time.Format() adequado a marcas com o passo dado
+func TimeLayout(step time.Duration) string {
+	switch {
+	case step < time.Second:
+		return "15:04:05.000"
+	case step < time.Minute:
+		return "15:04:05"
+	case step < 24*time.Hour:
+		return "15:04"
+	default:
+		return "Jan 2"
+	}
+}
+
+// FormatValue
+// en: Formats a value of an axis with the decimals needed by the step. Steps of
+// hundreds or more use the suffixes k, M, G and T
+//
+// pt_br: Formata um valor de um eixo com as casas decimais necessárias para o
+// passo. Passos de centenas ou mais usam os sufixos k, M, G e T
+func FormatValue(value, step float64) string {
+	if value == 0 {
+		return "0"
+	}
+	suffix := ""
+	for _, unit := range []struct {
+		scale  float64
+		suffix string
+	}{{1e12, "T"}, {1e9, "G"}, {1e6, "M"}, {1e3, "k"}} {
+		if math.Abs(step) >= unit.scale/10 {
+			value /= unit.scale
+			step /= unit.scale
+			suffix = unit.suffix
+			break
+		}
+	}
+
+	decimals := 0
+	if step > 0 && step < 1 {
+		decimals = int(math.Ceil(-math.Log10(step) - 1e-9))
+		// en: steps like 0.25 need one decimal more than 0.2
+		// pt_br: passos como 0.25 precisam de uma casa decimal a mais do que 0.2
+		if scaled := step * math.Pow(10, float64(decimals)); math.Abs(scaled-math.Round(scaled)) > 1e-9 {
+			decimals += 1
+		}
+	}
+	text := strconv.FormatFloat(value, 'f', decimals, 64)
+	if strings.Trim(text, "-0.") == "" {
+		text = "0"
+	}
+	return text + suffix
+}
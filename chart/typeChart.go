@@ -0,0 +1,620 @@
+package chart
+
+import (
+	"image/color"
+	"math"
+	"time"
+)
+
+// kTickLength
+// en: Length of the tick marks of the axes, in pixels
+//
+// pt_br: Comprimento das marcas dos eixos, em pixels
+const kTickLength = 4.0
+
+// drawnPoint
+// en: Sample drawn by the last Draw(), in pixels, for Nearest()
+//
+// pt_br: Amostra desenhada pelo último Draw(), em pixels, para Nearest()
+type drawnPoint struct {
+	x     float64
+	y     float64
+	point Point
+}
+
+// legendEntry
+// en: Box of a series in the legend drawn by the last Draw()
+//
+// pt_br: Caixa de uma série na legenda desenhada pelo último Draw()
+type legendEntry struct {
+	series *Series
+	x      float64
+	y      float64
+	width  float64
+	height float64
+}
+
+// Chart
+// en: Time series chart with line, area and bar series, automatic axes and legend.
+// Append samples to the series and call Draw() on each frame; the axes follow the
+// data
+//
+// pt_br: Gráfico de séries temporais com séries de linha, área e barras, eixos
+// automáticos e legenda. Adicione amostras às séries e chame Draw() a cada
+// quadro; os eixos acompanham os dados
+type Chart struct {
+	Options Options
+
+	series  []*Series
+	plot    [4]float64
+	drawn   map[*Series][]drawnPoint
+	legend  []legendEntry
+	start   time.Time
+	end     time.Time
+	minimum float64
+	maximum float64
+}
+
+// NewChart
+// en: Returns a chart without series
+//
+// pt_br: Retorna um gráfico sem séries
+func NewChart(options Options) *Chart {
+	return &Chart{
+		Options: options,
+		series:  make([]*Series, 0),
+		drawn:   make(map[*Series][]drawnPoint),
+	}
+}
+
+// AddSeries
+// en: Adds series to the chart, drawn and listed in the legend in this order
+//
+// pt_br: Adiciona séries ao gráfico, desenhadas e listadas na legenda nesta ordem
+func (el *Chart) AddSeries(series ...*Series) {
+	el.series = append(el.series, series...)
+}
+
+// GetSeries
+// en: Returns the first series with the name, or nil
+//
+// pt_br: Retorna a primeira série com o nome, ou nil
+func (el *Chart) GetSeries(name string) *Series {
+	for _, series := range el.series {
+		if series.Name == name {
+			return series
+		}
+	}
+	return nil
+}
+
+// RemoveSeries
+// en: Removes the series with the name
+//
+// pt_br: Remove as séries com o nome
+func (el *Chart) RemoveSeries(name string) {
+	kept := el.series[:0]
+	for _, series := range el.series {
+		if series.Name != name {
+			kept = append(kept, series)
+		} else {
+			delete(el.drawn, series)
+		}
+	}
+	el.series = kept
+}
+
+// GetPlotArea
+// en: Returns the area inside the axes computed by the last Draw()
+//
+// pt_br: Retorna a área dentro dos eixos calculada pelo último Draw()
+func (el *Chart) GetPlotArea() (x, y, width, height float64) {
+	return el.plot[0], el.plot[1], el.plot[2] - el.plot[0], el.plot[3] - el.plot[1]
+}
+
+// GetRange
+// en: Returns the limits of the axes of the last Draw()
+//
+// pt_br: Retorna os limites dos eixos do último Draw()
+func (el *Chart) GetRange() (start, end time.Time, minimum, maximum float64) {
+	return el.start, el.end, el.minimum, el.maximum
+}
+
+// Nearest
+// en: Returns the sample drawn closest to the point, for tooltips; found is false
+// when the point is outside of the plot area or nothing was drawn
+//
+// pt_br: Retorna a amostra desenhada mais próxima do ponto, para dicas; found é
+// false quando o ponto está fora da área do gráfico ou nada foi desenhado
+func (el *Chart) Nearest(x, y float64) (series *Series, point Point, found bool) {
+	if x < el.plot[0] || x > el.plot[2] || y < el.plot[1] || y > el.plot[3] {
+		return nil, Point{}, false
+	}
+	best := math.Inf(1)
+	for _, candidate := range el.series {
+		for _, drawn := range el.drawn[candidate] {
+			if distance := math.Hypot(drawn.x-x, drawn.y-y); distance < best {
+				best, series, point, found = distance, candidate, drawn.point, true
+			}
+		}
+	}
+	return
+}
+
+// SeriesAtLegend
+// en: Returns the series whose legend entry is under the point, for example to
+// toggle Series.Hidden with a click
+//
+// pt_br: Retorna a série cuja entrada da legenda está sob o ponto, por exemplo
+// para alternar Series.Hidden com um clique
+func (el *Chart) SeriesAtLegend(x, y float64) (series *Series, found bool) {
+	for _, entry := range el.legend {
+		if x >= entry.x && x < entry.x+entry.width && y >= entry.y && y < entry.y+entry.height {
+			return entry.series, true
+		}
+	}
+	return nil, false
+}
+
+// Draw
+// en: Draws the chart in the rectangle given, inside Save() and Restore()
+//
+// pt_br: Desenha o gráfico no retângulo dado, dentro de Save() e Restore()
+func (el *Chart) Draw(platform Platform, x, y, width, height float64) {
+	platform.Save()
+	defer platform.Restore()
+
+	options := el.Options
+	if options.Font != nil {
+		platform.Font(*options.Font)
+	}
+	padding := options.Padding
+	if padding == 0 {
+		padding = KDefaultPadding
+	}
+	tickSpacing := options.TickSpacing
+	if tickSpacing == 0 {
+		tickSpacing = KDefaultTickSpacing
+	}
+	textColor := defaultColor(options.TextColor, KDefaultTextColor)
+	ascent, descent := fontMetrics(platform)
+	lineHeight := (ascent + descent) * 1.2
+
+	if options.Background != nil {
+		platform.SetFillStyle(options.Background)
+		platform.FillRect(round(x), round(y), round(width), round(height))
+	}
+
+	el.start, el.end = el.timeRange()
+	visible := make([][]Point, len(el.series))
+	for i, series := range el.series {
+		visible[i] = series.visible(el.start, el.end)
+	}
+
+	// en: vertical layout: title, legend, plot and time labels
+	// pt_br: diagramação vertical: título, legenda, gráfico e rótulos de tempo
+	top := y + padding
+	bottom := y + height - padding
+	titleTop := top
+	if options.Title != "" {
+		top += lineHeight
+	}
+	rows := el.legendRows(platform, width-2*padding, lineHeight)
+	legendTop := top
+	switch options.Legend {
+	case KLegendTop:
+		top += float64(len(rows)) * lineHeight
+	case KLegendBottom:
+		bottom -= float64(len(rows)) * lineHeight
+		legendTop = bottom
+	}
+	bottom -= lineHeight + kTickLength
+	if bottom-top < 1 {
+		return
+	}
+
+	// en: value axis
+	// pt_br: eixo de valores
+	el.minimum, el.maximum = el.valueRange(visible)
+	count := int((bottom-top)/tickSpacing) + 1
+	ticks, step := ValueTicks(el.minimum, el.maximum, count)
+	if options.Min == nil {
+		el.minimum = ticks[0]
+	}
+	if options.Max == nil {
+		el.maximum = ticks[len(ticks)-1]
+	}
+	if el.maximum == el.minimum {
+		el.maximum = el.minimum + 1
+	}
+	labels := make([]string, 0, len(ticks))
+	values := make([]float64, 0, len(ticks))
+	labelWidth := 0.0
+	for _, tick := range ticks {
+		if tick < el.minimum-step*1e-9 || tick > el.maximum+step*1e-9 {
+			continue
+		}
+		label := FormatValue(tick, step)
+		if options.ValueFormat != nil {
+			label = options.ValueFormat(tick, step)
+		}
+		label += options.Unit
+		labels = append(labels, label)
+		values = append(values, tick)
+		labelWidth = math.Max(labelWidth, platform.MeasureText(label).Width)
+	}
+
+	left := x + padding + labelWidth + kTickLength + 2
+	right := x + width - padding
+	if right-left < 1 {
+		return
+	}
+	el.plot = [4]float64{left, top, right, bottom}
+
+	// en: time axis
+	// pt_br: eixo de tempo
+	layout := options.TimeFormat
+	span := el.end.Sub(el.start)
+	location := options.Location
+	if location == nil {
+		location = time.Local
+	}
+	sample := TimeLayout(span / 2)
+	if layout != "" {
+		sample = layout
+	}
+	sampleWidth := platform.MeasureText(el.end.In(location).Format(sample)).Width + lineHeight
+	timeTicks, timeStep := TimeTicks(el.start, el.end, int((right-left)/math.Max(sampleWidth, 1)), location)
+	if layout == "" {
+		layout = TimeLayout(timeStep)
+	}
+
+	timeX := func(when time.Time) float64 {
+		return left + float64(when.Sub(el.start))/float64(span)*(right-left)
+	}
+	valueY := func(value float64) float64 {
+		return bottom - (value-el.minimum)/(el.maximum-el.minimum)*(bottom-top)
+	}
+
+	// en: grid, axes and labels
+	// pt_br: grade, eixos e rótulos
+	platform.SetLineWidth(1)
+	platform.SetStrokeStyle(defaultColor(options.GridColor, KDefaultGridColor))
+	platform.BeginPath()
+	for _, value := range values {
+		lineY := crisp(valueY(value))
+		platform.MoveTo(left, lineY)
+		platform.LineTo(right, lineY)
+	}
+	for _, tick := range timeTicks {
+		lineX := crisp(timeX(tick))
+		platform.MoveTo(lineX, top)
+		platform.LineTo(lineX, bottom)
+	}
+	platform.Stroke()
+
+	platform.SetStrokeStyle(defaultColor(options.AxisColor, KDefaultAxisColor))
+	platform.BeginPath()
+	platform.MoveTo(crisp(left), top)
+	platform.LineTo(crisp(left), crisp(bottom))
+	platform.LineTo(right, crisp(bottom))
+	for _, value := range values {
+		platform.MoveTo(left-kTickLength, crisp(valueY(value)))
+		platform.LineTo(left, crisp(valueY(value)))
+	}
+	for _, tick := range timeTicks {
+		platform.MoveTo(crisp(timeX(tick)), bottom)
+		platform.LineTo(crisp(timeX(tick)), bottom+kTickLength)
+	}
+	platform.Stroke()
+
+	platform.SetFillStyle(textColor)
+	middle := (ascent - descent) / 2
+	for i, label := range labels {
+		labelX := left - kTickLength - 2 - platform.MeasureText(label).Width
+		platform.FillText(label, round(labelX), round(valueY(values[i])+middle))
+	}
+	for _, tick := range timeTicks {
+		label := tick.In(location).Format(layout)
+		labelWidth := platform.MeasureText(label).Width
+		labelX := math.Max(x+padding, math.Min(timeX(tick)-labelWidth/2, x+width-padding-labelWidth))
+		platform.FillText(label, round(labelX), round(bottom+kTickLength+2+ascent))
+	}
+
+	// en: series: bars below, then areas, then lines
+	// pt_br: séries: barras embaixo, depois áreas, depois linhas
+	el.drawn = make(map[*Series][]drawnPoint)
+	el.drawBars(platform, visible, timeX, valueY)
+	for _, kind := range []Kind{KKindArea, KKindLine} {
+		for i, series := range el.series {
+			if series.Kind == kind && !series.Hidden {
+				el.drawLine(platform, series, visible[i], timeX, valueY)
+			}
+		}
+	}
+
+	if options.Title != "" {
+		titleWidth := platform.MeasureText(options.Title).Width
+		platform.SetFillStyle(textColor)
+		platform.FillText(options.Title, round(x+(width-titleWidth)/2), round(titleTop+ascent))
+	}
+	el.legend = el.legend[:0]
+	if options.Legend != KLegendNone {
+		el.drawLegend(platform, rows, x, legendTop, width, lineHeight, ascent, descent, textColor)
+	}
+}
+
+// timeRange
+// en: Returns the limits of the time axis
+//
+// pt_br: Retorna os limites do eixo de tempo
+func (el *Chart) timeRange() (start, end time.Time) {
+	found := false
+	gap := time.Duration(math.MaxInt64)
+	for _, series := range el.series {
+		if len(series.points) == 0 {
+			continue
+		}
+		first, last := series.points[0].Time, series.points[len(series.points)-1].Time
+		if !found || first.Before(start) {
+			start = first
+		}
+		if !found || last.After(end) {
+			end = last
+		}
+		found = true
+		if series.Kind == KKindBar && !series.Hidden {
+			for i := 1; i < len(series.points); i += 1 {
+				if difference := series.points[i].Time.Sub(series.points[i-1].Time); difference > 0 && difference < gap {
+					gap = difference
+				}
+			}
+		}
+	}
+	if !found {
+		end = time.Now()
+		start = end.Add(-time.Minute)
+	}
+	if el.Options.TimeSpan > 0 {
+		start = end.Add(-el.Options.TimeSpan)
+	} else if gap != time.Duration(math.MaxInt64) {
+		// en: room for the first and the last bars
+		// pt_br: espaço para a primeira e a última barras
+		start, end = start.Add(-gap/2), end.Add(gap/2)
+	}
+	if !end.After(start) {
+		start, end = start.Add(-time.Second), end.Add(time.Second)
+	}
+	return
+}
+
+// valueRange
+// en: Returns the limits of the value axis: the fixed limits of the options or the
+// limits of the visible samples
+//
+// pt_br: Retorna os limites do eixo de valores: os limites fixos das opções ou os
+// limites das amostras visíveis
+func (el *Chart) valueRange(visible [][]Point) (minimum, maximum float64) {
+	minimum, maximum = math.Inf(1), math.Inf(-1)
+	for i, series := range el.series {
+		if series.Hidden {
+			continue
+		}
+		if series.Kind == KKindBar {
+			minimum, maximum = math.Min(minimum, 0), math.Max(maximum, 0)
+		}
+		for _, point := range visible[i] {
+			if isGap(point.Value) || point.Time.Before(el.start) || point.Time.After(el.end) {
+				continue
+			}
+			minimum, maximum = math.Min(minimum, point.Value), math.Max(maximum, point.Value)
+		}
+	}
+	if math.IsInf(minimum, 1) {
+		minimum, maximum = 0, 1
+	}
+	if el.Options.ZeroBased {
+		minimum, maximum = math.Min(minimum, 0), math.Max(maximum, 0)
+	}
+	if el.Options.Min != nil {
+		minimum = *el.Options.Min
+	}
+	if el.Options.Max != nil {
+		maximum = *el.Options.Max
+	}
+	return
+}
+
+// drawBars
+// en: Draws the bar series, side by side around the time of each sample
+//
+// pt_br: Desenha as séries de barras, lado a lado em volta do tempo de cada
+// amostra
+func (el *Chart) drawBars(platform Platform, visible [][]Point, timeX func(time.Time) float64, valueY func(float64) float64) {
+	indexes := make([]int, 0)
+	gap := math.Inf(1)
+	for i, series := range el.series {
+		if series.Kind != KKindBar || series.Hidden {
+			continue
+		}
+		indexes = append(indexes, i)
+		for j := 1; j < len(visible[i]); j += 1 {
+			if difference := timeX(visible[i][j].Time) - timeX(visible[i][j-1].Time); difference > 0 {
+				gap = math.Min(gap, difference)
+			}
+		}
+	}
+	if len(indexes) == 0 {
+		return
+	}
+	if math.IsInf(gap, 1) {
+		gap = (el.plot[2] - el.plot[0]) / 10
+	}
+
+	group := gap * 0.8
+	each := group / float64(len(indexes))
+	base := valueY(math.Max(el.minimum, math.Min(el.maximum, 0)))
+	for position, index := range indexes {
+		series := el.series[index]
+		platform.SetFillStyle(series.Color)
+		for _, point := range visible[index] {
+			if isGap(point.Value) || point.Time.Before(el.start) || point.Time.After(el.end) {
+				continue
+			}
+			barX := timeX(point.Time) - group/2 + float64(position)*each
+			barY := clamp(valueY(point.Value), el.plot[1], el.plot[3])
+			left, right := round(math.Max(barX, el.plot[0])), round(math.Min(barX+each, el.plot[2]))
+			if right-left < 1 {
+				right = left + 1
+			}
+			top, bottom := round(math.Min(barY, base)), round(math.Max(barY, base))
+			platform.FillRect(left, top, right-left, bottom-top)
+			el.drawn[series] = append(el.drawn[series], drawnPoint{x: barX + each/2, y: barY, point: point})
+		}
+	}
+}
+
+// drawLine
+// en: Draws a line or area series, reduced with Downsample() and cut at the
+// borders of the plot
+//
+// pt_br: Desenha uma série de linha ou área, reduzida com Downsample() e cortada
+// nas bordas do gráfico
+func (el *Chart) drawLine(platform Platform, series *Series, points []Point, timeX func(time.Time) float64, valueY func(float64) float64) {
+	maxPoints := el.Options.MaxPoints
+	if maxPoints == 0 {
+		maxPoints = int(el.plot[2] - el.plot[0])
+	}
+	points = Downsample(points, maxPoints)
+
+	runs := make([][][2]float64, 0)
+	run := make([][2]float64, 0, len(points))
+	for _, point := range points {
+		if isGap(point.Value) {
+			runs = append(runs, run)
+			run = make([][2]float64, 0)
+			continue
+		}
+		screen := [2]float64{timeX(point.Time), clamp(valueY(point.Value), el.plot[1], el.plot[3])}
+		run = append(run, screen)
+		if !point.Time.Before(el.start) && !point.Time.After(el.end) {
+			el.drawn[series] = append(el.drawn[series], drawnPoint{x: screen[0], y: screen[1], point: point})
+		}
+	}
+	runs = append(runs, run)
+
+	base := valueY(math.Max(el.minimum, math.Min(el.maximum, 0)))
+	area := color.RGBA{R: series.Color.R, G: series.Color.G, B: series.Color.B}
+	area.A = uint8(math.Round(float64(series.Color.A) * clamp(series.AreaOpacity, 0, 1)))
+	for _, run := range runs {
+		run = clip(run, el.plot[0], el.plot[2])
+		if len(run) == 0 {
+			continue
+		}
+		if len(run) == 1 {
+			// en: an isolated sample is drawn as a dot
+			// pt_br: uma amostra isolada é desenhada como um ponto
+			size := math.Max(series.LineWidth, 1) * 2
+			platform.SetFillStyle(series.Color)
+			platform.FillRect(round(run[0][0]-size/2), round(run[0][1]-size/2), round(size), round(size))
+			continue
+		}
+
+		if series.Kind == KKindArea {
+			platform.SetFillStyle(area)
+			platform.BeginPath()
+			platform.MoveTo(run[0][0], base)
+			for _, point := range run {
+				platform.LineTo(point[0], point[1])
+			}
+			platform.LineTo(run[len(run)-1][0], base)
+			platform.ClosePath(run[0][0], base)
+			platform.Fill()
+		}
+
+		platform.SetStrokeStyle(series.Color)
+		platform.SetLineWidth(series.LineWidth)
+		platform.BeginPath()
+		platform.MoveTo(run[0][0], run[0][1])
+		for _, point := range run[1:] {
+			platform.LineTo(point[0], point[1])
+		}
+		platform.Stroke()
+	}
+}
+
+// legendRows
+// en: Distributes the legend entries in rows that fit the width; each row has the
+// indexes of the series and the width of the row
+//
+// pt_br: Distribui as entradas da legenda em linhas que cabem na largura; cada
+// linha tem os índices das séries e a largura da linha
+func (el *Chart) legendRows(platform Platform, width, lineHeight float64) (rows [][]int) {
+	if el.Options.Legend == KLegendNone || len(el.series) == 0 {
+		return nil
+	}
+	rows = make([][]int, 0)
+	row := make([]int, 0)
+	used := 0.0
+	for i := range el.series {
+		entry := el.legendWidth(platform, i, lineHeight)
+		if len(row) != 0 && used+entry > width {
+			rows = append(rows, row)
+			row, used = make([]int, 0), 0
+		}
+		row = append(row, i)
+		used += entry
+	}
+	return append(rows, row)
+}
+
+// legendWidth
+// en: Returns the width of a legend entry: swatch, name and spacing
+//
+// pt_br: Retorna a largura de uma entrada da legenda: amostra de cor, nome e
+// espaçamento
+func (el *Chart) legendWidth(platform Platform, index int, lineHeight float64) float64 {
+	return lineHeight*0.8 + 4 + platform.MeasureText(el.series[index].Name).Width + lineHeight
+}
+
+// drawLegend
+// en: Draws the legend rows, centered; hidden series have a hollow swatch
+//
+// pt_br: Desenha as linhas da legenda, centralizadas; séries ocultas têm uma
+// amostra de cor vazada
+func (el *Chart) drawLegend(platform Platform, rows [][]int, x, y, width, lineHeight, ascent, descent float64, textColor color.RGBA) {
+	swatch := lineHeight * 0.8
+	for rowIndex, row := range rows {
+		rowWidth := 0.0
+		for _, index := range row {
+			rowWidth += el.legendWidth(platform, index, lineHeight)
+		}
+		// en: the spacing after the last entry is not centered
+		// pt_br: o espaçamento depois da última entrada não é centralizado
+		entryX := x + (width-rowWidth+lineHeight)/2
+		rowY := y + float64(rowIndex)*lineHeight
+		for _, index := range row {
+			series := el.series[index]
+			entryWidth := el.legendWidth(platform, index, lineHeight)
+			swatchY := rowY + (lineHeight-swatch)/2
+			if series.Hidden {
+				platform.SetStrokeStyle(series.Color)
+				platform.SetLineWidth(1)
+				platform.BeginPath()
+				platform.MoveTo(crisp(entryX), crisp(swatchY))
+				platform.LineTo(crisp(entryX+swatch), crisp(swatchY))
+				platform.LineTo(crisp(entryX+swatch), crisp(swatchY+swatch))
+				platform.LineTo(crisp(entryX), crisp(swatchY+swatch))
+				platform.ClosePath(crisp(entryX), crisp(swatchY))
+				platform.Stroke()
+			} else {
+				platform.SetFillStyle(series.Color)
+				platform.FillRect(round(entryX), round(swatchY), round(swatch), round(swatch))
+			}
+			platform.SetFillStyle(textColor)
+			platform.FillText(series.Name, round(entryX+swatch+4), round(rowY+(lineHeight-ascent-descent)/2+ascent))
+			el.legend = append(el.legend, legendEntry{series: series, x: entryX, y: rowY, width: entryWidth - lineHeight, height: lineHeight})
+			entryX += entryWidth
+		}
+	}
+}
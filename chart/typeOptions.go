@@ -0,0 +1,148 @@
+package chart
+
+import (
+	"image/color"
+	"time"
+
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// Platform
+// en: Methods of IDraw used to draw charts
+//
+// pt_br: Métodos de IDraw usados para desenhar gráficos
+type Platform interface {
+	Font(font font.Font)
+	MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics
+	FillText(text string, x, y int, maxWidth ...int)
+	FillRect(x, y, width, height int)
+	SetFillStyle(value interface{})
+	SetStrokeStyle(value interface{})
+	SetLineWidth(value interface{})
+	BeginPath()
+	MoveTo(x, y interface{})
+	LineTo(x, y interface{})
+	ClosePath(x, y interface{})
+	Stroke()
+	Fill()
+	Save()
+	Restore()
+}
+
+// Legend
+// en: Position of the legend
+//
+// pt_br: Posição da legenda
+type Legend int
+
+const (
+	KLegendTop Legend = iota
+	KLegendBottom
+	KLegendNone
+)
+
+// KDefaultPadding
+// en: Space around the chart, in pixels, used when Options.Padding is zero
+//
+// pt_br: Espaço em volta do gráfico, em pixels, usado quando Options.Padding é
+// zero
+const KDefaultPadding = 8.0
+
+// KDefaultTickSpacing
+// en: Minimum distance between the ticks of the value axis, in pixels, used when
+// Options.TickSpacing is zero
+//
+// pt_br: Distância mínima entre as marcas do eixo de valores, em pixels, usada
+// quando Options.TickSpacing é zero
+const KDefaultTickSpacing = 40.0
+
+var (
+	// KDefaultTextColor
+	// en: Color of the texts when Options.TextColor is zero
+	//
+	// pt_br: Cor dos textos quando Options.TextColor é zero
+	KDefaultTextColor = color.RGBA{R: 0x33, G: 0x33, B: 0x33, A: 0xff}
+
+	// KDefaultAxisColor
+	// en: Color of the axes when Options.AxisColor is zero
+	//
+	// pt_br: Cor dos eixos quando Options.AxisColor é zero
+	KDefaultAxisColor = color.RGBA{R: 0x66, G: 0x66, B: 0x66, A: 0xff}
+
+	// KDefaultGridColor
+	// en: Color of the grid when Options.GridColor is zero
+	//
+	// pt_br: Cor da grade quando Options.GridColor é zero
+	KDefaultGridColor = color.RGBA{R: 0xdd, G: 0xdd, B: 0xdd, A: 0xff}
+)
+
+// Options
+// en: Configuration of a chart
+//
+//	Title: Optional text above the chart
+//	Font: Optional; when nil, the current font of the platform is used
+//	Background: Optional color or gradient of the whole chart
+//	TextColor, AxisColor, GridColor: Zero uses the KDefault colors
+//	Legend: KLegendTop, KLegendBottom or KLegendNone
+//	TimeSpan: Width of the time axis, ending at the newest sample, for streaming
+//	          data; 0 shows every sample
+//	Min, Max: Optional fixed limits of the value axis; nil scales automatically
+//	ZeroBased: The automatic scale always includes zero; bar charts always do
+//	Unit: Text added after the labels of the value axis, like "°C"
+//	ValueFormat: Optional; formats the labels of the value axis. The default is
+//	             FormatValue()
+//	TimeFormat: Optional layout of time.Format() for the time axis; empty
+//	            chooses by the interval of the ticks, with TimeLayout()
+//	Location: Time zone of the time axis; nil is time.Local
+//	MaxPoints: Maximum number of points drawn per line or area series; larger
+//	           series are reduced with Downsample(). 0 uses one point per pixel
+//	Padding: Space around the chart, in pixels; 0 uses KDefaultPadding
+//	TickSpacing: Minimum distance between value ticks; 0 uses
+//	             KDefaultTickSpacing
+//
+// pt_br: Configuração de um gráfico
+//
+//	Title: Texto opcional acima do gráfico
+//	Font: Opcional; quando nil, a fonte atual da plataforma é usada
+//	Background: Cor ou gradiente opcional de todo o gráfico
+//	TextColor, AxisColor, GridColor: Zero usa as cores KDefault
+//	Legend: KLegendTop, KLegendBottom ou KLegendNone
+//	TimeSpan: Largura do eixo de tempo, terminando na amostra mais nova, para
+//	          dados contínuos; 0 mostra todas as amostras
+//	Min, Max: Limites fixos opcionais do eixo de valores; nil escala
+//	          automaticamente
+//	ZeroBased: A escala automática sempre inclui o zero; gráficos de barras
+//	           sempre incluem
+//	Unit: Texto adicionado depois dos rótulos do eixo de valores, como "°C"
+//	ValueFormat: Opcional; formata os rótulos do eixo de valores. O padrão é
+//	             FormatValue()
+//	TimeFormat: Layout opcional de time.Format() para o eixo de tempo; vazio
+//	            escolhe pelo intervalo das marcas, com TimeLayout()
+//	Location: Fuso horário do eixo de tempo; nil é time.Local
+//	MaxPoints: Número máximo de pontos desenhados por série de linha ou área;
+//	           séries maiores são reduzidas com Downsample(). 0 usa um ponto por
+//	           pixel
+//	Padding: Espaço em volta do gráfico, em pixels; 0 usa KDefaultPadding
+//	TickSpacing: Distância mínima entre as marcas de valores; 0 usa
+//	             KDefaultTickSpacing
+type Options struct {
+	Title       string
+	Font        *font.Font
+	Background  interface{}
+	TextColor   color.RGBA
+	AxisColor   color.RGBA
+	GridColor   color.RGBA
+	Legend      Legend
+	TimeSpan    time.Duration
+	Min         *float64
+	Max         *float64
+	ZeroBased   bool
+	Unit        string
+	ValueFormat func(value, step float64) string
+	TimeFormat  string
+	Location    *time.Location
+	MaxPoints   int
+	Padding     float64
+	TickSpacing float64
+}
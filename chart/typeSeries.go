@@ -0,0 +1,224 @@
+package chart
+
+import (
+	"image/color"
+	"math"
+	"sort"
+	"time"
+)
+
+// Kind
+// en: How a series is drawn
+//
+// pt_br: Como uma série é desenhada
+type Kind int
+
+const (
+	KKindLine Kind = iota
+	KKindArea
+	KKindBar
+)
+
+// Point
+// en: Sample of a time series. A NaN value is a gap: the line is interrupted
+//
+// pt_br: Amostra de uma série temporal. Um valor NaN é uma lacuna: a linha é
+// interrompida
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series
+// en: Samples of a time series, kept in time order, with an optional sliding
+// window for streaming data
+//
+//	Name: Text of the legend
+//	Kind: KKindLine, KKindArea or KKindBar
+//	Color: Color of the line, of the area and of the bars
+//	LineWidth: Width of the line, in pixels
+//	AreaOpacity: Opacity of the fill of KKindArea, 0 to 1
+//	Hidden: The series is kept in the legend, but not drawn
+//
+// pt_br: Amostras de uma série temporal, mantidas em ordem de tempo, com uma
+// janela deslizante opcional para dados contínuos
+//
+//	Name: Texto da legenda
+//	Kind: KKindLine, KKindArea ou KKindBar
+//	Color: Cor da linha, da área e das barras
+//	LineWidth: Espessura da linha, em pixels
+//	AreaOpacity: Opacidade do preenchimento de KKindArea, 0 a 1
+//	Hidden: A série é mantida na legenda, mas não é desenhada
+type Series struct {
+	Name        string
+	Kind        Kind
+	Color       color.RGBA
+	LineWidth   float64
+	AreaOpacity float64
+	Hidden      bool
+
+	points    []Point
+	maxPoints int
+	maxAge    time.Duration
+}
+
+// NewSeries
+// en: Returns an empty series with a line width of 2 pixels and an area opacity of
+// 0.25
+//
+// pt_br: Retorna uma série vazia com espessura de linha de 2 pixels e opacidade de
+// área de 0.25
+func NewSeries(name string, kind Kind, seriesColor color.RGBA) *Series {
+	return &Series{
+		Name:        name,
+		Kind:        kind,
+		Color:       seriesColor,
+		LineWidth:   2,
+		AreaOpacity: 0.25,
+		points:      make([]Point, 0),
+	}
+}
+
+// SetWindow
+// en: Defines the sliding window of the series. The oldest points are dropped when
+// the series has more than maxPoints points or when they are older than maxAge
+// relative to the newest point
+//
+//	maxPoints: 0 is unlimited
+//	maxAge: 0 is unlimited
+//
+// pt_br: Define a janela deslizante da série. Os pontos mais antigos são
+// descartados quando a série tem mais de maxPoints pontos ou quando são mais
+// antigos do que maxAge em relação ao ponto mais novo
+//
+//	maxPoints: 0 é ilimitado
+//	maxAge: 0 é ilimitado
+func (el *Series) SetWindow(maxPoints int, maxAge time.Duration) {
+	el.maxPoints = maxPoints
+	el.maxAge = maxAge
+	el.trim()
+}
+
+// Append
+// en: Adds a sample. Samples out of order are inserted in their place
+//
+// pt_br: Adiciona uma amostra. Amostras fora de ordem são inseridas no seu lugar
+func (el *Series) Append(when time.Time, value float64) {
+	el.insert(Point{Time: when, Value: value})
+	el.trim()
+}
+
+// AppendPoints
+// en: Adds several samples
+//
+// pt_br: Adiciona várias amostras
+func (el *Series) AppendPoints(points ...Point) {
+	for _, point := range points {
+		el.insert(point)
+	}
+	el.trim()
+}
+
+// GetPoints
+// en: Returns a copy of the samples, in time order
+//
+// pt_br: Retorna uma cópia das amostras, em ordem de tempo
+func (el *Series) GetPoints() []Point {
+	points := make([]Point, len(el.points))
+	copy(points, el.points)
+	return points
+}
+
+// GetLast
+// en: Returns the newest sample; found is false when the series is empty
+//
+// pt_br: Retorna a amostra mais nova; found é false quando a série está vazia
+func (el *Series) GetLast() (point Point, found bool) {
+	if len(el.points) == 0 {
+		return Point{}, false
+	}
+	return el.points[len(el.points)-1], true
+}
+
+// Len
+// en: Returns the number of samples
+//
+// pt_br: Retorna o número de amostras
+func (el *Series) Len() int {
+	return len(el.points)
+}
+
+// Clear
+// en: Removes all samples
+//
+// pt_br: Remove todas as amostras
+func (el *Series) Clear() {
+	el.points = el.points[:0]
+}
+
+// insert
+// en: Appends the point, or inserts it after the points with the same time or
+// older
+//
+// pt_br: Anexa o ponto, ou o insere depois dos pontos com o mesmo tempo ou mais
+// antigos
+func (el *Series) insert(point Point) {
+	count := len(el.points)
+	if count == 0 || !point.Time.Before(el.points[count-1].Time) {
+		el.points = append(el.points, point)
+		return
+	}
+	index := sort.Search(count, func(i int) bool { return el.points[i].Time.After(point.Time) })
+	el.points = append(el.points, Point{})
+	copy(el.points[index+1:], el.points[index:])
+	el.points[index] = point
+}
+
+// trim
+// en: Drops the points out of the sliding window
+//
+// pt_br: Descarta os pontos fora da janela deslizante
+func (el *Series) trim() {
+	drop := 0
+	if el.maxPoints > 0 && len(el.points) > el.maxPoints {
+		drop = len(el.points) - el.maxPoints
+	}
+	if el.maxAge > 0 && len(el.points) != 0 {
+		limit := el.points[len(el.points)-1].Time.Add(-el.maxAge)
+		for drop < len(el.points) && el.points[drop].Time.Before(limit) {
+			drop += 1
+		}
+	}
+	if drop != 0 {
+		// en: append reallocates from the new head, so the dropped points are freed
+		// pt_br: append realoca a partir do novo início, então os pontos
+		// descartados são liberados
+		el.points = el.points[drop:]
+	}
+}
+
+// visible
+// en: Returns the points between start and end, with the neighbour outside of each
+// side, so lines can reach the borders of the plot
+//
+// pt_br: Retorna os pontos entre start e end, com o vizinho de fora de cada lado,
+// para que as linhas alcancem as bordas do gráfico
+func (el *Series) visible(start, end time.Time) []Point {
+	first := sort.Search(len(el.points), func(i int) bool { return !el.points[i].Time.Before(start) })
+	last := sort.Search(len(el.points), func(i int) bool { return el.points[i].Time.After(end) })
+	if first > 0 {
+		first -= 1
+	}
+	if last < len(el.points) {
+		last += 1
+	}
+	return el.points[first:last]
+}
+
+// isGap
+// en: Returns true for the values that interrupt the line
+//
+// pt_br: Retorna true para os valores que interrompem a linha
+func isGap(value float64) bool {
+	return math.IsNaN(value) || math.IsInf(value, 0)
+}
@@ -0,0 +1,122 @@
+package collision
+
+import "math"
+
+// Intersects
+// en: Returns true if the two shapes overlap. The bounding boxes are tested first
+// and the separating axis theorem (SAT) is used when at least one of the shapes is
+// a circle or a polygon
+//
+//	a, b: AABB, Circle or Polygon
+//
+// pt_br: Retorna true se as duas formas se sobrepõem. As caixas delimitadoras são
+// testadas primeiro e o teorema do eixo separador (SAT) é usado quando pelo menos
+// uma das formas é um círculo ou um polígono
+//
+//	a, b: AABB, Circle ou Polygon
+func Intersects(a, b Shape) bool {
+	if !a.GetBounds().Intersects(b.GetBounds()) {
+		return false
+	}
+
+	switch shapeA := a.(type) {
+	case AABB:
+		switch shapeB := b.(type) {
+		case AABB:
+			return true
+		case Circle:
+			return IntersectsAABBCircle(shapeA, shapeB)
+		}
+	case Circle:
+		switch shapeB := b.(type) {
+		case AABB:
+			return IntersectsAABBCircle(shapeB, shapeA)
+		case Circle:
+			return IntersectsCircleCircle(shapeA, shapeB)
+		}
+	}
+
+	return separatingAxis(a, b)
+}
+
+// IntersectsAABBCircle
+// en: Returns true if the box and the circle overlap
+//
+// pt_br: Retorna true se a caixa e o círculo se sobrepõem
+func IntersectsAABBCircle(box AABB, circle Circle) bool {
+	return box.DistanceToPoint(circle.X, circle.Y) <= circle.Radius
+}
+
+// IntersectsCircleCircle
+// en: Returns true if the two circles overlap
+//
+// pt_br: Retorna true se os dois círculos se sobrepõem
+func IntersectsCircleCircle(a, b Circle) bool {
+	return math.Hypot(a.X-b.X, a.Y-b.Y) <= a.Radius+b.Radius
+}
+
+// IntersectsPolygonPolygon
+// en: Returns true if the two convex polygons overlap, using the separating axis
+// theorem
+//
+// pt_br: Retorna true se os dois polígonos convexos se sobrepõem, usando o teorema
+// do eixo separador
+func IntersectsPolygonPolygon(a, b Polygon) bool {
+	return separatingAxis(a, b)
+}
+
+// separatingAxis
+// en: Looks for an axis where the projections of the two shapes do not overlap.
+// Polygon and box edges give their normals; a circle gives the axis from its
+// center to the nearest vertex of the other shape
+//
+// pt_br: Procura um eixo onde as projeções das duas formas não se sobrepõem. Arestas
+// de polígonos e caixas fornecem suas normais; um círculo fornece o eixo do seu
+// centro até o vértice mais próximo da outra forma
+func separatingAxis(a, b Shape) bool {
+	axes := make([]Vector, 0)
+	axes = append(axes, shapeAxes(a, b)...)
+	axes = append(axes, shapeAxes(b, a)...)
+
+	for _, axis := range axes {
+		minA, maxA := a.project(axis)
+		minB, maxB := b.project(axis)
+		if maxA < minB || maxB < minA {
+			return false
+		}
+	}
+	return true
+}
+
+func shapeAxes(shape, other Shape) []Vector {
+	switch converted := shape.(type) {
+	case AABB:
+		return []Vector{{X: 1, Y: 0}, {X: 0, Y: 1}}
+	case Polygon:
+		return converted.axes()
+	case Circle:
+		center := Vector{X: converted.X, Y: converted.Y}
+		var vertices []Vector
+		switch otherConverted := other.(type) {
+		case AABB:
+			vertices = otherConverted.vertices()
+		case Polygon:
+			vertices = otherConverted.vertices()
+		}
+		if len(vertices) == 0 {
+			return nil
+		}
+		nearest := vertices[0]
+		for _, vertex := range vertices[1:] {
+			if vertex.Sub(center).Length() < nearest.Sub(center).Length() {
+				nearest = vertex
+			}
+		}
+		axis := nearest.Sub(center).Normalize()
+		if axis.X == 0 && axis.Y == 0 {
+			return nil
+		}
+		return []Vector{axis}
+	}
+	return nil
+}
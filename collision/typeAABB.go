@@ -0,0 +1,129 @@
+package collision
+
+import "math"
+
+// AABB
+// en: Axis aligned bounding box used by the broad-phase collision tests and by the
+// spatial indexes
+//
+//	X: The x-coordinate of the upper-left corner of the box
+//	Y: The y-coordinate of the upper-left corner of the box
+//	Width: The width of the box, in pixels
+//	Height: The height of the box, in pixels
+//
+// pt_br: Caixa alinhada aos eixos usada pelos testes de colisão de fase larga e
+// pelos índices espaciais
+//
+//	X: Coordenada x do canto superior esquerdo da caixa
+//	Y: Coordenada y do canto superior esquerdo da caixa
+//	Width: Comprimento da caixa em pixels
+//	Height: Altura da caixa em pixels
+type AABB struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// NewAABB
+// en: Returns a new AABB with the upper-left corner at (x, y)
+//
+// pt_br: Retorna uma nova AABB com o canto superior esquerdo em (x, y)
+func NewAABB(x, y, width, height float64) AABB {
+	return AABB{X: x, Y: y, Width: width, Height: height}
+}
+
+// GetBounds
+// en: Returns the box itself, so AABB can be used as a Shape
+//
+// pt_br: Retorna a própria caixa, para que AABB possa ser usada como Shape
+func (el AABB) GetBounds() AABB {
+	return el
+}
+
+// MaxX
+// en: Returns the x-coordinate of the right side of the box
+//
+// pt_br: Retorna a coordenada x do lado direito da caixa
+func (el AABB) MaxX() float64 {
+	return el.X + el.Width
+}
+
+// MaxY
+// en: Returns the y-coordinate of the bottom side of the box
+//
+// pt_br: Retorna a coordenada y do lado inferior da caixa
+func (el AABB) MaxY() float64 {
+	return el.Y + el.Height
+}
+
+// Center
+// en: Returns the center point of the box
+//
+// pt_br: Retorna o ponto central da caixa
+func (el AABB) Center() (x, y float64) {
+	return el.X + el.Width/2, el.Y + el.Height/2
+}
+
+// ContainsPoint
+// en: Returns true if the point (x, y) is inside the box or on its border
+//
+// pt_br: Retorna true se o ponto (x, y) estiver dentro da caixa ou na sua borda
+func (el AABB) ContainsPoint(x, y float64) bool {
+	return x >= el.X && x <= el.MaxX() && y >= el.Y && y <= el.MaxY()
+}
+
+// Contains
+// en: Returns true if the box completely contains the other box
+//
+// pt_br: Retorna true se a caixa contém completamente a outra caixa
+func (el AABB) Contains(other AABB) bool {
+	return other.X >= el.X && other.MaxX() <= el.MaxX() && other.Y >= el.Y && other.MaxY() <= el.MaxY()
+}
+
+// Intersects
+// en: Returns true if the two boxes overlap. Boxes that only touch on the border
+// are considered colliding
+//
+// pt_br: Retorna true se as duas caixas se sobrepõem. Caixas que apenas se tocam na
+// borda são consideradas em colisão
+func (el AABB) Intersects(other AABB) bool {
+	return el.X <= other.MaxX() && other.X <= el.MaxX() && el.Y <= other.MaxY() && other.Y <= el.MaxY()
+}
+
+// Union
+// en: Returns the smallest box that contains both boxes
+//
+// pt_br: Retorna a menor caixa que contém as duas caixas
+func (el AABB) Union(other AABB) AABB {
+	minX := math.Min(el.X, other.X)
+	minY := math.Min(el.Y, other.Y)
+	maxX := math.Max(el.MaxX(), other.MaxX())
+	maxY := math.Max(el.MaxY(), other.MaxY())
+	return AABB{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// DistanceToPoint
+// en: Returns the distance between the point (x, y) and the nearest point of the
+// box. Points inside the box have distance zero
+//
+// pt_br: Retorna a distância entre o ponto (x, y) e o ponto mais próximo da caixa.
+// Pontos dentro da caixa têm distância zero
+func (el AABB) DistanceToPoint(x, y float64) float64 {
+	dx := math.Max(0, math.Max(el.X-x, x-el.MaxX()))
+	dy := math.Max(0, math.Max(el.Y-y, y-el.MaxY()))
+	return math.Hypot(dx, dy)
+}
+
+func (el AABB) project(axis Vector) (min, max float64) {
+	return projectPoints(el.vertices(), axis)
+}
+
+func (el AABB) vertices() []Vector {
+	return []Vector{
+		{X: el.X, Y: el.Y},
+		{X: el.MaxX(), Y: el.Y},
+		{X: el.MaxX(), Y: el.MaxY()},
+		{X: el.X, Y: el.MaxY()},
+	}
+}
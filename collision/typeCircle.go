@@ -0,0 +1,50 @@
+package collision
+
+import "math"
+
+// Circle
+// en: Circle shape for collision tests
+//
+//	X: The x-coordinate of the center of the circle
+//	Y: The y-coordinate of the center of the circle
+//	Radius: The radius of the circle, in pixels
+//
+// pt_br: Forma de círculo para os testes de colisão
+//
+//	X: Coordenada x do centro do círculo
+//	Y: Coordenada y do centro do círculo
+//	Radius: Raio do círculo em pixels
+type Circle struct {
+	X      float64
+	Y      float64
+	Radius float64
+}
+
+// NewCircle
+// en: Returns a new circle with center at (x, y)
+//
+// pt_br: Retorna um novo círculo com centro em (x, y)
+func NewCircle(x, y, radius float64) Circle {
+	return Circle{X: x, Y: y, Radius: radius}
+}
+
+// GetBounds
+// en: Returns the bounding box of the circle
+//
+// pt_br: Retorna a caixa delimitadora do círculo
+func (el Circle) GetBounds() AABB {
+	return AABB{X: el.X - el.Radius, Y: el.Y - el.Radius, Width: el.Radius * 2, Height: el.Radius * 2}
+}
+
+// ContainsPoint
+// en: Returns true if the point (x, y) is inside the circle or on its border
+//
+// pt_br: Retorna true se o ponto (x, y) estiver dentro do círculo ou na sua borda
+func (el Circle) ContainsPoint(x, y float64) bool {
+	return math.Hypot(x-el.X, y-el.Y) <= el.Radius
+}
+
+func (el Circle) project(axis Vector) (min, max float64) {
+	center := Vector{X: el.X, Y: el.Y}.Dot(axis)
+	return center - el.Radius, center + el.Radius
+}
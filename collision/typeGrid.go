@@ -0,0 +1,246 @@
+package collision
+
+import (
+	"math"
+)
+
+// Grid
+// en: SpatialIndex that divides the world in cells of the same size. Each element
+// is registered in every cell touched by its bounds. The grid works best when the
+// elements have similar sizes and the cell size is close to the size of the
+// elements
+//
+// pt_br: SpatialIndex que divide o mundo em células do mesmo tamanho. Cada elemento
+// é registrado em todas as células tocadas pelos seus limites. A grade funciona
+// melhor quando os elementos têm tamanhos parecidos e o tamanho da célula é próximo
+// ao tamanho dos elementos
+type Grid struct {
+	cellSize float64
+	cells    map[gridCell]map[string]struct{}
+	items    map[string]AABB
+
+	// en: range of cells ever used, limits the nearest neighbour search
+	// pt_br: faixa de células já usadas, limita a busca do vizinho mais próximo
+	minCell gridCell
+	maxCell gridCell
+}
+
+type gridCell struct {
+	x int
+	y int
+}
+
+// NewGrid
+// en: Returns a new uniform grid
+//
+//	cellSize: Width and height of each cell, in pixels. Must be greater than zero
+//
+// pt_br: Retorna uma nova grade uniforme
+//
+//	cellSize: Comprimento e altura de cada célula em pixels. Deve ser maior do
+//	          que zero
+func NewGrid(cellSize float64) *Grid {
+	if cellSize <= 0 {
+		cellSize = 1
+	}
+	return &Grid{
+		cellSize: cellSize,
+		cells:    make(map[gridCell]map[string]struct{}),
+		items:    make(map[string]AABB),
+	}
+}
+
+// Insert
+// en: Adds an element to the grid. If the id already exists, the element is updated
+//
+// pt_br: Adiciona um elemento à grade. Se o id já existir, o elemento é atualizado
+func (el *Grid) Insert(id string, bounds AABB) {
+	if _, found := el.items[id]; found {
+		el.removeFromCells(id)
+	}
+	el.items[id] = bounds
+	minCell, maxCell := el.cellRange(bounds)
+	for x := minCell.x; x <= maxCell.x; x += 1 {
+		for y := minCell.y; y <= maxCell.y; y += 1 {
+			cell := gridCell{x: x, y: y}
+			if el.cells[cell] == nil {
+				el.cells[cell] = make(map[string]struct{})
+			}
+			el.cells[cell][id] = struct{}{}
+		}
+	}
+
+	if len(el.items) == 1 && len(el.cells) == (maxCell.x-minCell.x+1)*(maxCell.y-minCell.y+1) {
+		el.minCell = minCell
+		el.maxCell = maxCell
+		return
+	}
+	el.minCell = gridCell{x: minInt(el.minCell.x, minCell.x), y: minInt(el.minCell.y, minCell.y)}
+	el.maxCell = gridCell{x: maxInt(el.maxCell.x, maxCell.x), y: maxInt(el.maxCell.y, maxCell.y)}
+}
+
+// Update
+// en: Moves an element to new bounds. Unknown ids are inserted
+//
+// pt_br: Move um elemento para novos limites. Ids desconhecidos são inseridos
+func (el *Grid) Update(id string, bounds AABB) {
+	el.Insert(id, bounds)
+}
+
+// Remove
+// en: Removes an element from the grid
+//
+// pt_br: Remove um elemento da grade
+func (el *Grid) Remove(id string) {
+	if _, found := el.items[id]; !found {
+		return
+	}
+	el.removeFromCells(id)
+	delete(el.items, id)
+}
+
+// Clear
+// en: Removes all elements from the grid
+//
+// pt_br: Remove todos os elementos da grade
+func (el *Grid) Clear() {
+	el.cells = make(map[gridCell]map[string]struct{})
+	el.items = make(map[string]AABB)
+	el.minCell = gridCell{}
+	el.maxCell = gridCell{}
+}
+
+// Len
+// en: Returns the number of elements in the grid
+//
+// pt_br: Retorna o número de elementos na grade
+func (el *Grid) Len() int {
+	return len(el.items)
+}
+
+// GetBounds
+// en: Returns the bounds of an element and false if the id is unknown
+//
+// pt_br: Retorna os limites de um elemento e false se o id não existir
+func (el *Grid) GetBounds(id string) (bounds AABB, found bool) {
+	bounds, found = el.items[id]
+	return
+}
+
+// QueryPoint
+// en: Returns the id of every element whose bounds contain the point (x, y)
+//
+// pt_br: Retorna o id de todos os elementos cujos limites contêm o ponto (x, y)
+func (el *Grid) QueryPoint(x, y float64) []string {
+	return el.QueryRect(AABB{X: x, Y: y})
+}
+
+// QueryRect
+// en: Returns the id of every element whose bounds intersect the rectangle
+//
+// pt_br: Retorna o id de todos os elementos cujos limites cruzam o retângulo
+func (el *Grid) QueryRect(rect AABB) []string {
+	list := make([]string, 0)
+	seen := make(map[string]struct{})
+	minCell, maxCell := el.cellRange(rect)
+	for x := minCell.x; x <= maxCell.x; x += 1 {
+		for y := minCell.y; y <= maxCell.y; y += 1 {
+			for id := range el.cells[gridCell{x: x, y: y}] {
+				if _, found := seen[id]; found {
+					continue
+				}
+				seen[id] = struct{}{}
+				if el.items[id].Intersects(rect) {
+					list = append(list, id)
+				}
+			}
+		}
+	}
+	return list
+}
+
+// Nearest
+// en: Returns the id of the element nearest to the point (x, y) and false if the
+// grid is empty. The search walks rings of cells around the point and stops when
+// the next ring cannot hold anything closer than the best element found
+//
+// pt_br: Retorna o id do elemento mais próximo do ponto (x, y) e false se a grade
+// estiver vazia. A busca percorre anéis de células em volta do ponto e para quando
+// o próximo anel não pode conter nada mais próximo do que o melhor elemento
+// encontrado
+func (el *Grid) Nearest(x, y float64) (id string, distance float64, found bool) {
+	if len(el.items) == 0 {
+		return "", 0, false
+	}
+
+	center := el.cell(x, y)
+	maxRing := maxInt(
+		maxInt(absInt(center.x-el.minCell.x), absInt(el.maxCell.x-center.x)),
+		maxInt(absInt(center.y-el.minCell.y), absInt(el.maxCell.y-center.y)),
+	)
+
+	distance = math.Inf(1)
+	for ring := 0; ring <= maxRing; ring += 1 {
+		if found && distance <= float64(ring-1)*el.cellSize {
+			break
+		}
+		for cellX := center.x - ring; cellX <= center.x+ring; cellX += 1 {
+			for cellY := center.y - ring; cellY <= center.y+ring; cellY += 1 {
+				if absInt(cellX-center.x) != ring && absInt(cellY-center.y) != ring {
+					continue
+				}
+				for candidate := range el.cells[gridCell{x: cellX, y: cellY}] {
+					candidateDistance := el.items[candidate].DistanceToPoint(x, y)
+					if candidateDistance < distance || (candidateDistance == distance && candidate < id) {
+						id = candidate
+						distance = candidateDistance
+						found = true
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+func (el *Grid) removeFromCells(id string) {
+	minCell, maxCell := el.cellRange(el.items[id])
+	for x := minCell.x; x <= maxCell.x; x += 1 {
+		for y := minCell.y; y <= maxCell.y; y += 1 {
+			cell := gridCell{x: x, y: y}
+			delete(el.cells[cell], id)
+			if len(el.cells[cell]) == 0 {
+				delete(el.cells, cell)
+			}
+		}
+	}
+}
+
+func (el *Grid) cell(x, y float64) gridCell {
+	return gridCell{x: int(math.Floor(x / el.cellSize)), y: int(math.Floor(y / el.cellSize))}
+}
+
+func (el *Grid) cellRange(bounds AABB) (minCell, maxCell gridCell) {
+	return el.cell(bounds.X, bounds.Y), el.cell(bounds.MaxX(), bounds.MaxY())
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
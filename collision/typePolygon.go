@@ -0,0 +1,100 @@
+package collision
+
+import "math"
+
+// Polygon
+// en: Convex polygon shape for collision tests. The vertices may be given in
+// clockwise or counterclockwise order, but the polygon must be convex; concave
+// polygons must be split into convex parts before testing
+//
+// pt_br: Forma de polígono convexo para os testes de colisão. Os vértices podem
+// estar em sentido horário ou anti-horário, mas o polígono deve ser convexo;
+// polígonos côncavos devem ser divididos em partes convexas antes do teste
+type Polygon struct {
+	Vertices []Vector
+}
+
+// NewPolygon
+// en: Returns a new convex polygon from a list of x, y pairs
+//
+//	Example: NewPolygon(0, 0, 10, 0, 5, 10)
+//
+// pt_br: Retorna um novo polígono convexo a partir de uma lista de pares x, y
+//
+//	Exemplo: NewPolygon(0, 0, 10, 0, 5, 10)
+func NewPolygon(points ...float64) Polygon {
+	polygon := Polygon{Vertices: make([]Vector, 0, len(points)/2)}
+	for i := 0; i+1 < len(points); i += 2 {
+		polygon.Vertices = append(polygon.Vertices, Vector{X: points[i], Y: points[i+1]})
+	}
+	return polygon
+}
+
+// GetBounds
+// en: Returns the bounding box of the polygon
+//
+// pt_br: Retorna a caixa delimitadora do polígono
+func (el Polygon) GetBounds() AABB {
+	if len(el.Vertices) == 0 {
+		return AABB{}
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, vertex := range el.Vertices {
+		minX = math.Min(minX, vertex.X)
+		minY = math.Min(minY, vertex.Y)
+		maxX = math.Max(maxX, vertex.X)
+		maxY = math.Max(maxY, vertex.Y)
+	}
+	return AABB{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// ContainsPoint
+// en: Returns true if the point (x, y) is inside the polygon or on its border
+//
+// pt_br: Retorna true se o ponto (x, y) estiver dentro do polígono ou na sua borda
+func (el Polygon) ContainsPoint(x, y float64) bool {
+	if len(el.Vertices) < 3 {
+		return false
+	}
+	point := Vector{X: x, Y: y}
+	sign := 0.0
+	for i, vertex := range el.Vertices {
+		next := el.Vertices[(i+1)%len(el.Vertices)]
+		cross := next.Sub(vertex).X*point.Sub(vertex).Y - next.Sub(vertex).Y*point.Sub(vertex).X
+		if cross == 0 {
+			continue
+		}
+		if sign == 0 {
+			sign = cross
+		} else if (sign > 0) != (cross > 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// axes
+// en: Returns the normals of every edge, used as separating axis candidates
+//
+// pt_br: Retorna as normais de cada aresta, usadas como candidatas a eixo separador
+func (el Polygon) axes() []Vector {
+	axes := make([]Vector, 0, len(el.Vertices))
+	for i, vertex := range el.Vertices {
+		next := el.Vertices[(i+1)%len(el.Vertices)]
+		axis := next.Sub(vertex).Perpendicular().Normalize()
+		if axis.X == 0 && axis.Y == 0 {
+			continue
+		}
+		axes = append(axes, axis)
+	}
+	return axes
+}
+
+func (el Polygon) project(axis Vector) (min, max float64) {
+	return projectPoints(el.Vertices, axis)
+}
+
+func (el Polygon) vertices() []Vector {
+	return el.Vertices
+}
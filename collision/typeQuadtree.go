@@ -0,0 +1,310 @@
+package collision
+
+import (
+	"container/heap"
+)
+
+const (
+	// KQuadtreeDefaultCapacity
+	// en: Default number of elements a quadtree node holds before it splits
+	//
+	// pt_br: Número padrão de elementos que um nó da quadtree guarda antes de se
+	// dividir
+	KQuadtreeDefaultCapacity = 8
+
+	// KQuadtreeDefaultMaxDepth
+	// en: Default maximum depth of the quadtree
+	//
+	// pt_br: Profundidade máxima padrão da quadtree
+	KQuadtreeDefaultMaxDepth = 8
+)
+
+// Quadtree
+// en: SpatialIndex that recursively splits the world in four quadrants. Each
+// element is kept in the deepest node that completely contains its bounds.
+// Elements outside the world bounds are kept in the root node and are still found
+// by the queries
+//
+// pt_br: SpatialIndex que divide o mundo recursivamente em quatro quadrantes. Cada
+// elemento é guardado no nó mais profundo que contém completamente os seus limites.
+// Elementos fora dos limites do mundo são guardados no nó raiz e continuam sendo
+// encontrados pelas consultas
+type Quadtree struct {
+	root     *quadtreeNode
+	items    map[string]*quadtreeItem
+	capacity int
+	maxDepth int
+}
+
+type quadtreeItem struct {
+	id     string
+	bounds AABB
+	node   *quadtreeNode
+}
+
+type quadtreeNode struct {
+	bounds   AABB
+	depth    int
+	parent   *quadtreeNode
+	items    map[string]*quadtreeItem
+	children []*quadtreeNode
+}
+
+// NewQuadtree
+// en: Returns a new quadtree covering the world bounds
+//
+//	world: Area covered by the tree, normally the size of the canvas
+//	capacity: Number of elements in a node before it splits. Use zero for
+//	          KQuadtreeDefaultCapacity
+//	maxDepth: Maximum depth of the tree. Use zero for KQuadtreeDefaultMaxDepth
+//
+// pt_br: Retorna uma nova quadtree cobrindo os limites do mundo
+//
+//	world: Área coberta pela árvore, normalmente o tamanho do canvas
+//	capacity: Número de elementos em um nó antes da divisão. Use zero para
+//	          KQuadtreeDefaultCapacity
+//	maxDepth: Profundidade máxima da árvore. Use zero para
+//	          KQuadtreeDefaultMaxDepth
+func NewQuadtree(world AABB, capacity, maxDepth int) *Quadtree {
+	if capacity <= 0 {
+		capacity = KQuadtreeDefaultCapacity
+	}
+	if maxDepth <= 0 {
+		maxDepth = KQuadtreeDefaultMaxDepth
+	}
+	return &Quadtree{
+		root:     newQuadtreeNode(world, 0, nil),
+		items:    make(map[string]*quadtreeItem),
+		capacity: capacity,
+		maxDepth: maxDepth,
+	}
+}
+
+func newQuadtreeNode(bounds AABB, depth int, parent *quadtreeNode) *quadtreeNode {
+	return &quadtreeNode{
+		bounds: bounds,
+		depth:  depth,
+		parent: parent,
+		items:  make(map[string]*quadtreeItem),
+	}
+}
+
+// Insert
+// en: Adds an element to the tree. If the id already exists, the element is updated
+//
+// pt_br: Adiciona um elemento à árvore. Se o id já existir, o elemento é atualizado
+func (el *Quadtree) Insert(id string, bounds AABB) {
+	if _, found := el.items[id]; found {
+		el.Update(id, bounds)
+		return
+	}
+	item := &quadtreeItem{id: id, bounds: bounds}
+	el.items[id] = item
+	el.insert(el.root, item)
+}
+
+// Update
+// en: Moves an element to new bounds. Unknown ids are inserted
+//
+// pt_br: Move um elemento para novos limites. Ids desconhecidos são inseridos
+func (el *Quadtree) Update(id string, bounds AABB) {
+	item, found := el.items[id]
+	if !found {
+		el.Insert(id, bounds)
+		return
+	}
+	item.bounds = bounds
+	if item.node != el.root && item.node.bounds.Contains(bounds) && item.node.children == nil {
+		return
+	}
+	delete(item.node.items, id)
+	el.insert(el.root, item)
+}
+
+// Remove
+// en: Removes an element from the tree
+//
+// pt_br: Remove um elemento da árvore
+func (el *Quadtree) Remove(id string) {
+	item, found := el.items[id]
+	if !found {
+		return
+	}
+	delete(item.node.items, id)
+	delete(el.items, id)
+}
+
+// Clear
+// en: Removes all elements from the tree
+//
+// pt_br: Remove todos os elementos da árvore
+func (el *Quadtree) Clear() {
+	el.root = newQuadtreeNode(el.root.bounds, 0, nil)
+	el.items = make(map[string]*quadtreeItem)
+}
+
+// Len
+// en: Returns the number of elements in the tree
+//
+// pt_br: Retorna o número de elementos na árvore
+func (el *Quadtree) Len() int {
+	return len(el.items)
+}
+
+// GetBounds
+// en: Returns the bounds of an element and false if the id is unknown
+//
+// pt_br: Retorna os limites de um elemento e false se o id não existir
+func (el *Quadtree) GetBounds(id string) (bounds AABB, found bool) {
+	item, found := el.items[id]
+	if !found {
+		return AABB{}, false
+	}
+	return item.bounds, true
+}
+
+// QueryPoint
+// en: Returns the id of every element whose bounds contain the point (x, y)
+//
+// pt_br: Retorna o id de todos os elementos cujos limites contêm o ponto (x, y)
+func (el *Quadtree) QueryPoint(x, y float64) []string {
+	return el.QueryRect(AABB{X: x, Y: y})
+}
+
+// QueryRect
+// en: Returns the id of every element whose bounds intersect the rectangle
+//
+// pt_br: Retorna o id de todos os elementos cujos limites cruzam o retângulo
+func (el *Quadtree) QueryRect(rect AABB) []string {
+	list := make([]string, 0)
+	el.query(el.root, rect, &list)
+	return list
+}
+
+// Nearest
+// en: Returns the id of the element nearest to the point (x, y) and false if the
+// tree is empty. The search visits the nodes in order of distance and stops at the
+// first element closer than every node not yet visited
+//
+// pt_br: Retorna o id do elemento mais próximo do ponto (x, y) e false se a árvore
+// estiver vazia. A busca visita os nós em ordem de distância e para no primeiro
+// elemento mais próximo do que todos os nós ainda não visitados
+func (el *Quadtree) Nearest(x, y float64) (id string, distance float64, found bool) {
+	if len(el.items) == 0 {
+		return "", 0, false
+	}
+
+	queue := &nearestQueue{}
+	heap.Push(queue, nearestEntry{node: el.root, distance: 0})
+	for queue.Len() > 0 {
+		entry := heap.Pop(queue).(nearestEntry)
+		if entry.node == nil {
+			return entry.id, entry.distance, true
+		}
+		for _, item := range entry.node.items {
+			heap.Push(queue, nearestEntry{id: item.id, distance: item.bounds.DistanceToPoint(x, y)})
+		}
+		for _, child := range entry.node.children {
+			heap.Push(queue, nearestEntry{node: child, distance: child.bounds.DistanceToPoint(x, y)})
+		}
+	}
+	return "", 0, false
+}
+
+func (el *Quadtree) insert(node *quadtreeNode, item *quadtreeItem) {
+	for {
+		if node.children == nil {
+			break
+		}
+		child := node.childContaining(item.bounds)
+		if child == nil {
+			break
+		}
+		node = child
+	}
+
+	node.items[item.id] = item
+	item.node = node
+
+	if node.children == nil && len(node.items) > el.capacity && node.depth < el.maxDepth {
+		el.split(node)
+	}
+}
+
+func (el *Quadtree) split(node *quadtreeNode) {
+	halfWidth := node.bounds.Width / 2
+	halfHeight := node.bounds.Height / 2
+	depth := node.depth + 1
+	node.children = []*quadtreeNode{
+		newQuadtreeNode(AABB{X: node.bounds.X, Y: node.bounds.Y, Width: halfWidth, Height: halfHeight}, depth, node),
+		newQuadtreeNode(AABB{X: node.bounds.X + halfWidth, Y: node.bounds.Y, Width: halfWidth, Height: halfHeight}, depth, node),
+		newQuadtreeNode(AABB{X: node.bounds.X, Y: node.bounds.Y + halfHeight, Width: halfWidth, Height: halfHeight}, depth, node),
+		newQuadtreeNode(AABB{X: node.bounds.X + halfWidth, Y: node.bounds.Y + halfHeight, Width: halfWidth, Height: halfHeight}, depth, node),
+	}
+
+	items := node.items
+	node.items = make(map[string]*quadtreeItem)
+	for _, item := range items {
+		el.insert(node, item)
+	}
+}
+
+func (el *Quadtree) query(node *quadtreeNode, rect AABB, list *[]string) {
+	for _, item := range node.items {
+		if item.bounds.Intersects(rect) {
+			*list = append(*list, item.id)
+		}
+	}
+	for _, child := range node.children {
+		if child.bounds.Intersects(rect) {
+			el.query(child, rect, list)
+		}
+	}
+}
+
+func (el *quadtreeNode) childContaining(bounds AABB) *quadtreeNode {
+	for _, child := range el.children {
+		if child.bounds.Contains(bounds) {
+			return child
+		}
+	}
+	return nil
+}
+
+// nearestEntry
+// en: Entry of the nearest neighbour priority queue; node is nil for elements
+//
+// pt_br: Entrada da fila de prioridade do vizinho mais próximo; node é nil para
+// elementos
+type nearestEntry struct {
+	node     *quadtreeNode
+	id       string
+	distance float64
+}
+
+type nearestQueue []nearestEntry
+
+func (el nearestQueue) Len() int { return len(el) }
+
+func (el nearestQueue) Less(i, j int) bool {
+	if el[i].distance == el[j].distance {
+		// en: elements win ties against nodes so the search ends as soon as possible
+		// pt_br: elementos vencem empates contra nós para que a busca termine o quanto
+		// antes
+		return el[i].node == nil && el[j].node != nil
+	}
+	return el[i].distance < el[j].distance
+}
+
+func (el nearestQueue) Swap(i, j int) { el[i], el[j] = el[j], el[i] }
+
+func (el *nearestQueue) Push(value interface{}) {
+	*el = append(*el, value.(nearestEntry))
+}
+
+func (el *nearestQueue) Pop() interface{} {
+	old := *el
+	entry := old[len(old)-1]
+	*el = old[:len(old)-1]
+	return entry
+}
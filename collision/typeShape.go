@@ -0,0 +1,13 @@
+package collision
+
+// Shape
+// en: Shape accepted by the Intersects() function. AABB, Circle and Polygon
+// implement this interface
+//
+// pt_br: Forma aceita pela função Intersects(). AABB, Circle e Polygon implementam
+// esta interface
+type Shape interface {
+	GetBounds() AABB
+	ContainsPoint(x, y float64) bool
+	project(axis Vector) (min, max float64)
+}
@@ -0,0 +1,78 @@
+package collision
+
+// SpatialIndex
+// en: Broad-phase index of bounding boxes keyed by the id of the element. Use the
+// value of Primitive.GetId() as id and the position and size of
+// Primitive.GetDimensions() as bounds, and call Update() every time the element
+// moves
+//
+// pt_br: Índice de fase larga de caixas delimitadoras indexadas pelo id do
+// elemento. Use o valor de Primitive.GetId() como id e a posição e o tamanho de
+// Primitive.GetDimensions() como limites, e chame Update() sempre que o elemento
+// se mover
+type SpatialIndex interface {
+
+	// Insert
+	// en: Adds an element to the index. If the id already exists, the element is
+	// updated
+	//     id: Unique id of the element
+	//     bounds: Bounding box of the element
+	//
+	// pt_br: Adiciona um elemento ao índice. Se o id já existir, o elemento é
+	// atualizado
+	//     id: Id único do elemento
+	//     bounds: Caixa delimitadora do elemento
+	Insert(id string, bounds AABB)
+
+	// Update
+	// en: Moves an element already inserted to new bounds
+	//
+	// pt_br: Move um elemento já inserido para novos limites
+	Update(id string, bounds AABB)
+
+	// Remove
+	// en: Removes an element from the index
+	//
+	// pt_br: Remove um elemento do índice
+	Remove(id string)
+
+	// Clear
+	// en: Removes all elements from the index
+	//
+	// pt_br: Remove todos os elementos do índice
+	Clear()
+
+	// Len
+	// en: Returns the number of elements in the index
+	//
+	// pt_br: Retorna o número de elementos no índice
+	Len() int
+
+	// GetBounds
+	// en: Returns the bounds of an element and false if the id is unknown
+	//
+	// pt_br: Retorna os limites de um elemento e false se o id não existir
+	GetBounds(id string) (bounds AABB, found bool)
+
+	// QueryPoint
+	// en: Returns the id of every element whose bounds contain the point (x, y)
+	//
+	// pt_br: Retorna o id de todos os elementos cujos limites contêm o ponto (x, y)
+	QueryPoint(x, y float64) []string
+
+	// QueryRect
+	// en: Returns the id of every element whose bounds intersect the rectangle
+	//
+	// pt_br: Retorna o id de todos os elementos cujos limites cruzam o retângulo
+	QueryRect(rect AABB) []string
+
+	// Nearest
+	// en: Returns the id of the element nearest to the point (x, y), measured from
+	// the point to the nearest point of the element bounds, and false if the index is
+	// empty
+	//
+	// pt_br: Retorna o id do elemento mais próximo do ponto (x, y), medido do ponto
+	// até o ponto mais próximo dos limites do elemento, e false se o índice estiver
+	// vazio
+	Nearest(x, y float64) (id string, distance float64, found bool)
+}
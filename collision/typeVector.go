@@ -0,0 +1,67 @@
+package collision
+
+import "math"
+
+// Vector
+// en: Two dimensional point or direction used by the collision tests
+//
+// pt_br: Ponto ou direção em duas dimensões usado pelos testes de colisão
+type Vector struct {
+	X float64
+	Y float64
+}
+
+// Sub
+// en: Returns the vector el - other
+//
+// pt_br: Retorna o vetor el - other
+func (el Vector) Sub(other Vector) Vector {
+	return Vector{X: el.X - other.X, Y: el.Y - other.Y}
+}
+
+// Dot
+// en: Returns the dot product of the two vectors
+//
+// pt_br: Retorna o produto escalar dos dois vetores
+func (el Vector) Dot(other Vector) float64 {
+	return el.X*other.X + el.Y*other.Y
+}
+
+// Perpendicular
+// en: Returns the vector rotated by 90 degrees
+//
+// pt_br: Retorna o vetor rotacionado em 90 graus
+func (el Vector) Perpendicular() Vector {
+	return Vector{X: -el.Y, Y: el.X}
+}
+
+// Length
+// en: Returns the length of the vector
+//
+// pt_br: Retorna o comprimento do vetor
+func (el Vector) Length() float64 {
+	return math.Hypot(el.X, el.Y)
+}
+
+// Normalize
+// en: Returns the vector with length 1. The zero vector is returned unchanged
+//
+// pt_br: Retorna o vetor com comprimento 1. O vetor zero é retornado sem alterações
+func (el Vector) Normalize() Vector {
+	length := el.Length()
+	if length == 0 {
+		return el
+	}
+	return Vector{X: el.X / length, Y: el.Y / length}
+}
+
+func projectPoints(points []Vector, axis Vector) (min, max float64) {
+	min = math.Inf(1)
+	max = math.Inf(-1)
+	for _, point := range points {
+		value := point.Dot(axis)
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+	return
+}
@@ -0,0 +1,137 @@
+package colorUtils
+
+import (
+	"image/color"
+	"math"
+)
+
+const (
+	// KContrastAA
+	// en: Minimum contrast ratio of WCAG 2 level AA for normal text
+	//
+	// pt_br: Razão de contraste mínima do WCAG 2 nível AA para texto normal
+	KContrastAA = 4.5
+
+	// KContrastAALarge
+	// en: Minimum contrast ratio of WCAG 2 level AA for large text and graphics
+	//
+	// pt_br: Razão de contraste mínima do WCAG 2 nível AA para texto grande e
+	// gráficos
+	KContrastAALarge = 3.0
+
+	// KContrastAAA
+	// en: Minimum contrast ratio of WCAG 2 level AAA for normal text
+	//
+	// pt_br: Razão de contraste mínima do WCAG 2 nível AAA para texto normal
+	KContrastAAA = 7.0
+)
+
+// RelativeLuminance
+// en: Returns the relative luminance of WCAG 2, from 0.0 (black) to 1.0 (white).
+// The alpha is ignored
+//
+// pt_br: Retorna a luminância relativa do WCAG 2, de 0.0 (preto) a 1.0 (branco).
+// O alpha é ignorado
+func RelativeLuminance(value color.RGBA) float64 {
+	return 0.2126*ToLinear(value.R) + 0.7152*ToLinear(value.G) + 0.0722*ToLinear(value.B)
+}
+
+// ContrastRatio
+// en: Returns the contrast ratio of WCAG 2 between two colors, from 1 to 21. A
+// translucent foreground is first composed over the background
+//
+// pt_br: Retorna a razão de contraste do WCAG 2 entre duas cores, de 1 a 21. Um
+// primeiro plano translúcido é antes composto sobre o fundo
+func ContrastRatio(foreground, background color.RGBA) float64 {
+	foreground = Over(foreground, background)
+	a, b := RelativeLuminance(foreground), RelativeLuminance(background)
+	return (math.Max(a, b) + 0.05) / (math.Min(a, b) + 0.05)
+}
+
+// Over
+// en: Composes the foreground over the background, as source-over
+//
+// pt_br: Compõe o primeiro plano sobre o fundo, como source-over
+func Over(foreground, background color.RGBA) color.RGBA {
+	if foreground.A == 255 {
+		return foreground
+	}
+	alpha := float64(foreground.A) / 255
+	backgroundAlpha := float64(background.A) / 255 * (1 - alpha)
+	resultAlpha := alpha + backgroundAlpha
+	if resultAlpha == 0 {
+		return color.RGBA{}
+	}
+	channel := func(front, back uint8) uint8 {
+		return toByte((float64(front)*alpha + float64(back)*backgroundAlpha) / resultAlpha)
+	}
+	return color.RGBA{
+		R: channel(foreground.R, background.R),
+		G: channel(foreground.G, background.G),
+		B: channel(foreground.B, background.B),
+		A: toByte(resultAlpha * 255),
+	}
+}
+
+// TextColor
+// en: Returns black or white, the one with the largest contrast over the
+// background
+//
+// pt_br: Retorna preto ou branco, o que tiver o maior contraste sobre o fundo
+func TextColor(background color.RGBA) color.RGBA {
+	black := color.RGBA{A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	if ContrastRatio(black, background) >= ContrastRatio(white, background) {
+		return black
+	}
+	return white
+}
+
+// EnsureContrast
+// en: Returns the color closest to the foreground, changing only the OKLCH
+// lightness, with at least the contrast ratio over the background. When the
+// ratio can not be reached, returns black or white, the best of both
+//
+//	ratio: Minimum contrast ratio, like KContrastAA
+//
+// pt_br: Retorna a cor mais próxima do primeiro plano, alterando apenas a
+// luminosidade OKLCH, com pelo menos a razão de contraste sobre o fundo. Quando a
+// razão não pode ser alcançada, retorna preto ou branco, o melhor dos dois
+//
+//	ratio: Razão de contraste mínima, como KContrastAA
+func EnsureContrast(foreground, background color.RGBA, ratio float64) color.RGBA {
+	if ContrastRatio(foreground, background) >= ratio {
+		return foreground
+	}
+
+	lch := ToOKLCH(foreground)
+	var best color.RGBA
+	bestDistance := math.Inf(1)
+	for _, target := range []float64{0, 1} {
+		if contrast := ContrastRatio(WithAlpha(OKLCH{L: target, Alpha: 1}.ToRGBA(), lch.Alpha), background); contrast < ratio {
+			continue
+		}
+		low, high := lch.L, target
+		for i := 0; i < 20; i += 1 {
+			middle := (low + high) / 2
+			candidate := lch
+			candidate.L = middle
+			if ContrastRatio(candidate.ToRGBA(), background) >= ratio {
+				high = middle
+			} else {
+				low = middle
+			}
+		}
+		if distance := math.Abs(high - lch.L); distance < bestDistance {
+			candidate := lch
+			candidate.L = high
+			best = candidate.ToRGBA()
+			bestDistance = distance
+		}
+	}
+
+	if math.IsInf(bestDistance, 1) {
+		return WithAlpha(TextColor(background), lch.Alpha)
+	}
+	return best
+}
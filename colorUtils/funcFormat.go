@@ -0,0 +1,41 @@
+package colorUtils
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+)
+
+// ToHex
+// en: Returns the color as #rrggbb, or #rrggbbaa when it is not opaque
+//
+// pt_br: Retorna a cor como #rrggbb, ou #rrggbbaa quando não é opaca
+func ToHex(value color.RGBA) string {
+	if value.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", value.R, value.G, value.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", value.R, value.G, value.B, value.A)
+}
+
+// ToCSS
+// en: Returns the color as a CSS string, accepted by SetFillStyle() and
+// SetStrokeStyle(): rgb(r, g, b) or rgba(r, g, b, a)
+//
+// pt_br: Retorna a cor como uma string CSS, aceita por SetFillStyle() e
+// SetStrokeStyle(): rgb(r, g, b) ou rgba(r, g, b, a)
+func ToCSS(value color.RGBA) string {
+	if value.A == 255 {
+		return fmt.Sprintf("rgb(%v, %v, %v)", value.R, value.G, value.B)
+	}
+	alpha := strconv.FormatFloat(float64(value.A)/255, 'f', 3, 64)
+	return fmt.Sprintf("rgba(%v, %v, %v, %v)", value.R, value.G, value.B, alpha)
+}
+
+// WithAlpha
+// en: Returns the color with the opacity, from 0.0 to 1.0
+//
+// pt_br: Retorna a cor com a opacidade, de 0.0 a 1.0
+func WithAlpha(value color.RGBA, alpha float64) color.RGBA {
+	value.A = toByte(clamp(alpha, 0, 1) * 255)
+	return value
+}
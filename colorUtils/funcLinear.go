@@ -0,0 +1,85 @@
+package colorUtils
+
+import "math"
+
+// ToLinear
+// en: Converts a sRGB channel, from 0 to 255, to linear light, from 0.0 to 1.0
+//
+// pt_br: Converte um canal sRGB, de 0 a 255, para luz linear, de 0.0 a 1.0
+func ToLinear(channel uint8) float64 {
+	return linearize(float64(channel) / 255)
+}
+
+// FromLinear
+// en: Converts linear light, from 0.0 to 1.0, to a sRGB channel, from 0 to 255
+//
+// pt_br: Converte luz linear, de 0.0 a 1.0, para um canal sRGB, de 0 a 255
+func FromLinear(value float64) uint8 {
+	return toByte(gamma(value) * 255)
+}
+
+// linearize
+// en: Transfer function of sRGB, from encoded value to linear light, both from 0.0
+// to 1.0; negative values keep the sign
+//
+// pt_br: Função de transferência do sRGB, do valor codificado para luz linear,
+// ambos de 0.0 a 1.0; valores negativos mantêm o sinal
+func linearize(value float64) float64 {
+	sign := 1.0
+	if value < 0 {
+		sign, value = -1, -value
+	}
+	if value <= 0.04045 {
+		return sign * value / 12.92
+	}
+	return sign * math.Pow((value+0.055)/1.055, 2.4)
+}
+
+// gamma
+// en: Inverse of linearize()
+//
+// pt_br: Inverso de linearize()
+func gamma(value float64) float64 {
+	sign := 1.0
+	if value < 0 {
+		sign, value = -1, -value
+	}
+	if value <= 0.0031308 {
+		return sign * value * 12.92
+	}
+	return sign * (1.055*math.Pow(value, 1/2.4) - 0.055)
+}
+
+// toByte
+// en: Rounds and clamps a value to a byte
+//
+// pt_br: Arredonda e limita um valor a um byte
+func toByte(value float64) uint8 {
+	if value <= 0 || math.IsNaN(value) {
+		return 0
+	}
+	if value >= 255 {
+		return 255
+	}
+	return uint8(value + 0.5)
+}
+
+// clamp
+// en: Limits the value to the range
+//
+// pt_br: Limita o valor à faixa
+func clamp(value, minimum, maximum float64) float64 {
+	return math.Max(minimum, math.Min(maximum, value))
+}
+
+// normalizeHue
+// en: Returns the hue in the range from 0 to 360 degrees
+//
+// pt_br: Retorna o matiz na faixa de 0 a 360 graus
+func normalizeHue(hue float64) float64 {
+	hue = math.Mod(hue, 360)
+	if hue < 0 {
+		hue += 360
+	}
+	return hue
+}
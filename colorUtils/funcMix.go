@@ -0,0 +1,152 @@
+package colorUtils
+
+import (
+	"image/color"
+	"math"
+)
+
+// Mix
+// en: Returns the color between a and b, mixed in OKLab, the same space used by
+// the CSS color-mix() recommendation for even results
+//
+//	fraction: 0.0 returns a, 1.0 returns b
+//
+// pt_br: Retorna a cor entre a e b, misturada em OKLab, o mesmo espaço usado pela
+// recomendação de color-mix() do CSS para resultados uniformes
+//
+//	fraction: 0.0 retorna a, 1.0 retorna b
+func Mix(a, b color.RGBA, fraction float64) color.RGBA {
+	fraction = clamp(fraction, 0, 1)
+	labA, labB := ToOKLab(a), ToOKLab(b)
+	return OKLab{
+		L:     labA.L + (labB.L-labA.L)*fraction,
+		A:     labA.A + (labB.A-labA.A)*fraction,
+		B:     labA.B + (labB.B-labA.B)*fraction,
+		Alpha: labA.Alpha + (labB.Alpha-labA.Alpha)*fraction,
+	}.ToRGBA()
+}
+
+// MixLinear
+// en: Returns the color between a and b, mixed in linear light, as a physical
+// mixture of lights
+//
+//	fraction: 0.0 returns a, 1.0 returns b
+//
+// pt_br: Retorna a cor entre a e b, misturada em luz linear, como uma mistura
+// física de luzes
+//
+//	fraction: 0.0 retorna a, 1.0 retorna b
+func MixLinear(a, b color.RGBA, fraction float64) color.RGBA {
+	fraction = clamp(fraction, 0, 1)
+	channel := func(x, y uint8) uint8 {
+		return FromLinear(ToLinear(x) + (ToLinear(y)-ToLinear(x))*fraction)
+	}
+	return color.RGBA{
+		R: channel(a.R, b.R),
+		G: channel(a.G, b.G),
+		B: channel(a.B, b.B),
+		A: toByte(float64(a.A) + (float64(b.A)-float64(a.A))*fraction),
+	}
+}
+
+// Tint
+// en: Mixes the color with white
+//
+//	amount: 0.0 returns the color, 1.0 returns white
+//
+// pt_br: Mistura a cor com branco
+//
+//	amount: 0.0 retorna a cor, 1.0 retorna branco
+func Tint(value color.RGBA, amount float64) color.RGBA {
+	return Mix(value, color.RGBA{R: 255, G: 255, B: 255, A: value.A}, amount)
+}
+
+// Shade
+// en: Mixes the color with black
+//
+//	amount: 0.0 returns the color, 1.0 returns black
+//
+// pt_br: Mistura a cor com preto
+//
+//	amount: 0.0 retorna a cor, 1.0 retorna preto
+func Shade(value color.RGBA, amount float64) color.RGBA {
+	return Mix(value, color.RGBA{A: value.A}, amount)
+}
+
+// Tints
+// en: Returns count colors going from the color towards white, without white, or
+// nil when count is not positive
+//
+// pt_br: Retorna count cores indo da cor em direção ao branco, sem o branco, ou nil
+// quando count não é positivo
+func Tints(value color.RGBA, count int) []color.RGBA {
+	if count <= 0 {
+		return nil
+	}
+	list := make([]color.RGBA, 0, count)
+	for i := 0; i < count; i += 1 {
+		list = append(list, Tint(value, float64(i)/float64(count)))
+	}
+	return list
+}
+
+// Shades
+// en: Returns count colors going from the color towards black, without black, or
+// nil when count is not positive
+//
+// pt_br: Retorna count cores indo da cor em direção ao preto, sem o preto, ou nil
+// quando count não é positivo
+func Shades(value color.RGBA, count int) []color.RGBA {
+	if count <= 0 {
+		return nil
+	}
+	list := make([]color.RGBA, 0, count)
+	for i := 0; i < count; i += 1 {
+		list = append(list, Shade(value, float64(i)/float64(count)))
+	}
+	return list
+}
+
+// Lighten
+// en: Adds the amount to the OKLCH lightness, keeping the hue
+//
+//	amount: From -1.0 to 1.0; negative values darken
+//
+// pt_br: Soma a quantidade à luminosidade OKLCH, mantendo o matiz
+//
+//	amount: De -1.0 a 1.0; valores negativos escurecem
+func Lighten(value color.RGBA, amount float64) color.RGBA {
+	lch := ToOKLCH(value)
+	lch.L = clamp(lch.L+amount, 0, 1)
+	return lch.ToRGBA()
+}
+
+// Darken
+// en: Subtracts the amount from the OKLCH lightness, keeping the hue
+//
+// pt_br: Subtrai a quantidade da luminosidade OKLCH, mantendo o matiz
+func Darken(value color.RGBA, amount float64) color.RGBA {
+	return Lighten(value, -amount)
+}
+
+// Saturate
+// en: Multiplies the OKLCH chroma by the factor; 0 gives gray, values above 1
+// intensify the color up to the limit of the sRGB gamut
+//
+// pt_br: Multiplica o croma OKLCH pelo fator; 0 gera cinza, valores acima de 1
+// intensificam a cor até o limite da gama sRGB
+func Saturate(value color.RGBA, factor float64) color.RGBA {
+	lch := ToOKLCH(value)
+	lch.C = math.Max(0, lch.C*factor)
+	return lch.ToRGBA()
+}
+
+// RotateHue
+// en: Rotates the OKLCH hue by the angle, in degrees
+//
+// pt_br: Gira o matiz OKLCH pelo ângulo, em graus
+func RotateHue(value color.RGBA, angle float64) color.RGBA {
+	lch := ToOKLCH(value)
+	lch.H = normalizeHue(lch.H + angle)
+	return lch.ToRGBA()
+}
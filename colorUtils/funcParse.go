@@ -0,0 +1,270 @@
+package colorUtils
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Parse
+// en: Parses a CSS color and returns it as color.RGBA, ready for SetShadowColor(),
+// AddColorStopPosition() and the other IDraw methods
+//
+//	Supported syntax: color names, "transparent", #rgb, #rgba, #rrggbb,
+//	#rrggbbaa, rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(), oklab(),
+//	oklch() and color() with the srgb and srgb-linear spaces
+//	Both the legacy syntax, with commas, and the modern syntax, with spaces and
+//	"/ alpha", are accepted, as well as the keyword none
+//	Colors out of the sRGB gamut are mapped into it
+//	Not supported: currentcolor, system colors, color-mix() and relative colors
+//
+// pt_br: Interpreta uma cor CSS e a retorna como color.RGBA, pronta para
+// SetShadowColor(), AddColorStopPosition() e os outros métodos de IDraw
+//
+//	Sintaxe suportada: nomes de cores, "transparent", #rgb, #rgba, #rrggbb,
+//	#rrggbbaa, rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(), oklab(),
+//	oklch() e color() com os espaços srgb e srgb-linear
+//	Tanto a sintaxe antiga, com vírgulas, quanto a moderna, com espaços e
+//	"/ alpha", são aceitas, assim como a palavra chave none
+//	Cores fora da gama sRGB são mapeadas para dentro dela
+//	Não suportado: currentcolor, cores do sistema, color-mix() e cores relativas
+func Parse(value string) (color.RGBA, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if named, found := Named(value); found {
+		return named, nil
+	}
+	if strings.HasPrefix(value, "#") {
+		return parseHex(value)
+	}
+
+	open := strings.IndexByte(value, '(')
+	if open == -1 || !strings.HasSuffix(value, ")") {
+		return color.RGBA{}, fmt.Errorf("colorUtils: invalid color %q", value)
+	}
+	name := strings.TrimSpace(value[:open])
+	arguments := value[open+1 : len(value)-1]
+
+	switch name {
+	case "rgb", "rgba", "hsl", "hsla", "hwb", "lab", "lch", "oklab", "oklch", "color":
+	default:
+		return color.RGBA{}, fmt.Errorf("colorUtils: unsupported function %v()", name)
+	}
+
+	space := ""
+	if name == "color" {
+		fields := strings.Fields(arguments)
+		if len(fields) == 0 {
+			return color.RGBA{}, fmt.Errorf("colorUtils: color(): missing color space")
+		}
+		space = fields[0]
+		arguments = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(arguments), space))
+	}
+
+	components, alpha, err := splitComponents(arguments)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("colorUtils: %v(): %v", name, err)
+	}
+	if len(components) != 3 {
+		return color.RGBA{}, fmt.Errorf("colorUtils: %v(): expected 3 components, found %v", name, len(components))
+	}
+
+	opacity := 1.0
+	if alpha != "" {
+		if opacity, err = parseNumber(alpha, 1); err != nil {
+			return color.RGBA{}, fmt.Errorf("colorUtils: %v(): %v", name, err)
+		}
+		opacity = clamp(opacity, 0, 1)
+	}
+
+	var values [3]float64
+	scales := componentScales(name)
+	for i, component := range components {
+		if scales[i] < 0 {
+			values[i], err = parseHue(component)
+		} else {
+			values[i], err = parseNumber(component, scales[i])
+		}
+		if err != nil {
+			return color.RGBA{}, fmt.Errorf("colorUtils: %v(): %v", name, err)
+		}
+	}
+
+	switch name {
+	case "rgb", "rgba":
+		return color.RGBA{R: toByte(values[0]), G: toByte(values[1]), B: toByte(values[2]), A: toByte(opacity * 255)}, nil
+	case "hsl", "hsla":
+		return HSL{H: values[0], S: values[1] / 100, L: values[2] / 100, Alpha: opacity}.ToRGBA(), nil
+	case "hwb":
+		return HWB{H: values[0], W: values[1] / 100, B: values[2] / 100, Alpha: opacity}.ToRGBA(), nil
+	case "lab":
+		return Lab{L: clamp(values[0], 0, 100), A: values[1], B: values[2], Alpha: opacity}.ToRGBA(), nil
+	case "lch":
+		return LCH{L: clamp(values[0], 0, 100), C: math.Max(0, values[1]), H: values[2], Alpha: opacity}.ToRGBA(), nil
+	case "oklab":
+		return OKLab{L: values[0], A: values[1], B: values[2], Alpha: opacity}.ToOKLCH().ToRGBA(), nil
+	case "oklch":
+		return OKLCH{L: values[0], C: math.Max(0, values[1]), H: values[2], Alpha: opacity}.ToRGBA(), nil
+	}
+
+	switch space {
+	case "srgb":
+		return color.RGBA{R: toByte(values[0] * 255), G: toByte(values[1] * 255), B: toByte(values[2] * 255), A: toByte(opacity * 255)}, nil
+	case "srgb-linear":
+		return color.RGBA{R: FromLinear(values[0]), G: FromLinear(values[1]), B: FromLinear(values[2]), A: toByte(opacity * 255)}, nil
+	}
+	return color.RGBA{}, fmt.Errorf("colorUtils: color(): unsupported color space %q", space)
+}
+
+// MustParse
+// en: Same as Parse(), but panics on error. Intended for constant values
+//
+// pt_br: O mesmo que Parse(), mas entra em pânico em caso de erro. Destinado a
+// valores constantes
+func MustParse(value string) color.RGBA {
+	parsed, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// parseHex
+// en: Parses #rgb, #rgba, #rrggbb and #rrggbbaa
+//
+// pt_br: Interpreta #rgb, #rgba, #rrggbb e #rrggbbaa
+func parseHex(value string) (color.RGBA, error) {
+	hex := value[1:]
+	if len(hex) == 3 || len(hex) == 4 {
+		expanded := make([]byte, 0, 8)
+		for i := 0; i < len(hex); i += 1 {
+			expanded = append(expanded, hex[i], hex[i])
+		}
+		hex = string(expanded)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	number, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 8 || err != nil {
+		return color.RGBA{}, fmt.Errorf("colorUtils: invalid color %q", value)
+	}
+	return color.RGBA{R: uint8(number >> 24), G: uint8(number >> 16), B: uint8(number >> 8), A: uint8(number)}, nil
+}
+
+// componentScales
+// en: Returns, for each component of the function, the value of 100%; a negative
+// value marks a hue
+//
+// pt_br: Retorna, para cada componente da função, o valor de 100%; um valor
+// negativo marca um matiz
+func componentScales(name string) [3]float64 {
+	switch name {
+	case "rgb", "rgba":
+		return [3]float64{255, 255, 255}
+	case "hsl", "hsla", "hwb":
+		return [3]float64{-1, 100, 100}
+	case "lab":
+		return [3]float64{100, 125, 125}
+	case "lch":
+		return [3]float64{100, 150, -1}
+	case "oklab":
+		return [3]float64{1, 0.4, 0.4}
+	case "oklch":
+		return [3]float64{1, 0.4, -1}
+	}
+	return [3]float64{1, 1, 1}
+}
+
+// splitComponents
+// en: Splits the arguments of a color function in components and alpha, accepting
+// commas or spaces and "/"
+//
+// pt_br: Divide os argumentos de uma função de cor em componentes e alpha,
+// aceitando vírgulas ou espaços e "/"
+func splitComponents(arguments string) (components []string, alpha string, err error) {
+	if strings.Contains(arguments, ",") {
+		if strings.Contains(arguments, "/") {
+			return nil, "", fmt.Errorf("commas and \"/\" can not be mixed")
+		}
+		for _, part := range strings.Split(arguments, ",") {
+			components = append(components, strings.TrimSpace(part))
+		}
+		if len(components) == 4 {
+			alpha = components[3]
+			components = components[:3]
+		}
+		return
+	}
+
+	parts := strings.Split(arguments, "/")
+	if len(parts) > 2 {
+		return nil, "", fmt.Errorf("more than one \"/\"")
+	}
+	components = strings.Fields(parts[0])
+	if len(parts) == 2 {
+		alpha = strings.TrimSpace(parts[1])
+		if alpha == "" {
+			return nil, "", fmt.Errorf("missing alpha after \"/\"")
+		}
+	}
+	return
+}
+
+// parseNumber
+// en: Parses a number or a percentage of the scale; none is 0
+//
+// pt_br: Interpreta um número ou uma porcentagem da escala; none é 0
+func parseNumber(component string, scale float64) (float64, error) {
+	if component == "none" {
+		return 0, nil
+	}
+	if strings.HasSuffix(component, "%") {
+		number, err := strconv.ParseFloat(strings.TrimSuffix(component, "%"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid percentage %q", component)
+		}
+		return number / 100 * scale, nil
+	}
+	number, err := strconv.ParseFloat(component, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", component)
+	}
+	return number, nil
+}
+
+// parseHue
+// en: Parses an angle, without unit or with deg, rad, grad or turn, and returns it
+// in degrees; none is 0
+//
+// pt_br: Interpreta um ângulo, sem unidade ou com deg, rad, grad ou turn, e o
+// retorna em graus; none é 0
+func parseHue(component string) (float64, error) {
+	if component == "none" {
+		return 0, nil
+	}
+	units := []struct {
+		suffix string
+		scale  float64
+	}{
+		{"grad", 0.9},
+		{"turn", 360},
+		{"deg", 1},
+		{"rad", 180 / math.Pi},
+	}
+	scale := 1.0
+	for _, unit := range units {
+		if strings.HasSuffix(component, unit.suffix) {
+			component = strings.TrimSuffix(component, unit.suffix)
+			scale = unit.scale
+			break
+		}
+	}
+	number, err := strconv.ParseFloat(component, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hue %q", component)
+	}
+	return number * scale, nil
+}
@@ -0,0 +1,165 @@
+package colorUtils
+
+import (
+	"image/color"
+	"math"
+)
+
+// HSL
+// en: Color as hue, saturation and lightness, as used by the CSS hsl() function
+//
+//	H: Hue in degrees, from 0 to 360
+//	S: Saturation, from 0.0 to 1.0
+//	L: Lightness, from 0.0 to 1.0
+//	Alpha: Opacity, from 0.0 to 1.0
+//
+// pt_br: Cor como matiz, saturação e luminosidade, como usado pela função CSS hsl()
+//
+//	H: Matiz em graus, de 0 a 360
+//	S: Saturação, de 0.0 a 1.0
+//	L: Luminosidade, de 0.0 a 1.0
+//	Alpha: Opacidade, de 0.0 a 1.0
+type HSL struct {
+	H     float64
+	S     float64
+	L     float64
+	Alpha float64
+}
+
+// ToHSL
+// en: Converts a color to HSL
+//
+// pt_br: Converte uma cor para HSL
+func ToHSL(value color.RGBA) HSL {
+	red, green, blue := float64(value.R)/255, float64(value.G)/255, float64(value.B)/255
+	maximum := math.Max(red, math.Max(green, blue))
+	minimum := math.Min(red, math.Min(green, blue))
+	hue := hueOf(red, green, blue, maximum, minimum)
+
+	lightness := (maximum + minimum) / 2
+	saturation := 0.0
+	if delta := maximum - minimum; delta != 0 {
+		saturation = delta / (1 - math.Abs(2*lightness-1))
+	}
+	return HSL{H: hue, S: saturation, L: lightness, Alpha: float64(value.A) / 255}
+}
+
+// ToRGBA
+// en: Converts the HSL color to color.RGBA
+//
+// pt_br: Converte a cor HSL para color.RGBA
+func (el HSL) ToRGBA() color.RGBA {
+	saturation, lightness := clamp(el.S, 0, 1), clamp(el.L, 0, 1)
+	channel := func(n float64) uint8 {
+		k := math.Mod(n+normalizeHue(el.H)/30, 12)
+		a := saturation * math.Min(lightness, 1-lightness)
+		return toByte((lightness - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))) * 255)
+	}
+	return color.RGBA{R: channel(0), G: channel(8), B: channel(4), A: toByte(el.Alpha * 255)}
+}
+
+// HSV
+// en: Color as hue, saturation and value, common in color pickers
+//
+//	H: Hue in degrees, from 0 to 360
+//	S: Saturation, from 0.0 to 1.0
+//	V: Value, from 0.0 to 1.0
+//	Alpha: Opacity, from 0.0 to 1.0
+//
+// pt_br: Cor como matiz, saturação e valor, comum em seletores de cor
+//
+//	H: Matiz em graus, de 0 a 360
+//	S: Saturação, de 0.0 a 1.0
+//	V: Valor, de 0.0 a 1.0
+//	Alpha: Opacidade, de 0.0 a 1.0
+type HSV struct {
+	H     float64
+	S     float64
+	V     float64
+	Alpha float64
+}
+
+// ToHSV
+// en: Converts a color to HSV
+//
+// pt_br: Converte uma cor para HSV
+func ToHSV(value color.RGBA) HSV {
+	red, green, blue := float64(value.R)/255, float64(value.G)/255, float64(value.B)/255
+	maximum := math.Max(red, math.Max(green, blue))
+	minimum := math.Min(red, math.Min(green, blue))
+
+	saturation := 0.0
+	if maximum != 0 {
+		saturation = (maximum - minimum) / maximum
+	}
+	return HSV{H: hueOf(red, green, blue, maximum, minimum), S: saturation, V: maximum, Alpha: float64(value.A) / 255}
+}
+
+// ToRGBA
+// en: Converts the HSV color to color.RGBA
+//
+// pt_br: Converte a cor HSV para color.RGBA
+func (el HSV) ToRGBA() color.RGBA {
+	saturation, value := clamp(el.S, 0, 1), clamp(el.V, 0, 1)
+	channel := func(n float64) uint8 {
+		k := math.Mod(n+normalizeHue(el.H)/60, 6)
+		return toByte((value - value*saturation*math.Max(0, math.Min(k, math.Min(4-k, 1)))) * 255)
+	}
+	return color.RGBA{R: channel(5), G: channel(3), B: channel(1), A: toByte(el.Alpha * 255)}
+}
+
+// HWB
+// en: Color as hue, whiteness and blackness, as used by the CSS hwb() function
+//
+//	H: Hue in degrees, from 0 to 360
+//	W: Whiteness, from 0.0 to 1.0
+//	B: Blackness, from 0.0 to 1.0
+//	Alpha: Opacity, from 0.0 to 1.0
+//
+// pt_br: Cor como matiz, brancura e negrura, como usado pela função CSS hwb()
+//
+//	H: Matiz em graus, de 0 a 360
+//	W: Brancura, de 0.0 a 1.0
+//	B: Negrura, de 0.0 a 1.0
+//	Alpha: Opacidade, de 0.0 a 1.0
+type HWB struct {
+	H     float64
+	W     float64
+	B     float64
+	Alpha float64
+}
+
+// ToRGBA
+// en: Converts the HWB color to color.RGBA
+//
+// pt_br: Converte a cor HWB para color.RGBA
+func (el HWB) ToRGBA() color.RGBA {
+	whiteness, blackness := clamp(el.W, 0, 1), clamp(el.B, 0, 1)
+	if whiteness+blackness >= 1 {
+		gray := toByte(whiteness / (whiteness + blackness) * 255)
+		return color.RGBA{R: gray, G: gray, B: gray, A: toByte(el.Alpha * 255)}
+	}
+	return HSV{H: el.H, S: 1 - whiteness/(1-blackness), V: 1 - blackness, Alpha: el.Alpha}.ToRGBA()
+}
+
+// hueOf
+// en: Returns the hue, in degrees, of the channels from 0.0 to 1.0
+//
+// pt_br: Retorna o matiz, em graus, dos canais de 0.0 a 1.0
+func hueOf(red, green, blue, maximum, minimum float64) float64 {
+	delta := maximum - minimum
+	if delta == 0 {
+		return 0
+	}
+
+	var hue float64
+	switch maximum {
+	case red:
+		hue = math.Mod((green-blue)/delta, 6)
+	case green:
+		hue = (blue-red)/delta + 2
+	default:
+		hue = (red-green)/delta + 4
+	}
+	return normalizeHue(hue * 60)
+}
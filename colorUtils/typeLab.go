@@ -0,0 +1,144 @@
+package colorUtils
+
+import (
+	"image/color"
+	"math"
+)
+
+// Lab
+// en: Color in the CIE Lab space with the D50 white point, as used by the CSS lab()
+// function
+//
+//	L: Lightness, from 0 to 100
+//	A: Green (negative) to red (positive), about -125 to 125
+//	B: Blue (negative) to yellow (positive), about -125 to 125
+//	Alpha: Opacity, from 0.0 to 1.0
+//
+// pt_br: Cor no espaço CIE Lab com o ponto branco D50, como usado pela função CSS
+// lab()
+//
+//	L: Luminosidade, de 0 a 100
+//	A: Verde (negativo) a vermelho (positivo), cerca de -125 a 125
+//	B: Azul (negativo) a amarelo (positivo), cerca de -125 a 125
+//	Alpha: Opacidade, de 0.0 a 1.0
+type Lab struct {
+	L     float64
+	A     float64
+	B     float64
+	Alpha float64
+}
+
+const (
+	kLabEpsilon = 216.0 / 24389.0
+	kLabKappa   = 24389.0 / 27.0
+)
+
+// kWhiteD50
+// en: Reference white D50, in XYZ
+//
+// pt_br: Branco de referência D50, em XYZ
+var kWhiteD50 = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+
+// ToLab
+// en: Converts a color to CIE Lab
+//
+// pt_br: Converte uma cor para CIE Lab
+func ToLab(value color.RGBA) Lab {
+	red, green, blue := ToLinear(value.R), ToLinear(value.G), ToLinear(value.B)
+	xyz := [3]float64{
+		0.436065742824811*red + 0.3851514688337912*green + 0.14307845442264197*blue,
+		0.22249319175623702*red + 0.7168870538238823*green + 0.06061979053616537*blue,
+		0.013923904500943465*red + 0.09708128566574634*green + 0.7140993584005155*blue,
+	}
+
+	var f [3]float64
+	for i := range xyz {
+		ratio := xyz[i] / kWhiteD50[i]
+		if ratio > kLabEpsilon {
+			f[i] = math.Cbrt(ratio)
+		} else {
+			f[i] = (kLabKappa*ratio + 16) / 116
+		}
+	}
+	return Lab{L: 116*f[1] - 16, A: 500 * (f[0] - f[1]), B: 200 * (f[1] - f[2]), Alpha: float64(value.A) / 255}
+}
+
+// ToRGBA
+// en: Converts the Lab color to color.RGBA, clipping the channels out of the sRGB
+// gamut
+//
+// pt_br: Converte a cor Lab para color.RGBA, cortando os canais fora da gama sRGB
+func (el Lab) ToRGBA() color.RGBA {
+	fy := (el.L + 16) / 116
+	fx := el.A/500 + fy
+	fz := fy - el.B/200
+
+	inverse := func(f float64) float64 {
+		if cube := f * f * f; cube > kLabEpsilon {
+			return cube
+		}
+		return (116*f - 16) / kLabKappa
+	}
+	x := inverse(fx) * kWhiteD50[0]
+	z := inverse(fz) * kWhiteD50[2]
+	y := el.L / kLabKappa
+	if el.L > kLabKappa*kLabEpsilon {
+		y = fy * fy * fy
+	}
+
+	red := 3.1341359569958707*x - 1.6173863321612538*y - 0.4906619460083532*z
+	green := -0.978795502912089*x + 1.916254567259524*y + 0.03344273116131949*z
+	blue := 0.07195537988411677*x - 0.2289768264158322*y + 1.405386058324125*z
+	return color.RGBA{R: FromLinear(red), G: FromLinear(green), B: FromLinear(blue), A: toByte(el.Alpha * 255)}
+}
+
+// ToLCH
+// en: Converts the color to the polar form, LCH
+//
+// pt_br: Converte a cor para a forma polar, LCH
+func (el Lab) ToLCH() LCH {
+	return LCH{
+		L:     el.L,
+		C:     math.Hypot(el.A, el.B),
+		H:     normalizeHue(math.Atan2(el.B, el.A) * 180 / math.Pi),
+		Alpha: el.Alpha,
+	}
+}
+
+// LCH
+// en: Polar form of CIE Lab, as used by the CSS lch() function
+//
+//	L: Lightness, from 0 to 100
+//	C: Chroma, from 0 to about 150
+//	H: Hue in degrees, from 0 to 360
+//	Alpha: Opacity, from 0.0 to 1.0
+//
+// pt_br: Forma polar do CIE Lab, como usado pela função CSS lch()
+//
+//	L: Luminosidade, de 0 a 100
+//	C: Croma, de 0 a cerca de 150
+//	H: Matiz em graus, de 0 a 360
+//	Alpha: Opacidade, de 0.0 a 1.0
+type LCH struct {
+	L     float64
+	C     float64
+	H     float64
+	Alpha float64
+}
+
+// ToLab
+// en: Converts the color to the rectangular form, Lab
+//
+// pt_br: Converte a cor para a forma retangular, Lab
+func (el LCH) ToLab() Lab {
+	hue := el.H * math.Pi / 180
+	return Lab{L: el.L, A: el.C * math.Cos(hue), B: el.C * math.Sin(hue), Alpha: el.Alpha}
+}
+
+// ToRGBA
+// en: Converts the LCH color to color.RGBA
+//
+// pt_br: Converte a cor LCH para color.RGBA
+func (el LCH) ToRGBA() color.RGBA {
+	return el.ToLab().ToRGBA()
+}
@@ -0,0 +1,173 @@
+package colorUtils
+
+import (
+	"image/color"
+	"math"
+)
+
+// OKLab
+// en: Color in the perceptual OKLab space, by Björn Ottosson. Equal distances look
+// like equal differences, what makes it the best space for mixing and gradients
+//
+//	L: Lightness, from 0.0 to 1.0
+//	A: Green (negative) to red (positive), about -0.4 to 0.4
+//	B: Blue (negative) to yellow (positive), about -0.4 to 0.4
+//	Alpha: Opacity, from 0.0 to 1.0
+//
+// pt_br: Cor no espaço perceptual OKLab, de Björn Ottosson. Distâncias iguais
+// parecem diferenças iguais, o que o torna o melhor espaço para misturas e
+// gradientes
+//
+//	L: Luminosidade, de 0.0 a 1.0
+//	A: Verde (negativo) a vermelho (positivo), cerca de -0.4 a 0.4
+//	B: Azul (negativo) a amarelo (positivo), cerca de -0.4 a 0.4
+//	Alpha: Opacidade, de 0.0 a 1.0
+type OKLab struct {
+	L     float64
+	A     float64
+	B     float64
+	Alpha float64
+}
+
+// ToOKLab
+// en: Converts a color to OKLab
+//
+// pt_br: Converte uma cor para OKLab
+func ToOKLab(value color.RGBA) OKLab {
+	red, green, blue := ToLinear(value.R), ToLinear(value.G), ToLinear(value.B)
+
+	long := math.Cbrt(0.4122214708*red + 0.5363325363*green + 0.0514459929*blue)
+	medium := math.Cbrt(0.2119034982*red + 0.6806995451*green + 0.1073969566*blue)
+	short := math.Cbrt(0.0883024619*red + 0.2817188376*green + 0.6299787005*blue)
+
+	return OKLab{
+		L:     0.2104542553*long + 0.7936177850*medium - 0.0040720468*short,
+		A:     1.9779984951*long - 2.4285922050*medium + 0.4505937099*short,
+		B:     0.0259040371*long + 0.7827717662*medium - 0.8086757660*short,
+		Alpha: float64(value.A) / 255,
+	}
+}
+
+// ToRGBA
+// en: Converts the OKLab color to color.RGBA, clipping the channels out of the
+// sRGB gamut
+//
+// pt_br: Converte a cor OKLab para color.RGBA, cortando os canais fora da gama sRGB
+func (el OKLab) ToRGBA() color.RGBA {
+	red, green, blue := el.linearRGB()
+	return color.RGBA{R: FromLinear(red), G: FromLinear(green), B: FromLinear(blue), A: toByte(el.Alpha * 255)}
+}
+
+// ToOKLCH
+// en: Converts the color to the polar form, OKLCH
+//
+// pt_br: Converte a cor para a forma polar, OKLCH
+func (el OKLab) ToOKLCH() OKLCH {
+	return OKLCH{
+		L:     el.L,
+		C:     math.Hypot(el.A, el.B),
+		H:     normalizeHue(math.Atan2(el.B, el.A) * 180 / math.Pi),
+		Alpha: el.Alpha,
+	}
+}
+
+// linearRGB
+// en: Returns the linear sRGB channels, which can be out of the range from 0.0 to
+// 1.0
+//
+// pt_br: Retorna os canais sRGB lineares, que podem estar fora da faixa de 0.0 a
+// 1.0
+func (el OKLab) linearRGB() (red, green, blue float64) {
+	long := el.L + 0.3963377774*el.A + 0.2158037573*el.B
+	medium := el.L - 0.1055613458*el.A - 0.0638541728*el.B
+	short := el.L - 0.0894841775*el.A - 1.2914855480*el.B
+
+	long = long * long * long
+	medium = medium * medium * medium
+	short = short * short * short
+
+	red = +4.0767416621*long - 3.3077115913*medium + 0.2309699292*short
+	green = -1.2684380046*long + 2.6097574011*medium - 0.3413193965*short
+	blue = -0.0041960863*long - 0.7034186147*medium + 1.7076147010*short
+	return
+}
+
+// OKLCH
+// en: Polar form of OKLab, as used by the CSS oklch() function. Changing only L
+// keeps the perceived hue, what makes it good for tints, shades and palettes
+//
+//	L: Lightness, from 0.0 to 1.0
+//	C: Chroma, from 0.0 to about 0.4
+//	H: Hue in degrees, from 0 to 360
+//	Alpha: Opacity, from 0.0 to 1.0
+//
+// pt_br: Forma polar do OKLab, como usado pela função CSS oklch(). Alterar apenas L
+// mantém o matiz percebido, o que o torna bom para tons, sombras e paletas
+//
+//	L: Luminosidade, de 0.0 a 1.0
+//	C: Croma, de 0.0 a cerca de 0.4
+//	H: Matiz em graus, de 0 a 360
+//	Alpha: Opacidade, de 0.0 a 1.0
+type OKLCH struct {
+	L     float64
+	C     float64
+	H     float64
+	Alpha float64
+}
+
+// ToOKLCH
+// en: Converts a color to OKLCH
+//
+// pt_br: Converte uma cor para OKLCH
+func ToOKLCH(value color.RGBA) OKLCH {
+	return ToOKLab(value).ToOKLCH()
+}
+
+// ToOKLab
+// en: Converts the color to the rectangular form, OKLab
+//
+// pt_br: Converte a cor para a forma retangular, OKLab
+func (el OKLCH) ToOKLab() OKLab {
+	hue := el.H * math.Pi / 180
+	return OKLab{L: el.L, A: el.C * math.Cos(hue), B: el.C * math.Sin(hue), Alpha: el.Alpha}
+}
+
+// ToRGBA
+// en: Converts the OKLCH color to color.RGBA. Colors out of the sRGB gamut have the
+// chroma reduced, keeping lightness and hue, as recommended by CSS Color 4
+//
+// pt_br: Converte a cor OKLCH para color.RGBA. Cores fora da gama sRGB têm o croma
+// reduzido, mantendo luminosidade e matiz, como recomendado pelo CSS Color 4
+func (el OKLCH) ToRGBA() color.RGBA {
+	el.L = clamp(el.L, 0, 1)
+	if el.L == 0 || el.L == 1 || el.inGamut() {
+		return el.ToOKLab().ToRGBA()
+	}
+
+	minimum, maximum := 0.0, el.C
+	for i := 0; i < 24; i += 1 {
+		el.C = (minimum + maximum) / 2
+		if el.inGamut() {
+			minimum = el.C
+		} else {
+			maximum = el.C
+		}
+	}
+	el.C = minimum
+	return el.ToOKLab().ToRGBA()
+}
+
+// inGamut
+// en: Returns true when the color can be represented in sRGB
+//
+// pt_br: Retorna true quando a cor pode ser representada em sRGB
+func (el OKLCH) inGamut() bool {
+	const tolerance = 0.0001
+	red, green, blue := el.ToOKLab().linearRGB()
+	for _, channel := range []float64{red, green, blue} {
+		if channel < -tolerance || channel > 1+tolerance {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+package colorUtils
+
+import (
+	"image/color"
+	"math"
+)
+
+// Palette
+// en: Ordered list of colors
+//
+// pt_br: Lista ordenada de cores
+type Palette []color.RGBA
+
+// KScaleSteps
+// en: Names of the steps of NewScale(), as in the common 50 to 900 design scales
+//
+// pt_br: Nomes dos passos de NewScale(), como nas escalas de design comuns de 50 a
+// 900
+var KScaleSteps = []int{50, 100, 200, 300, 400, 500, 600, 700, 800, 900}
+
+// NewScale
+// en: Returns ten colors with the hue of the base color, from very light (50) to
+// very dark (900), as listed by KScaleSteps. The base color is at 500
+//
+// pt_br: Retorna dez cores com o matiz da cor base, de muito clara (50) a muito
+// escura (900), como listado por KScaleSteps. A cor base fica em 500
+func NewScale(base color.RGBA) Palette {
+	lightness := []float64{0.97, 0.93, 0.87, 0.78, 0.68, 0, 0.50, 0.42, 0.34, 0.26}
+	lch := ToOKLCH(base)
+	palette := make(Palette, 0, len(lightness))
+	for i, target := range lightness {
+		if i == 5 {
+			palette = append(palette, base)
+			continue
+		}
+		step := lch
+		step.L = target
+		// en: the chroma fades close to white and black, where the gamut is narrow
+		// pt_br: o croma diminui perto do branco e do preto, onde a gama é estreita
+		step.C = lch.C * math.Min(1, 4*target*(1-target)/0.75)
+		palette = append(palette, step.ToRGBA())
+	}
+	return palette
+}
+
+// NewHarmony
+// en: Returns the base color followed by the colors with the hue rotated by each
+// angle, in OKLCH
+//
+// pt_br: Retorna a cor base seguida das cores com o matiz girado por cada ângulo,
+// em OKLCH
+func NewHarmony(base color.RGBA, angles ...float64) Palette {
+	palette := Palette{base}
+	for _, angle := range angles {
+		palette = append(palette, RotateHue(base, angle))
+	}
+	return palette
+}
+
+// NewComplementary
+// en: Returns the base color and its opposite hue
+//
+// pt_br: Retorna a cor base e o seu matiz oposto
+func NewComplementary(base color.RGBA) Palette {
+	return NewHarmony(base, 180)
+}
+
+// NewAnalogous
+// en: Returns the base color and the two neighbour hues, 30 degrees away
+//
+// pt_br: Retorna a cor base e os dois matizes vizinhos, a 30 graus de distância
+func NewAnalogous(base color.RGBA) Palette {
+	return NewHarmony(base, -30, 30)
+}
+
+// NewTriadic
+// en: Returns the base color and the two hues 120 degrees away
+//
+// pt_br: Retorna a cor base e os dois matizes a 120 graus de distância
+func NewTriadic(base color.RGBA) Palette {
+	return NewHarmony(base, 120, 240)
+}
+
+// NewCategorical
+// en: Returns count colors with evenly spaced hues and the same perceived
+// lightness and chroma, intended for series of charts, or nil when count is not
+// positive
+//
+//	lightness: OKLCH lightness, like 0.65
+//	chroma: OKLCH chroma, like 0.15
+//
+// pt_br: Retorna count cores com matizes espaçados uniformemente e a mesma
+// luminosidade e croma percebidos, destinadas a séries de gráficos, ou nil quando
+// count não é positivo
+//
+//	lightness: Luminosidade OKLCH, como 0.65
+//	chroma: Croma OKLCH, como 0.15
+func NewCategorical(count int, lightness, chroma float64) Palette {
+	if count <= 0 {
+		return nil
+	}
+	palette := make(Palette, 0, count)
+	for i := 0; i < count; i += 1 {
+		// en: starts at 25 degrees, a red, instead of a pink
+		// pt_br: começa em 25 graus, um vermelho, em vez de um rosa
+		hue := 25 + 360*float64(i)/float64(count)
+		palette = append(palette, OKLCH{L: lightness, C: chroma, H: hue, Alpha: 1}.ToRGBA())
+	}
+	return palette
+}
+
+// NewAccessible
+// en: Returns count categorical colors, all with at least the contrast ratio over
+// the background
+//
+//	ratio: Minimum contrast ratio, like KContrastAALarge for graphics
+//
+// pt_br: Retorna count cores categóricas, todas com pelo menos a razão de
+// contraste sobre o fundo
+//
+//	ratio: Razão de contraste mínima, como KContrastAALarge para gráficos
+func NewAccessible(background color.RGBA, count int, ratio float64) Palette {
+	lightness := 0.70
+	if RelativeLuminance(background) > 0.18 {
+		lightness = 0.55
+	}
+	palette := NewCategorical(count, lightness, 0.14)
+	for i := range palette {
+		palette[i] = EnsureContrast(palette[i], background, ratio)
+	}
+	return palette
+}
+
+// Contrasts
+// en: Returns the contrast ratio of each color over the background
+//
+// pt_br: Retorna a razão de contraste de cada cor sobre o fundo
+func (el Palette) Contrasts(background color.RGBA) []float64 {
+	list := make([]float64, len(el))
+	for i, value := range el {
+		list[i] = ContrastRatio(value, background)
+	}
+	return list
+}
+
+// Hex
+// en: Returns the colors as #rrggbb strings
+//
+// pt_br: Retorna as cores como strings #rrggbb
+func (el Palette) Hex() []string {
+	list := make([]string, len(el))
+	for i, value := range el {
+		list[i] = ToHex(value)
+	}
+	return list
+}
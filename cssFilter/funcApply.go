@@ -0,0 +1,127 @@
+package cssFilter
+
+import (
+	"image"
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/effect"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/imageOps"
+)
+
+// Apply
+// en: Returns a copy of the image with every function of the list applied in
+// order, for backends without native filter support. Consecutive color functions
+// are applied in a single pass over the pixels, clamping the colors to [0, 1]
+// after each function as the Filter Effects specification, and blur uses
+// effect.GaussianBlur()
+//
+//	img: Image with premultiplied alpha, see effect.FromImageData()
+//
+// pt_br: Retorna uma cópia da imagem com todas as funções da lista aplicadas em
+// ordem, para backends sem suporte nativo a filtros. Funções de cor consecutivas
+// são aplicadas em uma única passada sobre os pixels, limitando as cores a [0, 1]
+// após cada função como a especificação Filter Effects, e blur usa
+// effect.GaussianBlur()
+//
+//	img: Imagem com alpha pré-multiplicado, veja effect.FromImageData()
+func (el List) Apply(img *image.RGBA) *image.RGBA {
+	result := effect.Pad(img, 0)
+	pending := make([]imageOps.ColorMatrix, 0)
+
+	flush := func() {
+		if len(pending) != 0 {
+			applyMatrices(result, pending)
+			pending = pending[:0]
+		}
+	}
+
+	for _, function := range el {
+		if blur, ok := function.(Blur); ok {
+			flush()
+			result = effect.GaussianBlur(result, blur.Radius)
+			continue
+		}
+		matrix, ok := functionMatrix(function)
+		if !ok {
+			continue
+		}
+		pending = append(pending, matrix)
+	}
+	flush()
+	return result
+}
+
+// functionMatrix
+// en: Returns the matrix of each color function, as defined by the Filter Effects
+// specification, see the matrices of the imageOps package
+//
+// pt_br: Retorna a matriz de cada função de cor, como definido pela especificação
+// Filter Effects, veja as matrizes do pacote imageOps
+func functionMatrix(function Function) (matrix imageOps.ColorMatrix, ok bool) {
+	switch converted := function.(type) {
+	case Brightness:
+		return imageOps.NewBrightnessMatrix(converted.Amount), true
+	case Contrast:
+		return imageOps.NewContrastMatrix(converted.Amount), true
+	case Grayscale:
+		return imageOps.NewGrayscaleMatrix(converted.Amount), true
+	case Sepia:
+		return imageOps.NewSepiaMatrix(converted.Amount), true
+	case Saturate:
+		return imageOps.NewSaturateMatrix(converted.Amount), true
+	case HueRotate:
+		return imageOps.NewHueRotateMatrix(converted.Angle), true
+	case Invert:
+		return imageOps.NewInvertMatrix(converted.Amount), true
+	case Opacity:
+		return imageOps.NewOpacityMatrix(converted.Amount), true
+	}
+	return imageOps.ColorMatrix{}, false
+}
+
+// applyMatrices
+// en: Applies the matrices in order, in place, to a premultiplied image; the values
+// are clamped to [0, 1] after each matrix
+//
+// pt_br: Aplica as matrizes em ordem, no lugar, em uma imagem pré-multiplicada; os
+// valores são limitados a [0, 1] após cada matriz
+func applyMatrices(img *image.RGBA, matrices []imageOps.ColorMatrix) {
+	// en: a transparent pixel stays transparent unless a matrix adds alpha
+	// pt_br: um pixel transparente continua transparente a menos que uma matriz
+	// adicione alpha
+	addsAlpha := false
+	for _, matrix := range matrices {
+		addsAlpha = addsAlpha || matrix[19] > 0
+	}
+
+	for i := 0; i < len(img.Pix); i += 4 {
+		alpha := float64(img.Pix[i+3]) / 255
+		if alpha == 0 && !addsAlpha {
+			continue
+		}
+		var values [4]float64
+		if alpha > 0 {
+			values = [4]float64{
+				float64(img.Pix[i+0]) / 255 / alpha,
+				float64(img.Pix[i+1]) / 255 / alpha,
+				float64(img.Pix[i+2]) / 255 / alpha,
+				alpha,
+			}
+		}
+		for _, matrix := range matrices {
+			var output [4]float64
+			for row := 0; row < 4; row += 1 {
+				value := matrix[row*5+4]
+				for k := 0; k < 4; k += 1 {
+					value += matrix[row*5+k] * values[k]
+				}
+				output[row] = math.Max(0, math.Min(1, value))
+			}
+			values = output
+		}
+		img.Pix[i+3] = uint8(math.Round(values[3] * 255))
+		for channel := 0; channel < 3; channel += 1 {
+			img.Pix[i+channel] = uint8(math.Round(values[channel] * values[3] * 255))
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package cssFilter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Parse
+// en: Parses a CSS filter string, like "grayscale(100%) blur(2px)" or "none"
+//
+//	Supported functions: blur(), brightness(), contrast(), grayscale(),
+//	hue-rotate(), invert(), opacity(), saturate() and sepia()
+//	Amounts accept numbers and percentages, blur accepts px and hue-rotate
+//	accepts deg, rad, grad and turn
+//
+// pt_br: Interpreta uma string de filtro CSS, como "grayscale(100%) blur(2px)" ou
+// "none"
+//
+//	Funções suportadas: blur(), brightness(), contrast(), grayscale(),
+//	hue-rotate(), invert(), opacity(), saturate() e sepia()
+//	Quantidades aceitam números e porcentagens, blur aceita px e hue-rotate
+//	aceita deg, rad, grad e turn
+func Parse(filter string) (list List, err error) {
+	filter = strings.TrimSpace(filter)
+	list = make(List, 0)
+	if filter == "" || strings.EqualFold(filter, "none") {
+		return
+	}
+
+	for len(filter) > 0 {
+		open := strings.IndexByte(filter, '(')
+		if open == -1 {
+			return nil, fmt.Errorf("cssFilter: expected function at %q", filter)
+		}
+		closing := strings.IndexByte(filter, ')')
+		if closing < open {
+			return nil, fmt.Errorf("cssFilter: missing ')' at %q", filter)
+		}
+
+		name := strings.ToLower(strings.TrimSpace(filter[:open]))
+		argument := strings.TrimSpace(filter[open+1 : closing])
+		filter = strings.TrimSpace(filter[closing+1:])
+
+		var function Function
+		function, err = parseFunction(name, argument)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, function)
+	}
+	return
+}
+
+func parseFunction(name, argument string) (function Function, err error) {
+	switch name {
+	case "blur":
+		var radius float64
+		radius, err = parseLength(argument)
+		return Blur{Radius: radius}, err
+	case "hue-rotate":
+		var angle float64
+		angle, err = parseAngle(argument)
+		return HueRotate{Angle: angle}, err
+	}
+
+	switch name {
+	case "brightness", "contrast", "saturate", "grayscale", "invert", "opacity", "sepia":
+	default:
+		return nil, fmt.Errorf("cssFilter: unsupported function %v()", name)
+	}
+
+	var amount float64
+	amount, err = parseAmount(argument)
+	if err != nil {
+		return nil, fmt.Errorf("cssFilter: %v(): %v", name, err)
+	}
+
+	switch name {
+	case "brightness":
+		return Brightness{Amount: amount}, nil
+	case "contrast":
+		return Contrast{Amount: amount}, nil
+	case "saturate":
+		return Saturate{Amount: amount}, nil
+	case "grayscale":
+		return Grayscale{Amount: math.Min(amount, 1)}, nil
+	case "invert":
+		return Invert{Amount: math.Min(amount, 1)}, nil
+	case "opacity":
+		return Opacity{Amount: math.Min(amount, 1)}, nil
+	case "sepia":
+		return Sepia{Amount: math.Min(amount, 1)}, nil
+	}
+	return
+}
+
+// parseAmount
+// en: Parses a number or a percentage; an empty argument is 1, the CSS default
+//
+// pt_br: Interpreta um número ou uma porcentagem; um argumento vazio é 1, o padrão
+// do CSS
+func parseAmount(argument string) (amount float64, err error) {
+	if argument == "" {
+		return 1, nil
+	}
+	divisor := 1.0
+	if strings.HasSuffix(argument, "%") {
+		argument = strings.TrimSuffix(argument, "%")
+		divisor = 100
+	}
+	amount, err = strconv.ParseFloat(strings.TrimSpace(argument), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", argument)
+	}
+	if amount < 0 {
+		return 0, fmt.Errorf("negative amount %q", argument)
+	}
+	return amount / divisor, nil
+}
+
+// parseLength
+// en: Parses a length in pixels; an empty argument is 0
+//
+// pt_br: Interpreta um comprimento em pixels; um argumento vazio é 0
+func parseLength(argument string) (length float64, err error) {
+	if argument == "" {
+		return 0, nil
+	}
+	value := strings.TrimSuffix(strings.ToLower(argument), "px")
+	length, err = strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || length < 0 || (value == argument && length != 0) {
+		return 0, fmt.Errorf("cssFilter: blur(): invalid length %q", argument)
+	}
+	return length, nil
+}
+
+// parseAngle
+// en: Parses an angle and returns it in degrees; an empty argument is 0
+//
+// pt_br: Interpreta um ângulo e o retorna em graus; um argumento vazio é 0
+func parseAngle(argument string) (angle float64, err error) {
+	if argument == "" {
+		return 0, nil
+	}
+	argument = strings.ToLower(argument)
+	units := []struct {
+		suffix string
+		scale  float64
+	}{
+		{"grad", 0.9},
+		{"turn", 360},
+		{"deg", 1},
+		{"rad", 180 / math.Pi},
+	}
+	for _, unit := range units {
+		if !strings.HasSuffix(argument, unit.suffix) {
+			continue
+		}
+		angle, err = strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(argument, unit.suffix)), 64)
+		if err != nil {
+			return 0, fmt.Errorf("cssFilter: hue-rotate(): invalid angle %q", argument)
+		}
+		return angle * unit.scale, nil
+	}
+
+	angle, err = strconv.ParseFloat(argument, 64)
+	if err != nil || angle != 0 {
+		return 0, fmt.Errorf("cssFilter: hue-rotate(): invalid angle %q", argument)
+	}
+	return 0, nil
+}
@@ -0,0 +1,172 @@
+package cssFilter
+
+import (
+	"strconv"
+)
+
+// Function
+// en: One function of a CSS filter list, like blur(4px) or grayscale(100%)
+//
+// pt_br: Uma função de uma lista de filtros CSS, como blur(4px) ou grayscale(100%)
+type Function interface {
+
+	// String
+	// en: Returns the function in CSS syntax
+	//
+	// pt_br: Retorna a função na sintaxe CSS
+	String() string
+}
+
+// Blur
+// en: Gaussian blur; Radius is the standard deviation, in pixels
+//
+//	CSS syntax: blur(4px)
+//
+// pt_br: Borrão Gaussiano; Radius é o desvio padrão em pixels
+//
+//	Sintaxe CSS: blur(4px)
+type Blur struct {
+	Radius float64
+}
+
+func (el Blur) String() string {
+	return "blur(" + formatNumber(el.Radius) + "px)"
+}
+
+// Brightness
+// en: Multiplies the color channels; 0 is black, 1 keeps the image and values
+// above 1 make it brighter
+//
+//	CSS syntax: brightness(150%)
+//
+// pt_br: Multiplica os canais de cor; 0 é preto, 1 mantém a imagem e valores acima
+// de 1 a tornam mais clara
+//
+//	Sintaxe CSS: brightness(150%)
+type Brightness struct {
+	Amount float64
+}
+
+func (el Brightness) String() string {
+	return "brightness(" + formatNumber(el.Amount) + ")"
+}
+
+// Contrast
+// en: Changes the contrast; 0 is gray, 1 keeps the image and values above 1
+// increase the contrast
+//
+//	CSS syntax: contrast(200%)
+//
+// pt_br: Muda o contraste; 0 é cinza, 1 mantém a imagem e valores acima de 1
+// aumentam o contraste
+//
+//	Sintaxe CSS: contrast(200%)
+type Contrast struct {
+	Amount float64
+}
+
+func (el Contrast) String() string {
+	return "contrast(" + formatNumber(el.Amount) + ")"
+}
+
+// Grayscale
+// en: Converts to gray; 0 keeps the image and 1 is completely gray
+//
+//	CSS syntax: grayscale(100%)
+//
+// pt_br: Converte para cinza; 0 mantém a imagem e 1 é completamente cinza
+//
+//	Sintaxe CSS: grayscale(100%)
+type Grayscale struct {
+	Amount float64
+}
+
+func (el Grayscale) String() string {
+	return "grayscale(" + formatNumber(el.Amount) + ")"
+}
+
+// HueRotate
+// en: Rotates the hue of the colors by Angle degrees
+//
+//	CSS syntax: hue-rotate(90deg)
+//
+// pt_br: Gira o matiz das cores em Angle graus
+//
+//	Sintaxe CSS: hue-rotate(90deg)
+type HueRotate struct {
+	Angle float64
+}
+
+func (el HueRotate) String() string {
+	return "hue-rotate(" + formatNumber(el.Angle) + "deg)"
+}
+
+// Invert
+// en: Inverts the colors; 0 keeps the image and 1 is completely inverted
+//
+//	CSS syntax: invert(100%)
+//
+// pt_br: Inverte as cores; 0 mantém a imagem e 1 é completamente invertida
+//
+//	Sintaxe CSS: invert(100%)
+type Invert struct {
+	Amount float64
+}
+
+func (el Invert) String() string {
+	return "invert(" + formatNumber(el.Amount) + ")"
+}
+
+// Opacity
+// en: Multiplies the alpha channel; 0 is transparent and 1 keeps the image
+//
+//	CSS syntax: opacity(50%)
+//
+// pt_br: Multiplica o canal alpha; 0 é transparente e 1 mantém a imagem
+//
+//	Sintaxe CSS: opacity(50%)
+type Opacity struct {
+	Amount float64
+}
+
+func (el Opacity) String() string {
+	return "opacity(" + formatNumber(el.Amount) + ")"
+}
+
+// Saturate
+// en: Changes the saturation; 0 is gray, 1 keeps the image and values above 1
+// make the colors stronger
+//
+//	CSS syntax: saturate(200%)
+//
+// pt_br: Muda a saturação; 0 é cinza, 1 mantém a imagem e valores acima de 1
+// tornam as cores mais fortes
+//
+//	Sintaxe CSS: saturate(200%)
+type Saturate struct {
+	Amount float64
+}
+
+func (el Saturate) String() string {
+	return "saturate(" + formatNumber(el.Amount) + ")"
+}
+
+// Sepia
+// en: Converts to sepia; 0 keeps the image and 1 is completely sepia
+//
+//	CSS syntax: sepia(100%)
+//
+// pt_br: Converte para sépia; 0 mantém a imagem e 1 é completamente sépia
+//
+//	Sintaxe CSS: sepia(100%)
+type Sepia struct {
+	Amount float64
+}
+
+func (el Sepia) String() string {
+	return "sepia(" + formatNumber(el.Amount) + ")"
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
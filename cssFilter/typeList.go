@@ -0,0 +1,34 @@
+package cssFilter
+
+import (
+	"strings"
+)
+
+// List
+// en: Ordered list of filter functions, the value of IDraw.SetFilter(). An empty
+// list means no filter
+//
+//	Example: cssFilter.List{cssFilter.Grayscale{Amount: 1}, cssFilter.Opacity{Amount: 0.5}}
+//
+// pt_br: Lista ordenada de funções de filtro, o valor de IDraw.SetFilter(). Uma
+// lista vazia significa nenhum filtro
+//
+//	Exemplo: cssFilter.List{cssFilter.Grayscale{Amount: 1}, cssFilter.Opacity{Amount: 0.5}}
+type List []Function
+
+// String
+// en: Returns the list in CSS syntax, the value used by context.filter in web
+// browsers; an empty list returns "none"
+//
+// pt_br: Retorna a lista na sintaxe CSS, o valor usado por context.filter nos
+// navegadores web; uma lista vazia retorna "none"
+func (el List) String() string {
+	if len(el) == 0 {
+		return "none"
+	}
+	list := make([]string, 0, len(el))
+	for _, function := range el {
+		list = append(list, function.String())
+	}
+	return strings.Join(list, " ")
+}
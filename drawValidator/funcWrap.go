@@ -0,0 +1,17 @@
+//go:build !drawvalidator
+
+package drawValidator
+
+import (
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// Wrap
+// en: Returns the platform unchanged. Build with -tags drawvalidator to wrap the
+// platform with a Validator that logs every violation
+//
+// pt_br: Retorna a plataforma sem alterações. Compile com -tags drawvalidator para
+// envolver a plataforma com um Validator que registra em log todas as violações
+func Wrap(platform iotmakerPlatformIDraw.IDraw) iotmakerPlatformIDraw.IDraw {
+	return platform
+}
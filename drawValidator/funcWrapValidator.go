@@ -0,0 +1,23 @@
+//go:build drawvalidator
+
+package drawValidator
+
+import (
+	"log"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// Wrap
+// en: Returns the platform wrapped by a Validator that logs every violation with
+// its call stack. This version is used when the code is built with
+// -tags drawvalidator
+//
+// pt_br: Retorna a plataforma envolvida por um Validator que registra em log todas
+// as violações com a sua pilha de chamadas. Esta versão é usada quando o código é
+// compilado com -tags drawvalidator
+func Wrap(platform iotmakerPlatformIDraw.IDraw) iotmakerPlatformIDraw.IDraw {
+	return New(platform, func(violation Violation) {
+		log.Print(violation.String())
+	})
+}
@@ -0,0 +1,449 @@
+package drawValidator
+
+import (
+	"fmt"
+	"image/color"
+	"reflect"
+	"runtime"
+	"sync"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// Validator
+// en: IDraw wrapper that forwards every call to the wrapped platform and reports
+// misuse: unbalanced Save()/Restore(), Fill()/Stroke() without BeginPath() and
+// gradients not created by the same context. Paths reused after painting are
+// reported only after SetCheckPathReuse(true). Use it in development builds and
+// tests; see Wrap()
+//
+// pt_br: Envoltório de IDraw que repassa todas as chamadas para a plataforma
+// envolvida e relata usos incorretos: Save()/Restore() desbalanceados,
+// Fill()/Stroke() sem BeginPath() e gradientes não criados pelo mesmo contexto.
+// Caminhos reaproveitados após a pintura são relatados apenas após
+// SetCheckPathReuse(true). Use em builds de desenvolvimento e testes; veja Wrap()
+type Validator struct {
+	iotmakerPlatformIDraw.IDraw
+
+	mutex      sync.Mutex
+	violations []Violation
+
+	// en: stack of every Save() call not yet restored
+	// pt_br: pilha de cada chamada Save() ainda não restaurada
+	saveStack [][]runtime.Frame
+
+	pathBegun      bool
+	pathCommands   int
+	pathPainted    bool
+	checkPathReuse bool
+
+	// en: gradients created by this context, the last KMaxGradients in a ring;
+	// comparable handles are also indexed by value
+	// pt_br: gradientes criados por este contexto, os últimos KMaxGradients em um
+	// anel; handles comparáveis também são indexados pelo valor
+	gradients     []*gradientInfo
+	gradientNext  int
+	gradientIndex map[interface{}]*gradientInfo
+
+	onViolation func(violation Violation)
+}
+
+// KMaxGradients
+// en: Number of gradients tracked by a Validator. Older gradients are forgotten, so
+// the memory stays bounded when gradients are created on every frame; a color stop
+// added to a forgotten gradient is reported as KViolationUnknownGradient
+//
+// pt_br: Número de gradientes monitorados por um Validator. Gradientes mais antigos
+// são esquecidos, assim a memória fica limitada quando gradientes são criados a
+// cada quadro; uma parada de cor adicionada a um gradiente esquecido é relatada
+// como KViolationUnknownGradient
+const KMaxGradients = 1024
+
+type gradientInfo struct {
+	gradient   interface{}
+	colorStops int
+}
+
+// New
+// en: Returns a new validator wrapping the platform
+//
+//	platform: IDraw that receives every call
+//	onViolation: [optional] Function called for each violation when it happens,
+//	             for example to log it or to call t.Error() in tests
+//
+// pt_br: Retorna um novo validador envolvendo a plataforma
+//
+//	platform: IDraw que recebe todas as chamadas
+//	onViolation: [opcional] Função chamada para cada violação quando ela
+//	             acontece, por exemplo para registrar em log ou chamar t.Error()
+//	             em testes
+func New(platform iotmakerPlatformIDraw.IDraw, onViolation func(violation Violation)) *Validator {
+	return &Validator{
+		IDraw:       platform,
+		onViolation: onViolation,
+	}
+}
+
+// SetCheckPathReuse
+// en: Enables the report of KViolationPathReused, disabled by default
+//
+// pt_br: Habilita o relato de KViolationPathReused, desabilitado por padrão
+func (el *Validator) SetCheckPathReuse(enabled bool) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	el.checkPathReuse = enabled
+}
+
+// GetViolations
+// en: Returns every violation detected since the creation or the last Reset()
+//
+// pt_br: Retorna todas as violações detectadas desde a criação ou o último Reset()
+func (el *Validator) GetViolations() []Violation {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	return append([]Violation{}, el.violations...)
+}
+
+// GetSaveDepth
+// en: Returns the number of Save() calls not yet restored
+//
+// pt_br: Retorna o número de chamadas Save() ainda não restauradas
+func (el *Validator) GetSaveDepth() int {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	return len(el.saveStack)
+}
+
+// Check
+// en: Reports a KViolationSaveWithoutRestore for each Save() not yet restored and
+// returns all violations as a single error, or nil. Call it at the end of each
+// frame, or at the end of the drawing of a widget
+//
+// pt_br: Relata uma KViolationSaveWithoutRestore para cada Save() ainda não
+// restaurado e retorna todas as violações como um único erro, ou nil. Chame ao
+// final de cada quadro, ou ao final do desenho de um widget
+func (el *Validator) Check() (err error) {
+	el.mutex.Lock()
+	saveStack := el.saveStack
+	el.saveStack = nil
+	el.mutex.Unlock()
+
+	for i, stack := range saveStack {
+		el.report(Violation{
+			Kind:    KViolationSaveWithoutRestore,
+			Message: fmt.Sprintf("save number %v of %v was not restored", i+1, len(saveStack)),
+			Stack:   stack,
+		})
+	}
+
+	violations := el.GetViolations()
+	if len(violations) == 0 {
+		return nil
+	}
+	return Violations(violations)
+}
+
+// Reset
+// en: Clears the violations and the tracked state
+//
+// pt_br: Limpa as violações e o estado monitorado
+func (el *Validator) Reset() {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	el.violations = nil
+	el.saveStack = nil
+	el.pathBegun = false
+	el.pathCommands = 0
+	el.pathPainted = false
+	el.gradients = nil
+	el.gradientNext = 0
+	el.gradientIndex = nil
+}
+
+// Save
+// en: Forwards Save() and records the call stack of the call
+//
+// pt_br: Repassa Save() e registra a pilha de chamadas da chamada
+func (el *Validator) Save() {
+	el.mutex.Lock()
+	el.saveStack = append(el.saveStack, callerStack())
+	el.mutex.Unlock()
+	el.IDraw.Save()
+}
+
+// Restore
+// en: Forwards Restore() and reports a Restore() without Save()
+//
+// pt_br: Repassa Restore() e relata um Restore() sem Save()
+func (el *Validator) Restore() {
+	el.mutex.Lock()
+	depth := len(el.saveStack)
+	if depth > 0 {
+		el.saveStack = el.saveStack[:depth-1]
+	}
+	el.mutex.Unlock()
+
+	if depth == 0 {
+		el.report(Violation{
+			Kind:    KViolationRestoreWithoutSave,
+			Message: "restore called with an empty state stack",
+			Stack:   callerStack(),
+		})
+	}
+	el.IDraw.Restore()
+}
+
+// BeginPath
+// en: Forwards BeginPath() and starts tracking a new path
+//
+// pt_br: Repassa BeginPath() e começa a monitorar um novo caminho
+func (el *Validator) BeginPath() {
+	el.mutex.Lock()
+	el.pathBegun = true
+	el.pathCommands = 0
+	el.pathPainted = false
+	el.mutex.Unlock()
+	el.IDraw.BeginPath()
+}
+
+// MoveTo
+// en: Forwards MoveTo() and checks the path lifecycle
+//
+// pt_br: Repassa MoveTo() e verifica o ciclo de vida do caminho
+func (el *Validator) MoveTo(x, y interface{}) {
+	el.pathCommand("MoveTo")
+	el.IDraw.MoveTo(x, y)
+}
+
+// LineTo
+// en: Forwards LineTo() and checks the path lifecycle
+//
+// pt_br: Repassa LineTo() e verifica o ciclo de vida do caminho
+func (el *Validator) LineTo(x, y interface{}) {
+	el.pathCommand("LineTo")
+	el.IDraw.LineTo(x, y)
+}
+
+// ArcTo
+// en: Forwards ArcTo() and checks the path lifecycle
+//
+// pt_br: Repassa ArcTo() e verifica o ciclo de vida do caminho
+func (el *Validator) ArcTo(x, y, radius, startAngle, endAngle interface{}) {
+	el.pathCommand("ArcTo")
+	el.IDraw.ArcTo(x, y, radius, startAngle, endAngle)
+}
+
+// ClosePath
+// en: Forwards ClosePath() and checks the path lifecycle
+//
+// pt_br: Repassa ClosePath() e verifica o ciclo de vida do caminho
+func (el *Validator) ClosePath(x, y interface{}) {
+	el.pathCommand("ClosePath")
+	el.IDraw.ClosePath(x, y)
+}
+
+// Fill
+// en: Forwards Fill() and checks the path lifecycle
+//
+// pt_br: Repassa Fill() e verifica o ciclo de vida do caminho
+func (el *Validator) Fill() {
+	el.paint("Fill")
+	el.IDraw.Fill()
+}
+
+// Stroke
+// en: Forwards Stroke() and checks the path lifecycle
+//
+// pt_br: Repassa Stroke() e verifica o ciclo de vida do caminho
+func (el *Validator) Stroke() {
+	el.paint("Stroke")
+	el.IDraw.Stroke()
+}
+
+// CreateLinearGradient
+// en: Forwards CreateLinearGradient() and records the gradient as created by this
+// context
+//
+// pt_br: Repassa CreateLinearGradient() e registra o gradiente como criado por este
+// contexto
+func (el *Validator) CreateLinearGradient(x0, y0, x1, y1 interface{}) interface{} {
+	gradient := el.IDraw.CreateLinearGradient(x0, y0, x1, y1)
+	el.mutex.Lock()
+	el.addGradient(gradient)
+	el.mutex.Unlock()
+	return gradient
+}
+
+// CreateRadialGradient
+// en: Forwards CreateRadialGradient() and records the gradient as created by this
+// context
+//
+// pt_br: Repassa CreateRadialGradient() e registra o gradiente como criado por este
+// contexto
+func (el *Validator) CreateRadialGradient(x0, y0, r0, x1, y1, r1 interface{}) interface{} {
+	gradient := el.IDraw.CreateRadialGradient(x0, y0, r0, x1, y1, r1)
+	el.mutex.Lock()
+	el.addGradient(gradient)
+	el.mutex.Unlock()
+	return gradient
+}
+
+// AddColorStopPosition
+// en: Forwards AddColorStopPosition() and reports gradients not created by this
+// context
+//
+// pt_br: Repassa AddColorStopPosition() e relata gradientes não criados por este
+// contexto
+func (el *Validator) AddColorStopPosition(gradient interface{}, stop float64, color color.RGBA) {
+	el.mutex.Lock()
+	info := el.findGradient(gradient)
+	if info != nil {
+		info.colorStops += 1
+	}
+	el.mutex.Unlock()
+
+	if info == nil {
+		el.report(Violation{
+			Kind:    KViolationUnknownGradient,
+			Message: fmt.Sprintf("gradient %T was not created by CreateLinearGradient() or CreateRadialGradient() of this context", gradient),
+			Stack:   callerStack(),
+		})
+	}
+	el.IDraw.AddColorStopPosition(gradient, stop, color)
+}
+
+// SetFillStyle
+// en: Forwards SetFillStyle() and reports gradients without color stops
+//
+// pt_br: Repassa SetFillStyle() e relata gradientes sem paradas de cor
+func (el *Validator) SetFillStyle(value interface{}) {
+	el.checkStyle("SetFillStyle", value)
+	el.IDraw.SetFillStyle(value)
+}
+
+// SetStrokeStyle
+// en: Forwards SetStrokeStyle() and reports gradients without color stops
+//
+// pt_br: Repassa SetStrokeStyle() e relata gradientes sem paradas de cor
+func (el *Validator) SetStrokeStyle(value interface{}) {
+	el.checkStyle("SetStrokeStyle", value)
+	el.IDraw.SetStrokeStyle(value)
+}
+
+func (el *Validator) pathCommand(name string) {
+	el.mutex.Lock()
+	painted := el.pathPainted
+	begun := el.pathBegun
+	checkPathReuse := el.checkPathReuse
+	el.pathCommands += 1
+	el.pathPainted = false
+	el.mutex.Unlock()
+
+	if checkPathReuse && begun && painted {
+		el.report(Violation{
+			Kind:    KViolationPathReused,
+			Message: fmt.Sprintf("%v called after Fill() or Stroke() without BeginPath()", name),
+			Stack:   callerStack(),
+		})
+	}
+}
+
+func (el *Validator) paint(name string) {
+	el.mutex.Lock()
+	begun := el.pathBegun
+	commands := el.pathCommands
+	el.pathPainted = commands > 0
+	el.mutex.Unlock()
+
+	switch {
+	case !begun:
+		el.report(Violation{
+			Kind:    KViolationPaintWithoutBeginPath,
+			Message: fmt.Sprintf("%v called before any BeginPath()", name),
+			Stack:   callerStack(),
+		})
+	case commands == 0:
+		el.report(Violation{
+			Kind:    KViolationEmptyPath,
+			Message: fmt.Sprintf("%v called on a path without commands", name),
+			Stack:   callerStack(),
+		})
+	}
+}
+
+func (el *Validator) checkStyle(name string, value interface{}) {
+	el.mutex.Lock()
+	info := el.findGradient(value)
+	el.mutex.Unlock()
+
+	if info != nil && info.colorStops == 0 {
+		el.report(Violation{
+			Kind:    KViolationGradientWithoutColorStop,
+			Message: fmt.Sprintf("%v received a gradient without color stops", name),
+			Stack:   callerStack(),
+		})
+	}
+}
+
+// addGradient
+// en: Records the gradient, replacing the oldest one when KMaxGradients are
+// already tracked. Must be called with the mutex locked
+//
+// pt_br: Registra o gradiente, substituindo o mais antigo quando KMaxGradients já
+// são monitorados. Deve ser chamada com a trava fechada
+func (el *Validator) addGradient(gradient interface{}) {
+	if gradient == nil {
+		return
+	}
+	info := &gradientInfo{gradient: gradient}
+	if len(el.gradients) < KMaxGradients {
+		el.gradients = append(el.gradients, info)
+	} else {
+		oldest := el.gradients[el.gradientNext]
+		if reflect.TypeOf(oldest.gradient).Comparable() && el.gradientIndex[oldest.gradient] == oldest {
+			delete(el.gradientIndex, oldest.gradient)
+		}
+		el.gradients[el.gradientNext] = info
+		el.gradientNext = (el.gradientNext + 1) % KMaxGradients
+	}
+	if reflect.TypeOf(gradient).Comparable() {
+		if el.gradientIndex == nil {
+			el.gradientIndex = make(map[interface{}]*gradientInfo)
+		}
+		el.gradientIndex[gradient] = info
+	}
+}
+
+// findGradient
+// en: Looks for the gradient among the gradients created by this context. Comparable
+// handles are found in the index; reflect.DeepEqual() is used when the handle,
+// like js.Value, is not comparable with ==
+//
+// pt_br: Procura o gradiente entre os gradientes criados por este contexto.
+// Handles comparáveis são encontrados no índice; reflect.DeepEqual() é usado
+// quando o handle, como js.Value, não é comparável com ==
+func (el *Validator) findGradient(gradient interface{}) *gradientInfo {
+	if gradient == nil {
+		return nil
+	}
+	if reflect.TypeOf(gradient).Comparable() {
+		return el.gradientIndex[gradient]
+	}
+	for _, info := range el.gradients {
+		if reflect.TypeOf(info.gradient) == reflect.TypeOf(gradient) && reflect.DeepEqual(info.gradient, gradient) {
+			return info
+		}
+	}
+	return nil
+}
+
+func (el *Validator) report(violation Violation) {
+	el.mutex.Lock()
+	el.violations = append(el.violations, violation)
+	onViolation := el.onViolation
+	el.mutex.Unlock()
+
+	if onViolation != nil {
+		onViolation(violation)
+	}
+}
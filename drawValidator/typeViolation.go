@@ -0,0 +1,175 @@
+package drawValidator
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// ViolationKind
+// en: Kind of misuse detected by the Validator
+//
+// pt_br: Tipo de uso incorreto detectado pelo Validator
+type ViolationKind int
+
+const (
+	// KViolationRestoreWithoutSave
+	// en: Restore() was called with an empty state stack
+	//
+	// pt_br: Restore() foi chamado com a pilha de estados vazia
+	KViolationRestoreWithoutSave ViolationKind = iota + 1
+
+	// KViolationSaveWithoutRestore
+	// en: Save() was called without the matching Restore() before Check()
+	//
+	// pt_br: Save() foi chamado sem o Restore() correspondente antes de Check()
+	KViolationSaveWithoutRestore
+
+	// KViolationPaintWithoutBeginPath
+	// en: Fill() or Stroke() was called before any BeginPath()
+	//
+	// pt_br: Fill() ou Stroke() foi chamado antes de qualquer BeginPath()
+	KViolationPaintWithoutBeginPath
+
+	// KViolationPathReused
+	// en: A path command was called after Fill() or Stroke() without a new
+	// BeginPath(), so the old shape is painted again with the new one. Only
+	// reported after Validator.SetCheckPathReuse(true), since extending a path after
+	// painting it is also valid canvas code
+	//
+	// pt_br: Um comando de caminho foi chamado após Fill() ou Stroke() sem um novo
+	// BeginPath(), então a forma antiga é pintada novamente junto com a nova.
+	// Relatado apenas após Validator.SetCheckPathReuse(true), pois estender um
+	// caminho após pintá-lo também é código de canvas válido
+	KViolationPathReused
+
+	// KViolationEmptyPath
+	// en: Fill() or Stroke() was called on a path without any command
+	//
+	// pt_br: Fill() ou Stroke() foi chamado em um caminho sem nenhum comando
+	KViolationEmptyPath
+
+	// KViolationUnknownGradient
+	// en: AddColorStopPosition() received a gradient not created by this context
+	//
+	// pt_br: AddColorStopPosition() recebeu um gradiente não criado por este contexto
+	KViolationUnknownGradient
+
+	// KViolationGradientWithoutColorStop
+	// en: A gradient without color stops was used as fill or stroke style, so the
+	// drawing is invisible
+	//
+	// pt_br: Um gradiente sem paradas de cor foi usado como estilo de preenchimento
+	// ou contorno, então o desenho fica invisível
+	KViolationGradientWithoutColorStop
+)
+
+// String
+// en: Returns the name of the violation kind
+//
+// pt_br: Retorna o nome do tipo de violação
+func (el ViolationKind) String() string {
+	switch el {
+	case KViolationRestoreWithoutSave:
+		return "restore without save"
+	case KViolationSaveWithoutRestore:
+		return "save without restore"
+	case KViolationPaintWithoutBeginPath:
+		return "paint without begin path"
+	case KViolationPathReused:
+		return "path reused after paint"
+	case KViolationEmptyPath:
+		return "paint of empty path"
+	case KViolationUnknownGradient:
+		return "unknown gradient"
+	case KViolationGradientWithoutColorStop:
+		return "gradient without color stop"
+	}
+	return fmt.Sprintf("violation(%d)", int(el))
+}
+
+// Violation
+// en: Misuse of IDraw detected by the Validator
+//
+//	Kind: Kind of misuse
+//	Message: Human readable description
+//	Stack: Call stack of the call that caused the violation, starting at the code
+//	       that called the IDraw method
+//
+// pt_br: Uso incorreto de IDraw detectado pelo Validator
+//
+//	Kind: Tipo de uso incorreto
+//	Message: Descrição legível
+//	Stack: Pilha de chamadas da chamada que causou a violação, começando no
+//	       código que chamou o método de IDraw
+type Violation struct {
+	Kind    ViolationKind
+	Message string
+	Stack   []runtime.Frame
+}
+
+// Error
+// en: Returns the violation and the first frame of the stack, so Violation can be
+// used as error
+//
+// pt_br: Retorna a violação e o primeiro quadro da pilha, para que Violation possa
+// ser usada como error
+func (el Violation) Error() string {
+	if len(el.Stack) == 0 {
+		return fmt.Sprintf("%v: %v", el.Kind, el.Message)
+	}
+	return fmt.Sprintf("%v: %v (%v:%v)", el.Kind, el.Message, el.Stack[0].File, el.Stack[0].Line)
+}
+
+// String
+// en: Returns the violation followed by the complete call stack
+//
+// pt_br: Retorna a violação seguida da pilha de chamadas completa
+func (el Violation) String() string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%v: %v\n", el.Kind, el.Message))
+	for _, frame := range el.Stack {
+		builder.WriteString(fmt.Sprintf("\t%v\n\t\t%v:%v\n", frame.Function, frame.File, frame.Line))
+	}
+	return builder.String()
+}
+
+// Violations
+// en: List of violations returned as error by Validator.Check()
+//
+// pt_br: Lista de violações retornada como erro por Validator.Check()
+type Violations []Violation
+
+// Error
+// en: Returns one violation per line
+//
+// pt_br: Retorna uma violação por linha
+func (el Violations) Error() string {
+	list := make([]string, 0, len(el))
+	for _, violation := range el {
+		list = append(list, violation.Error())
+	}
+	return strings.Join(list, "\n")
+}
+
+// callerStack
+// en: Returns the call stack without the frames of this package and of the runtime
+//
+// pt_br: Retorna a pilha de chamadas sem os quadros deste pacote e do runtime
+func callerStack() []runtime.Frame {
+	programCounters := make([]uintptr, 32)
+	length := runtime.Callers(2, programCounters)
+	frames := runtime.CallersFrames(programCounters[:length])
+
+	stack := make([]runtime.Frame, 0, length)
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/drawValidator.") && !strings.HasPrefix(frame.Function, "runtime.") {
+			stack = append(stack, frame)
+		}
+		if !more {
+			break
+		}
+	}
+	return stack
+}
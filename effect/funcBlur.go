@@ -0,0 +1,249 @@
+package effect
+
+import (
+	"image"
+	"math"
+)
+
+// ShadowBlurToSigma
+// en: Converts the value of IDraw.SetShadowBlur() to the standard deviation of the
+// Gaussian blur used by web browsers. The HTML specification defines the standard
+// deviation as half of shadowBlur
+//
+// pt_br: Converte o valor de IDraw.SetShadowBlur() para o desvio padrão do borrão
+// Gaussiano usado pelos navegadores web. A especificação HTML define o desvio
+// padrão como metade de shadowBlur
+func ShadowBlurToSigma(shadowBlur float64) float64 {
+	return shadowBlur / 2
+}
+
+// GaussianBlur
+// en: Returns a copy of the image blurred by a separable Gaussian kernel. Pixels
+// outside the image are transparent, so use Pad() to keep the blur of drawings
+// that touch the border
+//
+//	sigma: Standard deviation of the kernel, in pixels. Use
+//	       ShadowBlurToSigma() to match the shadowBlur of web browsers
+//
+// pt_br: Retorna uma cópia da imagem borrada por um kernel Gaussiano separável.
+// Pixels fora da imagem são transparentes, então use Pad() para manter o borrão de
+// desenhos que tocam a borda
+//
+//	sigma: Desvio padrão do kernel em pixels. Use ShadowBlurToSigma() para
+//	       reproduzir o shadowBlur dos navegadores web
+func GaussianBlur(img *image.RGBA, sigma float64) *image.RGBA {
+	img = normalize(img)
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	plane := toPlane(img)
+	plane = gaussianPlane(plane, width, height, 4, sigma)
+	return fromPlane(plane, width, height)
+}
+
+// BoxBlur
+// en: Returns a copy of the image blurred by a separable box kernel, the average of
+// the (2 * radius + 1)² pixels around each pixel. The cost does not depend on the
+// radius
+//
+//	radius: Radius of the box, in pixels
+//
+// pt_br: Retorna uma cópia da imagem borrada por um kernel de caixa separável, a
+// média dos (2 * radius + 1)² pixels em volta de cada pixel. O custo não depende
+// do raio
+//
+//	radius: Raio da caixa em pixels
+func BoxBlur(img *image.RGBA, radius int) *image.RGBA {
+	img = normalize(img)
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	plane := toPlane(img)
+	plane = boxPlane(plane, width, height, 4, radius)
+	return fromPlane(plane, width, height)
+}
+
+// gaussianKernel
+// en: Returns the normalized weights of a Gaussian kernel with radius 3 * sigma
+//
+// pt_br: Retorna os pesos normalizados de um kernel Gaussiano com raio 3 * sigma
+func gaussianKernel(sigma float64) []float32 {
+	radius := int(math.Ceil(sigma * 3))
+	kernel := make([]float32, radius*2+1)
+	var sum float64
+	for i := -radius; i <= radius; i += 1 {
+		weight := math.Exp(-float64(i*i) / (2 * sigma * sigma))
+		kernel[i+radius] = float32(weight)
+		sum += weight
+	}
+	for i := range kernel {
+		kernel[i] = float32(float64(kernel[i]) / sum)
+	}
+	return kernel
+}
+
+// gaussianPlane
+// en: Blurs a plane of float values with interleaved channels
+//
+// pt_br: Borra um plano de valores float com canais intercalados
+func gaussianPlane(plane []float32, width, height, channels int, sigma float64) []float32 {
+	if sigma <= 0 || width == 0 || height == 0 {
+		return plane
+	}
+	kernel := gaussianKernel(sigma)
+	plane = convolveLine(plane, width, height, channels, kernel, true)
+	return convolveLine(plane, width, height, channels, kernel, false)
+}
+
+// convolveLine
+// en: Convolves every row (horizontal = true) or every column with a one
+// dimensional kernel. Values outside the plane are zero
+//
+// pt_br: Faz a convolução de cada linha (horizontal = true) ou de cada coluna com
+// um kernel de uma dimensão. Valores fora do plano são zero
+func convolveLine(plane []float32, width, height, channels int, kernel []float32, horizontal bool) []float32 {
+	result := make([]float32, len(plane))
+	radius := len(kernel) / 2
+
+	lines, length := height, width
+	if !horizontal {
+		lines, length = width, height
+	}
+	index := func(line, position int) int {
+		if horizontal {
+			return (line*width + position) * channels
+		}
+		return (position*width + line) * channels
+	}
+
+	for line := 0; line < lines; line += 1 {
+		for position := 0; position < length; position += 1 {
+			start := maxInt(0, position-radius)
+			end := minInt(length-1, position+radius)
+			target := index(line, position)
+			for sample := start; sample <= end; sample += 1 {
+				weight := kernel[sample-position+radius]
+				source := index(line, sample)
+				for channel := 0; channel < channels; channel += 1 {
+					result[target+channel] += plane[source+channel] * weight
+				}
+			}
+		}
+	}
+	return result
+}
+
+// boxPlane
+// en: Blurs a plane of float values with interleaved channels using running sums
+//
+// pt_br: Borra um plano de valores float com canais intercalados usando somas
+// acumuladas
+func boxPlane(plane []float32, width, height, channels, radius int) []float32 {
+	if radius <= 0 || width == 0 || height == 0 {
+		return plane
+	}
+	plane = boxLine(plane, width, height, channels, radius, true)
+	return boxLine(plane, width, height, channels, radius, false)
+}
+
+func boxLine(plane []float32, width, height, channels, radius int, horizontal bool) []float32 {
+	result := make([]float32, len(plane))
+	size := float32(radius*2 + 1)
+
+	lines, length := height, width
+	if !horizontal {
+		lines, length = width, height
+	}
+	index := func(line, position int) int {
+		if horizontal {
+			return (line*width + position) * channels
+		}
+		return (position*width + line) * channels
+	}
+
+	sum := make([]float32, channels)
+	for line := 0; line < lines; line += 1 {
+		for channel := range sum {
+			sum[channel] = 0
+		}
+		for position := 0; position <= minInt(radius, length-1); position += 1 {
+			source := index(line, position)
+			for channel := 0; channel < channels; channel += 1 {
+				sum[channel] += plane[source+channel]
+			}
+		}
+		for position := 0; position < length; position += 1 {
+			target := index(line, position)
+			for channel := 0; channel < channels; channel += 1 {
+				result[target+channel] = sum[channel] / size
+			}
+			if leaving := position - radius; leaving >= 0 {
+				source := index(line, leaving)
+				for channel := 0; channel < channels; channel += 1 {
+					sum[channel] -= plane[source+channel]
+				}
+			}
+			if entering := position + radius + 1; entering < length {
+				source := index(line, entering)
+				for channel := 0; channel < channels; channel += 1 {
+					sum[channel] += plane[source+channel]
+				}
+			}
+		}
+	}
+	return result
+}
+
+// toPlane
+// en: Converts the premultiplied pixels into float values from 0 to 255
+//
+// pt_br: Converte os pixels pré-multiplicados em valores float de 0 a 255
+func toPlane(img *image.RGBA) []float32 {
+	plane := make([]float32, len(img.Pix))
+	for i, value := range img.Pix {
+		plane[i] = float32(value)
+	}
+	return plane
+}
+
+// fromPlane
+// en: Converts float values back to premultiplied pixels, keeping each color
+// channel not greater than the alpha channel
+//
+// pt_br: Converte valores float de volta para pixels pré-multiplicados, mantendo
+// cada canal de cor não maior do que o canal alpha
+func fromPlane(plane []float32, width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < len(plane); i += 4 {
+		alpha := clampByte(plane[i+3])
+		img.Pix[i+3] = alpha
+		for channel := 0; channel < 3; channel += 1 {
+			value := clampByte(plane[i+channel])
+			if value > alpha {
+				value = alpha
+			}
+			img.Pix[i+channel] = value
+		}
+	}
+	return img
+}
+
+func clampByte(value float32) uint8 {
+	if value <= 0 {
+		return 0
+	}
+	if value >= 255 {
+		return 255
+	}
+	return uint8(value + 0.5)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,59 @@
+package effect
+
+import (
+	"image"
+	"math"
+)
+
+// Emboss
+// en: Returns a copy of the image with a relief effect, lighting the edges that
+// face the light and darkening the opposite edges. The alpha channel is kept
+//
+//	angle: Direction of the light, in degrees; 0 lights from the left and 90 from
+//	       the top
+//	depth: Strength of the relief; 1 is a soft relief and 4 a strong one
+//
+// pt_br: Retorna uma cópia da imagem com um efeito de relevo, iluminando as bordas
+// voltadas para a luz e escurecendo as bordas opostas. O canal alpha é mantido
+//
+//	angle: Direção da luz em graus; 0 ilumina pela esquerda e 90 por cima
+//	depth: Intensidade do relevo; 1 é um relevo suave e 4 um relevo forte
+func Emboss(img *image.RGBA, angle, depth float64) *image.RGBA {
+	img = normalize(img)
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	result := newLike(img)
+
+	radians := angle * math.Pi / 180
+	stepX := int(math.Round(math.Cos(radians)))
+	stepY := int(math.Round(math.Sin(radians)))
+
+	// en: premultiplied luminance, so transparent neighbours count as dark
+	// pt_br: luminância pré-multiplicada, para que vizinhos transparentes contem como
+	// escuros
+	luminance := func(x, y int) float64 {
+		x = minInt(maxInt(x, 0), width-1)
+		y = minInt(maxInt(y, 0), height-1)
+		offset := img.PixOffset(x, y)
+		return 0.2126*float64(img.Pix[offset]) + 0.7152*float64(img.Pix[offset+1]) + 0.0722*float64(img.Pix[offset+2])
+	}
+
+	for y := 0; y < height; y += 1 {
+		for x := 0; x < width; x += 1 {
+			offset := img.PixOffset(x, y)
+			alpha := img.Pix[offset+3]
+			result.Pix[offset+3] = alpha
+			if alpha == 0 {
+				continue
+			}
+			difference := luminance(x+stepX, y+stepY) - luminance(x-stepX, y-stepY)
+			for channel := 0; channel < 3; channel += 1 {
+				value := float32(float64(img.Pix[offset+channel]) + depth*difference/2)
+				if value > float32(alpha) {
+					value = float32(alpha)
+				}
+				result.Pix[offset+channel] = clampByte(value)
+			}
+		}
+	}
+	return result
+}
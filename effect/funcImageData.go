@@ -0,0 +1,151 @@
+package effect
+
+import (
+	"image"
+	"image/color"
+)
+
+// FromImageData
+// en: Converts image data in the format of IDraw.GetImageData() into an
+// *image.RGBA with premultiplied alpha, as used by every function of this package
+//
+//	data: map[x][y]color.RGBA with the values of the canvas (not premultiplied)
+//	x, y: Coordinate of the upper-left pixel of data
+//	width, height: Size of the area
+//
+// pt_br: Converte dados de imagem no formato de IDraw.GetImageData() em um
+// *image.RGBA com alpha pré-multiplicado, como usado por todas as funções deste
+// pacote
+//
+//	data: map[x][y]color.RGBA com os valores do canvas (não pré-multiplicados)
+//	x, y: Coordenada do pixel superior esquerdo de data
+//	width, height: Tamanho da área
+func FromImageData(data map[int]map[int]color.RGBA, x, y, width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for pixelX := 0; pixelX < width; pixelX += 1 {
+		column := data[x+pixelX]
+		for pixelY := 0; pixelY < height; pixelY += 1 {
+			pixel := column[y+pixelY]
+			offset := img.PixOffset(pixelX, pixelY)
+			img.Pix[offset+0] = premultiply(pixel.R, pixel.A)
+			img.Pix[offset+1] = premultiply(pixel.G, pixel.A)
+			img.Pix[offset+2] = premultiply(pixel.B, pixel.A)
+			img.Pix[offset+3] = pixel.A
+		}
+	}
+	return img
+}
+
+// ToImageData
+// en: Converts an *image.RGBA into image data in the format of IDraw.GetImageData(),
+// with the alpha not premultiplied
+//
+//	x, y: Coordinate given to the upper-left pixel of the image in the map
+//
+// pt_br: Converte um *image.RGBA em dados de imagem no formato de
+// IDraw.GetImageData(), com alpha não pré-multiplicado
+//
+//	x, y: Coordenada dada ao pixel superior esquerdo da imagem no mapa
+func ToImageData(img *image.RGBA, x, y int) map[int]map[int]color.RGBA {
+	bounds := img.Bounds()
+	data := make(map[int]map[int]color.RGBA, bounds.Dx())
+	for pixelX := bounds.Min.X; pixelX < bounds.Max.X; pixelX += 1 {
+		column := make(map[int]color.RGBA, bounds.Dy())
+		for pixelY := bounds.Min.Y; pixelY < bounds.Max.Y; pixelY += 1 {
+			offset := img.PixOffset(pixelX, pixelY)
+			alpha := img.Pix[offset+3]
+			column[y+pixelY-bounds.Min.Y] = color.RGBA{
+				R: unpremultiply(img.Pix[offset+0], alpha),
+				G: unpremultiply(img.Pix[offset+1], alpha),
+				B: unpremultiply(img.Pix[offset+2], alpha),
+				A: alpha,
+			}
+		}
+		data[x+pixelX-bounds.Min.X] = column
+	}
+	return data
+}
+
+// ApplyToImageData
+// en: Runs an effect over image data in the format of IDraw.GetImageData() and
+// writes the result back into the same map. Use it to plug the effects of this
+// package into filterChain.NewSoftwareFilter()
+//
+//	Example:
+//	filterChain.NewSoftwareFilter("blur", func(data map[int]map[int]color.RGBA, width, height int) {
+//	  effect.ApplyToImageData(data, width, height, func(img *image.RGBA) *image.RGBA {
+//	    return effect.GaussianBlur(img, 4)
+//	  })
+//	})
+//
+// pt_br: Executa um efeito sobre dados de imagem no formato de IDraw.GetImageData()
+// e escreve o resultado de volta no mesmo mapa. Use para conectar os efeitos deste
+// pacote a filterChain.NewSoftwareFilter()
+func ApplyToImageData(data map[int]map[int]color.RGBA, width, height int, effect func(img *image.RGBA) *image.RGBA) {
+	result := ToImageData(effect(FromImageData(data, 0, 0, width, height)), 0, 0)
+	for x := 0; x < width; x += 1 {
+		if data[x] == nil {
+			data[x] = make(map[int]color.RGBA, height)
+		}
+		for y := 0; y < height; y += 1 {
+			data[x][y] = result[x][y]
+		}
+	}
+}
+
+// Pad
+// en: Returns a copy of the image with transparent borders, so effects that grow
+// the drawing, like blur and shadows, are not cut
+//
+//	size: Width of the border, in pixels
+//
+// pt_br: Retorna uma cópia da imagem com bordas transparentes, para que efeitos que
+// aumentam o desenho, como borrão e sombras, não sejam cortados
+//
+//	size: Largura da borda em pixels
+func Pad(img *image.RGBA, size int) *image.RGBA {
+	bounds := img.Bounds()
+	padded := image.NewRGBA(image.Rect(0, 0, bounds.Dx()+size*2, bounds.Dy()+size*2))
+	for y := 0; y < bounds.Dy(); y += 1 {
+		source := img.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+		destination := padded.PixOffset(size, size+y)
+		copy(padded.Pix[destination:destination+bounds.Dx()*4], img.Pix[source:source+bounds.Dx()*4])
+	}
+	return padded
+}
+
+func premultiply(value, alpha uint8) uint8 {
+	return uint8((uint32(value)*uint32(alpha) + 127) / 255)
+}
+
+func unpremultiply(value, alpha uint8) uint8 {
+	if alpha == 0 {
+		return 0
+	}
+	result := (uint32(value)*255 + uint32(alpha)/2) / uint32(alpha)
+	if result > 255 {
+		return 255
+	}
+	return uint8(result)
+}
+
+// newLike
+// en: Returns a new transparent image with the bounds of img, starting at (0, 0)
+//
+// pt_br: Retorna uma nova imagem transparente com os limites de img, começando em
+// (0, 0)
+func newLike(img *image.RGBA) *image.RGBA {
+	return image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+}
+
+// normalize
+// en: Returns the image with bounds starting at (0, 0), copying it only if needed
+//
+// pt_br: Retorna a imagem com limites começando em (0, 0), copiando apenas se
+// necessário
+func normalize(img *image.RGBA) *image.RGBA {
+	if img.Bounds().Min == (image.Point{}) && img.Stride == img.Bounds().Dx()*4 {
+		return img
+	}
+	return Pad(img, 0)
+}
@@ -0,0 +1,206 @@
+package effect
+
+import (
+	"image"
+	"image/color"
+)
+
+// DropShadow
+// en: Returns a copy of the image with a shadow drawn behind it, with the same
+// result of drawing the image with IDraw.SetShadowBlur(), SetShadowColor(),
+// ShadowOffsetX() and ShadowOffsetY() in a web browser. Use Pad() first when the
+// shadow must not be cut by the border of the image
+//
+// pt_br: Retorna uma cópia da imagem com uma sombra desenhada atrás dela, com o
+// mesmo resultado de desenhar a imagem com IDraw.SetShadowBlur(),
+// SetShadowColor(), ShadowOffsetX() e ShadowOffsetY() em um navegador web. Use
+// Pad() antes quando a sombra não puder ser cortada pela borda da imagem
+func DropShadow(img *image.RGBA, options ShadowOptions) *image.RGBA {
+	img = normalize(img)
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+
+	mask := alphaMask(img, false)
+	mask = spreadMask(mask, width, height, options.Spread)
+	mask = shiftMask(mask, width, height, options.OffsetX, options.OffsetY)
+	mask = gaussianPlane(mask, width, height, 1, ShadowBlurToSigma(options.Blur))
+
+	return sourceOver(img, colorize(mask, width, height, options.Color))
+}
+
+// OuterGlow
+// en: Returns a copy of the image with a blurred halo around the shape
+//
+// pt_br: Retorna uma cópia da imagem com um halo borrado em volta da forma
+func OuterGlow(img *image.RGBA, options GlowOptions) *image.RGBA {
+	return DropShadow(img, ShadowOptions{Blur: options.Blur, Spread: options.Spread, Color: options.Color})
+}
+
+// InnerShadow
+// en: Returns a copy of the image with a shadow drawn inside the shape, as if the
+// shape were a hole lit from the direction opposite to the offset
+//
+// pt_br: Retorna uma cópia da imagem com uma sombra desenhada dentro da forma, como
+// se a forma fosse um buraco iluminado na direção oposta ao deslocamento
+func InnerShadow(img *image.RGBA, options ShadowOptions) *image.RGBA {
+	img = normalize(img)
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+
+	// en: the shadow is cast by everything outside the shape, including the area
+	// outside the image
+	// pt_br: a sombra é projetada por tudo que está fora da forma, incluindo a área
+	// fora da imagem
+	margin := int(options.Blur) + absInt(options.OffsetX) + absInt(options.OffsetY) + absInt(options.Spread) + 1
+	padded := Pad(img, margin)
+	paddedWidth, paddedHeight := padded.Bounds().Dx(), padded.Bounds().Dy()
+
+	mask := alphaMask(padded, true)
+	mask = spreadMask(mask, paddedWidth, paddedHeight, options.Spread)
+	mask = shiftMask(mask, paddedWidth, paddedHeight, options.OffsetX, options.OffsetY)
+	mask = gaussianPlane(mask, paddedWidth, paddedHeight, 1, ShadowBlurToSigma(options.Blur))
+
+	clipped := make([]float32, width*height)
+	for y := 0; y < height; y += 1 {
+		for x := 0; x < width; x += 1 {
+			alpha := float32(img.Pix[img.PixOffset(x, y)+3]) / 255
+			clipped[y*width+x] = mask[(y+margin)*paddedWidth+x+margin] * alpha
+		}
+	}
+
+	return sourceOver(colorize(clipped, width, height, options.Color), img)
+}
+
+// alphaMask
+// en: Returns the alpha channel as values from 0 to 1, inverted if requested
+//
+// pt_br: Retorna o canal alpha como valores de 0 a 1, invertido se pedido
+func alphaMask(img *image.RGBA, inverted bool) []float32 {
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	mask := make([]float32, width*height)
+	for i := range mask {
+		value := float32(img.Pix[i*4+3]) / 255
+		if inverted {
+			value = 1 - value
+		}
+		mask[i] = value
+	}
+	return mask
+}
+
+// spreadMask
+// en: Grows (dilation) or shrinks (erosion) the mask by a square of the given
+// radius
+//
+// pt_br: Aumenta (dilatação) ou encolhe (erosão) a máscara por um quadrado com o
+// raio informado
+func spreadMask(mask []float32, width, height, spread int) []float32 {
+	if spread == 0 {
+		return mask
+	}
+	grow := spread > 0
+	radius := absInt(spread)
+	pick := func(a, b float32) float32 {
+		if (grow && b > a) || (!grow && b < a) {
+			return b
+		}
+		return a
+	}
+	outside := float32(0)
+
+	horizontal := make([]float32, len(mask))
+	for y := 0; y < height; y += 1 {
+		for x := 0; x < width; x += 1 {
+			value := mask[y*width+x]
+			for sample := x - radius; sample <= x+radius; sample += 1 {
+				if sample < 0 || sample >= width {
+					value = pick(value, outside)
+					continue
+				}
+				value = pick(value, mask[y*width+sample])
+			}
+			horizontal[y*width+x] = value
+		}
+	}
+
+	result := make([]float32, len(mask))
+	for y := 0; y < height; y += 1 {
+		for x := 0; x < width; x += 1 {
+			value := horizontal[y*width+x]
+			for sample := y - radius; sample <= y+radius; sample += 1 {
+				if sample < 0 || sample >= height {
+					value = pick(value, outside)
+					continue
+				}
+				value = pick(value, horizontal[sample*width+x])
+			}
+			result[y*width+x] = value
+		}
+	}
+	return result
+}
+
+// shiftMask
+// en: Moves the mask by (offsetX, offsetY); uncovered values are zero
+//
+// pt_br: Move a máscara por (offsetX, offsetY); valores descobertos são zero
+func shiftMask(mask []float32, width, height, offsetX, offsetY int) []float32 {
+	if offsetX == 0 && offsetY == 0 {
+		return mask
+	}
+	result := make([]float32, len(mask))
+	for y := 0; y < height; y += 1 {
+		sourceY := y - offsetY
+		if sourceY < 0 || sourceY >= height {
+			continue
+		}
+		for x := 0; x < width; x += 1 {
+			sourceX := x - offsetX
+			if sourceX < 0 || sourceX >= width {
+				continue
+			}
+			result[y*width+x] = mask[sourceY*width+sourceX]
+		}
+	}
+	return result
+}
+
+// colorize
+// en: Returns a premultiplied image painted with the color, using the mask as
+// coverage
+//
+// pt_br: Retorna uma imagem pré-multiplicada pintada com a cor, usando a máscara
+// como cobertura
+func colorize(mask []float32, width, height int, paint color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i, coverage := range mask {
+		alpha := coverage * float32(paint.A) / 255
+		img.Pix[i*4+0] = clampByte(float32(paint.R) * alpha)
+		img.Pix[i*4+1] = clampByte(float32(paint.G) * alpha)
+		img.Pix[i*4+2] = clampByte(float32(paint.B) * alpha)
+		img.Pix[i*4+3] = clampByte(255 * alpha)
+	}
+	return img
+}
+
+// sourceOver
+// en: Returns source drawn over destination, both premultiplied and of the same
+// size
+//
+// pt_br: Retorna source desenhado sobre destination, ambos pré-multiplicados e do
+// mesmo tamanho
+func sourceOver(source, destination *image.RGBA) *image.RGBA {
+	result := newLike(source)
+	for i := 0; i < len(result.Pix); i += 4 {
+		inverse := 255 - uint32(source.Pix[i+3])
+		for channel := 0; channel < 4; channel += 1 {
+			result.Pix[i+channel] = uint8(uint32(source.Pix[i+channel]) + (uint32(destination.Pix[i+channel])*inverse+127)/255)
+		}
+	}
+	return result
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
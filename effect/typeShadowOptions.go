@@ -0,0 +1,51 @@
+package effect
+
+import "image/color"
+
+// ShadowOptions
+// en: Parameters of DropShadow() and InnerShadow()
+//
+//	OffsetX: Horizontal distance of the shadow, in pixels, as in
+//	         IDraw.ShadowOffsetX()
+//	OffsetY: Vertical distance of the shadow, in pixels, as in
+//	         IDraw.ShadowOffsetY()
+//	Blur: Blur level, as in IDraw.SetShadowBlur()
+//	Spread: Pixels added around the shape before the blur. Negative values
+//	        shrink the shadow
+//	Color: Color of the shadow, as in IDraw.SetShadowColor()
+//
+// pt_br: Parâmetros de DropShadow() e InnerShadow()
+//
+//	OffsetX: Distância horizontal da sombra em pixels, como em
+//	         IDraw.ShadowOffsetX()
+//	OffsetY: Distância vertical da sombra em pixels, como em
+//	         IDraw.ShadowOffsetY()
+//	Blur: Nível de borrão, como em IDraw.SetShadowBlur()
+//	Spread: Pixels adicionados em volta da forma antes do borrão. Valores
+//	        negativos encolhem a sombra
+//	Color: Cor da sombra, como em IDraw.SetShadowColor()
+type ShadowOptions struct {
+	OffsetX int
+	OffsetY int
+	Blur    float64
+	Spread  int
+	Color   color.RGBA
+}
+
+// GlowOptions
+// en: Parameters of OuterGlow()
+//
+//	Blur: Blur level, as in IDraw.SetShadowBlur()
+//	Spread: Pixels added around the shape before the blur
+//	Color: Color of the glow
+//
+// pt_br: Parâmetros de OuterGlow()
+//
+//	Blur: Nível de borrão, como em IDraw.SetShadowBlur()
+//	Spread: Pixels adicionados em volta da forma antes do borrão
+//	Color: Cor do brilho
+type GlowOptions struct {
+	Blur   float64
+	Spread int
+	Color  color.RGBA
+}
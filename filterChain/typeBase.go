@@ -0,0 +1,98 @@
+package filterChain
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Base
+// en: Implements the name, enabled and parameter methods of IFilter. Embed Base in
+// a new filter and implement PrepareFilter() and, optionally, ApplyToImageData()
+//
+// pt_br: Implementa os métodos de nome, habilitação e parâmetros de IFilter.
+// Incorpore Base em um novo filtro e implemente PrepareFilter() e, opcionalmente,
+// ApplyToImageData()
+type Base struct {
+	name       string
+	disabled   bool
+	parameters map[string]interface{}
+	mutex      sync.RWMutex
+}
+
+// NewBase
+// en: Returns a new enabled Base
+//
+//	name: Name of the filter
+//	parameters: Name and default value of each parameter accepted by the filter
+//
+// pt_br: Retorna uma nova Base habilitada
+//
+//	name: Nome do filtro
+//	parameters: Nome e valor padrão de cada parâmetro aceito pelo filtro
+func NewBase(name string, parameters map[string]interface{}) *Base {
+	list := make(map[string]interface{}, len(parameters))
+	for key, value := range parameters {
+		list[key] = value
+	}
+	return &Base{name: name, parameters: list}
+}
+
+// GetName
+// en: Returns the name of the filter
+//
+// pt_br: Retorna o nome do filtro
+func (el *Base) GetName() string {
+	return el.name
+}
+
+// SetEnabled
+// en: Enables or disables the filter
+//
+// pt_br: Habilita ou desabilita o filtro
+func (el *Base) SetEnabled(enabled bool) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	el.disabled = !enabled
+}
+
+// GetEnabled
+// en: Returns true if the filter is enabled
+//
+// pt_br: Retorna true se o filtro estiver habilitado
+func (el *Base) GetEnabled() bool {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	return !el.disabled
+}
+
+// SetParameter
+// en: Sets a parameter declared by NewBase(). The new value must have the same type
+// of the default value
+//
+// pt_br: Define um parâmetro declarado por NewBase(). O novo valor deve ter o mesmo
+// tipo do valor padrão
+func (el *Base) SetParameter(name string, value interface{}) (err error) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+
+	current, found := el.parameters[name]
+	if !found {
+		return fmt.Errorf("filter %v: unknown parameter %v", el.name, name)
+	}
+	if current != nil && fmt.Sprintf("%T", current) != fmt.Sprintf("%T", value) {
+		return fmt.Errorf("filter %v: parameter %v must be %T, got %T", el.name, name, current, value)
+	}
+	el.parameters[name] = value
+	return
+}
+
+// GetParameter
+// en: Returns the value of a parameter and false for unknown names
+//
+// pt_br: Retorna o valor de um parâmetro e false para nomes desconhecidos
+func (el *Base) GetParameter(name string) (value interface{}, found bool) {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	value, found = el.parameters[name]
+	return
+}
@@ -0,0 +1,90 @@
+package filterChain
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/cssFilter"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/effect"
+)
+
+// KParameterFilter
+// en: Name of the parameter of CSSFilter with the cssFilter.List
+//
+// pt_br: Nome do parâmetro de CSSFilter com a cssFilter.List
+const KParameterFilter = "filter"
+
+// CSSFilter
+// en: Plugs a CSS filter list into a Chain. Natively it calls IDraw.SetFilter(),
+// in software it calls cssFilter.List.Apply()
+//
+//	Example: dim a disabled widget
+//	chain.Add(filterChain.NewCSSFilter("disabled", cssFilter.List{cssFilter.Grayscale{Amount: 1}, cssFilter.Opacity{Amount: 0.5}}))
+//
+// pt_br: Conecta uma lista de filtros CSS a uma Chain. Nativamente chama
+// IDraw.SetFilter(), em software chama cssFilter.List.Apply()
+//
+//	Exemplo: escurecer um widget desabilitado
+//	chain.Add(filterChain.NewCSSFilter("disabled", cssFilter.List{cssFilter.Grayscale{Amount: 1}, cssFilter.Opacity{Amount: 0.5}}))
+type CSSFilter struct {
+	*Base
+}
+
+// NewCSSFilter
+// en: Returns a new chain filter with the list
+//
+//	name: Name of the filter inside the chain
+//
+// pt_br: Retorna um novo filtro de cadeia com a lista
+//
+//	name: Nome do filtro dentro da cadeia
+func NewCSSFilter(name string, list cssFilter.List) *CSSFilter {
+	return &CSSFilter{
+		Base: NewBase(name, map[string]interface{}{KParameterFilter: list}),
+	}
+}
+
+// SetParameter
+// en: Sets KParameterFilter as a cssFilter.List or as a CSS filter string
+//
+// pt_br: Define KParameterFilter como uma cssFilter.List ou como uma string de
+// filtro CSS
+func (el *CSSFilter) SetParameter(name string, value interface{}) (err error) {
+	if text, ok := value.(string); ok && name == KParameterFilter {
+		value, err = cssFilter.Parse(text)
+		if err != nil {
+			return
+		}
+	}
+	if _, ok := value.(cssFilter.List); !ok && name == KParameterFilter {
+		return fmt.Errorf("filter %v: parameter %v must be cssFilter.List or string, got %T", el.GetName(), name, value)
+	}
+	return el.Base.SetParameter(name, value)
+}
+
+// PrepareFilter
+// en: Sets the filter list on the platform
+//
+// pt_br: Define a lista de filtros na plataforma
+func (el *CSSFilter) PrepareFilter(platform iotmakerPlatformIDraw.IDraw) {
+	platform.SetFilter(el.getList())
+}
+
+// ApplyToImageData
+// en: Applies the filter list in software
+//
+// pt_br: Aplica a lista de filtros em software
+func (el *CSSFilter) ApplyToImageData(data map[int]map[int]color.RGBA, width, height int) {
+	list := el.getList()
+	effect.ApplyToImageData(data, width, height, func(img *image.RGBA) *image.RGBA {
+		return list.Apply(img)
+	})
+}
+
+func (el *CSSFilter) getList() cssFilter.List {
+	value, _ := el.GetParameter(KParameterFilter)
+	list, _ := value.(cssFilter.List)
+	return list
+}
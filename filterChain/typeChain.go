@@ -0,0 +1,356 @@
+package filterChain
+
+import (
+	"fmt"
+	"sync"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/cssFilter"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/offscreenPool"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/pixelRatio"
+)
+
+// Chain
+// en: Ordered list of filters applied to the drawing of a Primitive.
+//
+// The enabled filters are grouped, in order, into stages of consecutive native
+// filters and of consecutive software filters. A native stage prepares the
+// platform and draws; the CSS filters of the stage are joined into one
+// SetFilter() call, so none of them overwrites the other. A software stage needs
+// the pixels of the drawing alone, so it works on an off-screen surface and the
+// next stage draws that surface with DrawImage(). The last stage draws on the
+// platform
+//
+// pt_br: Lista ordenada de filtros aplicados ao desenho de um Primitive.
+//
+// Os filtros habilitados são agrupados, em ordem, em estágios de filtros nativos
+// consecutivos e de filtros de software consecutivos. Um estágio nativo prepara a
+// plataforma e desenha; os filtros CSS do estágio são unidos em uma única chamada
+// de SetFilter(), assim nenhum deles sobrescreve o outro. Um estágio de software
+// precisa apenas dos pixels do desenho, então ele trabalha em uma superfície fora
+// da tela e o próximo estágio desenha essa superfície com DrawImage(). O último
+// estágio desenha na plataforma
+type Chain struct {
+	filters []iotmakerPlatformIDraw.IFilter
+	pool    *offscreenPool.Pool
+	mutex   sync.RWMutex
+}
+
+// NewChain
+// en: Returns a new chain with the filters in the order they are applied
+//
+// pt_br: Retorna uma nova cadeia com os filtros na ordem em que são aplicados
+func NewChain(filters ...iotmakerPlatformIDraw.IFilter) *Chain {
+	return &Chain{filters: append([]iotmakerPlatformIDraw.IFilter{}, filters...)}
+}
+
+// SetPool
+// en: Sets the pool of off-screen surfaces used by software filters. Without a
+// pool a new surface is created and released on each Apply()
+//
+// pt_br: Define o pool de superfícies fora da tela usado pelos filtros de software.
+// Sem um pool uma nova superfície é criada e liberada a cada Apply()
+func (el *Chain) SetPool(pool *offscreenPool.Pool) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	el.pool = pool
+}
+
+// Add
+// en: Adds filters to the end of the chain
+//
+// pt_br: Adiciona filtros ao final da cadeia
+func (el *Chain) Add(filters ...iotmakerPlatformIDraw.IFilter) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	el.filters = append(el.filters, filters...)
+}
+
+// Insert
+// en: Inserts a filter at the index; indexes out of range add the filter at the
+// start or at the end of the chain
+//
+// pt_br: Insere um filtro no índice; índices fora da faixa adicionam o filtro no
+// início ou no final da cadeia
+func (el *Chain) Insert(index int, filter iotmakerPlatformIDraw.IFilter) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	index = clampIndex(index, len(el.filters))
+	el.filters = append(el.filters, nil)
+	copy(el.filters[index+1:], el.filters[index:])
+	el.filters[index] = filter
+}
+
+// Remove
+// en: Removes the filter with the name and returns an error if it is not found
+//
+// pt_br: Remove o filtro com o nome e retorna um erro se ele não for encontrado
+func (el *Chain) Remove(name string) (err error) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	index := el.indexOf(name)
+	if index == -1 {
+		return fmt.Errorf("filter %v not found", name)
+	}
+	el.filters = append(el.filters[:index], el.filters[index+1:]...)
+	return
+}
+
+// Move
+// en: Moves the filter with the name to the index, changing the order in which it
+// is applied
+//
+// pt_br: Move o filtro com o nome para o índice, mudando a ordem em que ele é
+// aplicado
+func (el *Chain) Move(name string, index int) (err error) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	current := el.indexOf(name)
+	if current == -1 {
+		return fmt.Errorf("filter %v not found", name)
+	}
+	filter := el.filters[current]
+	el.filters = append(el.filters[:current], el.filters[current+1:]...)
+	index = clampIndex(index, len(el.filters))
+	el.filters = append(el.filters, nil)
+	copy(el.filters[index+1:], el.filters[index:])
+	el.filters[index] = filter
+	return
+}
+
+// Get
+// en: Returns the filter with the name and false if it is not found
+//
+// pt_br: Retorna o filtro com o nome e false se ele não for encontrado
+func (el *Chain) Get(name string) (filter iotmakerPlatformIDraw.IFilter, found bool) {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	index := el.indexOf(name)
+	if index == -1 {
+		return nil, false
+	}
+	return el.filters[index], true
+}
+
+// SetEnabled
+// en: Enables or disables the filter with the name
+//
+// pt_br: Habilita ou desabilita o filtro com o nome
+func (el *Chain) SetEnabled(name string, enabled bool) (err error) {
+	filter, found := el.Get(name)
+	if !found {
+		return fmt.Errorf("filter %v not found", name)
+	}
+	filter.SetEnabled(enabled)
+	return
+}
+
+// SetParameter
+// en: Sets a parameter of the filter with the name
+//
+// pt_br: Define um parâmetro do filtro com o nome
+func (el *Chain) SetParameter(name, parameter string, value interface{}) (err error) {
+	filter, found := el.Get(name)
+	if !found {
+		return fmt.Errorf("filter %v not found", name)
+	}
+	return filter.SetParameter(parameter, value)
+}
+
+// GetFilters
+// en: Returns a copy of the list of filters, in the order they are applied
+//
+// pt_br: Retorna uma cópia da lista de filtros, na ordem em que são aplicados
+func (el *Chain) GetFilters() []iotmakerPlatformIDraw.IFilter {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	return append([]iotmakerPlatformIDraw.IFilter{}, el.filters...)
+}
+
+// Apply
+// en: Draws with every enabled filter of the chain
+//
+//	platform: Destination of the drawing
+//	x, y, width, height: Area of the drawing, in logical pixels after the
+//	                     current transformation of the platform. Pixels outside
+//	                     this area are lost when a software filter is used
+//	draw: Function that draws the Primitive using the coordinates and the
+//	      current transformation of the platform
+//
+// Software filters receive the physical pixels of the area, so a radius in pixels
+// covers fewer logical pixels when the device pixel ratio is above 1
+//
+// pt_br: Desenha com todos os filtros habilitados da cadeia
+//
+//	platform: Destino do desenho
+//	x, y, width, height: Área do desenho, em pixels lógicos após a
+//	                     transformação atual da plataforma. Pixels fora desta
+//	                     área são perdidos quando um filtro de software é usado
+//	draw: Função que desenha o Primitive usando as coordenadas e a
+//	      transformação atual da plataforma
+//
+// Filtros de software recebem os pixels físicos da área, assim um raio em pixels
+// cobre menos pixels lógicos quando a razão de pixels do dispositivo é maior do
+// que 1
+func (el *Chain) Apply(platform iotmakerPlatformIDraw.IDraw, x, y, width, height int, draw func(platform iotmakerPlatformIDraw.IDraw)) {
+	el.mutex.RLock()
+	pool := el.pool
+	stages := make([]stage, 0)
+	nativeSupport, hasNativeSupport := platform.(iotmakerPlatformIDraw.IFilterNative)
+	for _, filter := range el.filters {
+		if !filter.GetEnabled() {
+			continue
+		}
+		softwareFilter, isSoftware := filter.(iotmakerPlatformIDraw.IFilterSoftware)
+		isSoftware = isSoftware && (!hasNativeSupport || !nativeSupport.SupportsNativeFilter(filter))
+		if len(stages) == 0 || stages[len(stages)-1].software != isSoftware {
+			stages = append(stages, stage{software: isSoftware})
+		}
+		current := &stages[len(stages)-1]
+		if isSoftware {
+			current.softwareFilters = append(current.softwareFilters, softwareFilter)
+		} else {
+			current.nativeFilters = append(current.nativeFilters, filter)
+		}
+	}
+	el.mutex.RUnlock()
+
+	if len(stages) == 0 {
+		stages = append(stages, stage{})
+	}
+	if (len(stages) > 1 || stages[0].software) && (width <= 0 || height <= 0) {
+		return
+	}
+
+	getSurface := func() iotmakerPlatformIDraw.IOffscreenSurface {
+		if pool != nil {
+			return pool.Get(width, height)
+		}
+		return platform.CreateOffscreen(width, height)
+	}
+	putSurface := func(surface iotmakerPlatformIDraw.IOffscreenSurface) {
+		if surface == nil {
+			return
+		} else if pool != nil {
+			pool.Put(surface)
+		} else {
+			surface.Release()
+		}
+	}
+
+	// en: previous holds the result of the last stage; nil means the drawing itself
+	// pt_br: previous guarda o resultado do último estágio; nil significa o próprio
+	// desenho
+	var previous iotmakerPlatformIDraw.IOffscreenSurface
+	base := platform.GetTransform()
+	paint := func(target iotmakerPlatformIDraw.IDraw, onPlatform bool) {
+		switch {
+		case previous == nil && onPlatform:
+			draw(target)
+		case previous == nil:
+			// en: the transformation of the caller, moved to the origin of the area
+			// pt_br: a transformação de quem chama, movida para a origem da área
+			target.SetTransform(base[0], base[1], base[2], base[3], base[4]-float64(x), base[5]-float64(y))
+			draw(target)
+		case onPlatform:
+			// en: the surface already has the transformation of the caller
+			// pt_br: a superfície já tem a transformação de quem chama
+			target.SetTransform(1, 0, 0, 1, 0, 0)
+			target.DrawImage(previous.GetImageSource(), x, y, width, height)
+		default:
+			target.DrawImage(previous.GetImageSource(), 0, 0, width, height)
+		}
+	}
+
+	for index, current := range stages {
+		last := index == len(stages)-1
+
+		if !current.software {
+			var target iotmakerPlatformIDraw.IDraw = platform
+			var surface iotmakerPlatformIDraw.IOffscreenSurface
+			if !last {
+				surface = getSurface()
+				target = surface
+			}
+			target.Save()
+			current.prepare(target)
+			paint(target, last)
+			target.Restore()
+			if !last {
+				putSurface(previous)
+				previous = surface
+			}
+			continue
+		}
+
+		if previous == nil {
+			surface := getSurface()
+			surface.Save()
+			paint(surface, false)
+			surface.Restore()
+			previous = surface
+		}
+		physicalWidth, physicalHeight := pixelRatio.New(previous.GetDevicePixelRatio()).BackingStoreSize(width, height)
+		data := previous.GetImageDataPhysical(0, 0, physicalWidth, physicalHeight)
+		for _, filter := range current.softwareFilters {
+			filter.ApplyToImageData(data, physicalWidth, physicalHeight)
+		}
+		previous.PutImageDataPhysical(data, 0, 0, physicalWidth, physicalHeight)
+		if last {
+			platform.Save()
+			paint(platform, true)
+			platform.Restore()
+		}
+	}
+
+	putSurface(previous)
+}
+
+// stage
+// en: Consecutive filters of the same kind, native or software
+//
+// pt_br: Filtros consecutivos do mesmo tipo, nativos ou de software
+type stage struct {
+	software        bool
+	nativeFilters   []iotmakerPlatformIDraw.IFilter
+	softwareFilters []iotmakerPlatformIDraw.IFilterSoftware
+}
+
+// prepare
+// en: Prepares the native filters of the stage on the platform, joining the lists
+// of the CSS filters into a single SetFilter() call
+//
+// pt_br: Prepara os filtros nativos do estágio na plataforma, unindo as listas dos
+// filtros CSS em uma única chamada de SetFilter()
+func (el stage) prepare(platform iotmakerPlatformIDraw.IDraw) {
+	var list cssFilter.List
+	for _, filter := range el.nativeFilters {
+		if css, ok := filter.(*CSSFilter); ok {
+			list = append(list, css.getList()...)
+			continue
+		}
+		filter.PrepareFilter(platform)
+	}
+	if len(list) != 0 {
+		platform.SetFilter(list)
+	}
+}
+
+func (el *Chain) indexOf(name string) int {
+	for index, filter := range el.filters {
+		if filter.GetName() == name {
+			return index
+		}
+	}
+	return -1
+}
+
+func clampIndex(index, length int) int {
+	if index < 0 {
+		return 0
+	}
+	if index > length {
+		return length
+	}
+	return index
+}
@@ -0,0 +1,77 @@
+package filterChain
+
+import (
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+const (
+	// KParameterP0
+	// en: Name of the parameter with the start point of the gradient, passed to
+	// IFilterGradientInterface.SetP0()
+	//
+	// pt_br: Nome do parâmetro com o ponto inicial do gradiente, passado para
+	// IFilterGradientInterface.SetP0()
+	KParameterP0 = "p0"
+
+	// KParameterP1
+	// en: Name of the parameter with the end point of the gradient, passed to
+	// IFilterGradientInterface.SetP1()
+	//
+	// pt_br: Nome do parâmetro com o ponto final do gradiente, passado para
+	// IFilterGradientInterface.SetP1()
+	KParameterP1 = "p1"
+)
+
+// GradientFilter
+// en: Plugs an existing IFilterGradientInterface into a Chain. The parameters
+// KParameterP0 and KParameterP1 are forwarded to SetP0() and SetP1()
+//
+// pt_br: Conecta um IFilterGradientInterface existente a uma Chain. Os parâmetros
+// KParameterP0 e KParameterP1 são repassados para SetP0() e SetP1()
+type GradientFilter struct {
+	*Base
+	Filter iotmakerPlatformIDraw.IFilterGradientInterface
+}
+
+// NewGradientFilter
+// en: Returns a new chain filter that calls filter.PrepareFilter()
+//
+//	name: Name of the filter inside the chain
+//
+// pt_br: Retorna um novo filtro de cadeia que chama filter.PrepareFilter()
+//
+//	name: Nome do filtro dentro da cadeia
+func NewGradientFilter(name string, filter iotmakerPlatformIDraw.IFilterGradientInterface) *GradientFilter {
+	return &GradientFilter{
+		Base:   NewBase(name, map[string]interface{}{KParameterP0: nil, KParameterP1: nil}),
+		Filter: filter,
+	}
+}
+
+// SetParameter
+// en: Sets the parameter and forwards KParameterP0 and KParameterP1 to the
+// gradient filter
+//
+// pt_br: Define o parâmetro e repassa KParameterP0 e KParameterP1 para o filtro de
+// gradiente
+func (el *GradientFilter) SetParameter(name string, value interface{}) (err error) {
+	err = el.Base.SetParameter(name, value)
+	if err != nil {
+		return
+	}
+	switch name {
+	case KParameterP0:
+		el.Filter.SetP0(value)
+	case KParameterP1:
+		el.Filter.SetP1(value)
+	}
+	return
+}
+
+// PrepareFilter
+// en: Configures the gradient of the platform
+//
+// pt_br: Configura o gradiente da plataforma
+func (el *GradientFilter) PrepareFilter(platform iotmakerPlatformIDraw.IDraw) {
+	el.Filter.PrepareFilter(platform)
+}
@@ -0,0 +1,37 @@
+package filterChain
+
+import (
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// ShadowFilter
+// en: Plugs an existing IFilterShadowInterface into a Chain
+//
+// pt_br: Conecta um IFilterShadowInterface existente a uma Chain
+type ShadowFilter struct {
+	*Base
+	Filter iotmakerPlatformIDraw.IFilterShadowInterface
+}
+
+// NewShadowFilter
+// en: Returns a new chain filter that calls filter.PrepareFilter()
+//
+//	name: Name of the filter inside the chain
+//
+// pt_br: Retorna um novo filtro de cadeia que chama filter.PrepareFilter()
+//
+//	name: Nome do filtro dentro da cadeia
+func NewShadowFilter(name string, filter iotmakerPlatformIDraw.IFilterShadowInterface) *ShadowFilter {
+	return &ShadowFilter{
+		Base:   NewBase(name, nil),
+		Filter: filter,
+	}
+}
+
+// PrepareFilter
+// en: Configures the shadow of the platform
+//
+// pt_br: Configura a sombra da plataforma
+func (el *ShadowFilter) PrepareFilter(platform iotmakerPlatformIDraw.IDraw) {
+	el.Filter.PrepareFilter(platform)
+}
@@ -0,0 +1,51 @@
+package filterChain
+
+import (
+	"image/color"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// SoftwareFilter
+// en: Filter executed only in software, by a function that changes the pixels of
+// the drawing. Use it to plug the effects of the effect package into a Chain
+//
+// pt_br: Filtro executado apenas em software, por uma função que altera os pixels
+// do desenho. Use para conectar os efeitos do pacote effect a uma Chain
+type SoftwareFilter struct {
+	*Base
+	apply func(data map[int]map[int]color.RGBA, width, height int)
+}
+
+// NewSoftwareFilter
+// en: Returns a new software filter
+//
+//	name: Name of the filter inside the chain
+//	apply: Function that changes the pixels; data is a map[x][y]color.RGBA in
+//	       the format of IDraw.GetImageData()
+//
+// pt_br: Retorna um novo filtro de software
+//
+//	name: Nome do filtro dentro da cadeia
+//	apply: Função que altera os pixels; data é um map[x][y]color.RGBA no formato
+//	       de IDraw.GetImageData()
+func NewSoftwareFilter(name string, apply func(data map[int]map[int]color.RGBA, width, height int)) *SoftwareFilter {
+	return &SoftwareFilter{
+		Base:  NewBase(name, nil),
+		apply: apply,
+	}
+}
+
+// PrepareFilter
+// en: Does nothing, the filter has no native execution
+//
+// pt_br: Não faz nada, o filtro não tem execução nativa
+func (el *SoftwareFilter) PrepareFilter(platform iotmakerPlatformIDraw.IDraw) {}
+
+// ApplyToImageData
+// en: Calls the function of the filter
+//
+// pt_br: Chama a função do filtro
+func (el *SoftwareFilter) ApplyToImageData(data map[int]map[int]color.RGBA, width, height int) {
+	el.apply(data, width, height)
+}
@@ -0,0 +1,53 @@
+package fontRegistry
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// FromFont
+// en: Converts the font.Font used by IDraw.Font() into a Description. Family is
+// read as a CSS family list and Size in pixels; font.Font has no weight or style,
+// so the description is normal 400
+//
+// pt_br: Converte o font.Font usado por IDraw.Font() em uma Description. Family é
+// lido como uma lista de famílias CSS e Size em pixels; font.Font não tem peso nem
+// estilo, assim a descrição é normal 400
+func FromFont(value font.Font) Description {
+	description := Description{Weight: 400, Size: KDefaultSize}
+	for _, name := range strings.Split(value.Family, ",") {
+		name = strings.Trim(strings.TrimSpace(name), `"'`)
+		if name != "" {
+			description.Families = append(description.Families, name)
+		}
+	}
+	if value.Size > 0 {
+		description.Size = float64(value.Size)
+	}
+	return description
+}
+
+// ToFont
+// en: Converts the description into the font.Font used by IDraw.Font(), the
+// inverse of FromFont(). Families with spaces or commas are quoted and the size is
+// rounded to whole pixels; weight and style have no field in font.Font
+//
+// pt_br: Converte a descrição no font.Font usado por IDraw.Font(), o inverso de
+// FromFont(). Famílias com espaços ou vírgulas ficam entre aspas e o tamanho é
+// arredondado para pixels inteiros; peso e estilo não têm campo em font.Font
+func (el Description) ToFont() font.Font {
+	families := make([]string, len(el.Families))
+	for i, family := range el.Families {
+		if strings.ContainsAny(family, " ,") {
+			family = strconv.Quote(family)
+		}
+		families[i] = family
+	}
+	return font.Font{
+		Family: strings.Join(families, ", "),
+		Size:   int(math.Round(el.Size)),
+	}
+}
@@ -0,0 +1,48 @@
+package fontRegistry
+
+// u8, u16, i16 and u32 read big endian values and return 0 outside of the data, so
+// a damaged font produces empty glyphs instead of a panic
+
+func u8(data []byte, offset int) uint8 {
+	if offset < 0 || offset >= len(data) {
+		return 0
+	}
+	return data[offset]
+}
+
+func u16(data []byte, offset int) uint16 {
+	if offset < 0 || offset+2 > len(data) {
+		return 0
+	}
+	return uint16(data[offset])<<8 | uint16(data[offset+1])
+}
+
+func i16(data []byte, offset int) int16 {
+	return int16(u16(data, offset))
+}
+
+func u32(data []byte, offset int) uint32 {
+	if offset < 0 || offset+4 > len(data) {
+		return 0
+	}
+	return uint32(data[offset])<<24 | uint32(data[offset+1])<<16 | uint32(data[offset+2])<<8 | uint32(data[offset+3])
+}
+
+// f2dot14
+// en: Reads a signed fixed point number with 14 fractional bits
+//
+// pt_br: Lê um número de ponto fixo com sinal e 14 bits fracionários
+func f2dot14(data []byte, offset int) float64 {
+	return float64(i16(data, offset)) / 16384
+}
+
+// slice
+// en: Returns the part of the data, or nil when it is out of the data
+//
+// pt_br: Retorna a parte dos dados, ou nil quando está fora dos dados
+func slice(data []byte, offset, length int) []byte {
+	if offset < 0 || length < 0 || offset+length > len(data) {
+		return nil
+	}
+	return data[offset : offset+length]
+}
@@ -0,0 +1,211 @@
+package fontRegistry
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// KDefaultSize
+// en: Font size, in pixels, of "medium" and of the em unit, as in the browsers
+//
+// pt_br: Tamanho da fonte, em pixels, de "medium" e da unidade em, como nos
+// navegadores
+const KDefaultSize = 16.0
+
+// Description
+// en: Font requested by the user, as in the CSS font shorthand
+//
+//	Families: Family names in order of preference; generic names, like
+//	          sans-serif, are resolved by Registry.SetGeneric()
+//	Weight: From 1 to 1000; 400 is normal and 700 is bold
+//	Style: Normal, italic or oblique
+//	Size: Size in pixels
+//	LineHeight: Line height in pixels; 0 means normal
+//
+// pt_br: Fonte pedida pelo usuário, como no atalho CSS font
+//
+//	Families: Nomes de famílias em ordem de preferência; nomes genéricos, como
+//	          sans-serif, são resolvidos por Registry.SetGeneric()
+//	Weight: De 1 a 1000; 400 é normal e 700 é negrito
+//	Style: Normal, itálico ou oblíquo
+//	Size: Tamanho em pixels
+//	LineHeight: Altura da linha em pixels; 0 significa normal
+type Description struct {
+	Families   []string
+	Weight     int
+	Style      Style
+	Size       float64
+	LineHeight float64
+}
+
+// String
+// en: Returns the description as a CSS font shorthand, accepted by ParseCSS()
+//
+// pt_br: Retorna a descrição como um atalho CSS font, aceito por ParseCSS()
+func (el Description) String() string {
+	families := make([]string, len(el.Families))
+	for i, family := range el.Families {
+		if strings.ContainsAny(family, " ,") {
+			family = strconv.Quote(family)
+		}
+		families[i] = family
+	}
+	size := strconv.FormatFloat(el.Size, 'f', -1, 64) + "px"
+	if el.LineHeight != 0 {
+		size += "/" + strconv.FormatFloat(el.LineHeight, 'f', -1, 64) + "px"
+	}
+	return fmt.Sprintf("%v %v %v %v", el.Style, el.Weight, size, strings.Join(families, ", "))
+}
+
+// ParseCSS
+// en: Parses a CSS font shorthand, like "italic bold 16px/1.5 'Open Sans', serif"
+//
+//	Sizes accept px, pt, em, rem, % and the keywords from xx-small to xx-large;
+//	em, rem and % are relative to KDefaultSize
+//	Variant and stretch keywords are accepted and ignored
+//
+// pt_br: Interpreta um atalho CSS font, como "italic bold 16px/1.5 'Open Sans',
+// serif"
+//
+//	Tamanhos aceitam px, pt, em, rem, % e as palavras chave de xx-small a
+//	xx-large; em, rem e % são relativos a KDefaultSize
+//	Palavras chave de variante e largura são aceitas e ignoradas
+func ParseCSS(value string) (description Description, err error) {
+	description = Description{Weight: 400, Size: KDefaultSize}
+	words := strings.Fields(value)
+
+	index := 0
+	for ; index < len(words); index += 1 {
+		word := strings.ToLower(words[index])
+		switch word {
+		case "normal", "small-caps", "ultra-condensed", "extra-condensed", "condensed", "semi-condensed",
+			"semi-expanded", "expanded", "extra-expanded", "ultra-expanded":
+			continue
+		case "italic":
+			description.Style = KStyleItalic
+			continue
+		case "oblique":
+			description.Style = KStyleOblique
+			continue
+		case "bold", "bolder":
+			description.Weight = 700
+			continue
+		case "lighter":
+			description.Weight = 300
+			continue
+		}
+		if weight, err := strconv.Atoi(word); err == nil && weight >= 1 && weight <= 1000 {
+			description.Weight = weight
+			continue
+		}
+		break
+	}
+	if index == len(words) {
+		return Description{}, fmt.Errorf("fontRegistry: missing font size in %q", value)
+	}
+
+	size := words[index]
+	lineHeight := ""
+	if slash := strings.IndexByte(size, '/'); slash != -1 {
+		size, lineHeight = size[:slash], size[slash+1:]
+	}
+	index += 1
+	if lineHeight == "" && index < len(words) && strings.HasPrefix(words[index], "/") {
+		lineHeight = strings.TrimPrefix(words[index], "/")
+		index += 1
+		if lineHeight == "" && index < len(words) {
+			lineHeight = words[index]
+			index += 1
+		}
+	}
+
+	if description.Size, err = parseSize(size); err != nil {
+		return Description{}, err
+	}
+	if lineHeight != "" {
+		if description.LineHeight, err = parseLineHeight(lineHeight, description.Size); err != nil {
+			return Description{}, err
+		}
+	}
+
+	for _, family := range strings.Split(strings.Join(words[index:], " "), ",") {
+		family = strings.Trim(strings.TrimSpace(family), `"'`)
+		if family != "" {
+			description.Families = append(description.Families, family)
+		}
+	}
+	if len(description.Families) == 0 {
+		return Description{}, fmt.Errorf("fontRegistry: missing font family in %q", value)
+	}
+	return
+}
+
+// parseSize
+// en: Parses a font size and returns it in pixels
+//
+// pt_br: Interpreta um tamanho de fonte e o retorna em pixels
+func parseSize(value string) (float64, error) {
+	keywords := map[string]float64{
+		"xx-small": 9, "x-small": 10, "small": 13, "medium": 16, "large": 18, "x-large": 24, "xx-large": 32,
+		"xxx-large": 48, "smaller": 13, "larger": 19,
+	}
+	value = strings.ToLower(value)
+	if size, found := keywords[value]; found {
+		return size, nil
+	}
+
+	units := []struct {
+		suffix string
+		scale  float64
+	}{
+		{"px", 1},
+		{"pt", 4.0 / 3.0},
+		{"rem", KDefaultSize},
+		{"em", KDefaultSize},
+		{"%", KDefaultSize / 100},
+	}
+	for _, unit := range units {
+		if !strings.HasSuffix(value, unit.suffix) {
+			continue
+		}
+		size, err := strconv.ParseFloat(strings.TrimSuffix(value, unit.suffix), 64)
+		if err != nil || size < 0 || math.IsInf(size, 0) {
+			break
+		}
+		return size * unit.scale, nil
+	}
+	return 0, fmt.Errorf("fontRegistry: invalid font size %q", value)
+}
+
+// parseLineHeight
+// en: Parses a line height and returns it in pixels; numbers multiply the font
+// size
+//
+// pt_br: Interpreta uma altura de linha e a retorna em pixels; números multiplicam
+// o tamanho da fonte
+func parseLineHeight(value string, size float64) (float64, error) {
+	value = strings.ToLower(value)
+	if value == "normal" {
+		return 0, nil
+	}
+	if number, err := strconv.ParseFloat(value, 64); err == nil && number >= 0 {
+		return number * size, nil
+	}
+	if strings.HasSuffix(value, "%") {
+		if number, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64); err == nil && number >= 0 {
+			return number * size / 100, nil
+		}
+	}
+	if strings.HasSuffix(value, "em") {
+		if number, err := strconv.ParseFloat(strings.TrimSuffix(value, "em"), 64); err == nil && number >= 0 {
+			return number * size, nil
+		}
+	}
+	height, err := parseSize(value)
+	if err != nil {
+		return 0, fmt.Errorf("fontRegistry: invalid line height %q", value)
+	}
+	return height, nil
+}
@@ -0,0 +1,494 @@
+package fontRegistry
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/bidi"
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+)
+
+// Hinting
+// en: Adjustment of the glyphs to the pixel grid
+//
+// pt_br: Ajuste dos glifos à grade de pixels
+type Hinting int
+
+const (
+	// KHintingNone
+	// en: The glyphs are drawn at the exact positions, as in the browsers with
+	// subpixel positioning
+	//
+	// pt_br: Os glifos são desenhados nas posições exatas, como nos navegadores com
+	// posicionamento subpixel
+	KHintingNone Hinting = iota
+
+	// KHintingVertical
+	// en: Light hinting: the baseline is placed on a pixel border and the vertical
+	// scale is adjusted so the x-height has an integer number of pixels, which keeps
+	// small text sharp. The horizontal positions are not changed. The bytecode
+	// instructions of the fonts are not executed
+	//
+	// pt_br: Hinting leve: a linha de base é colocada na borda de um pixel e a
+	// escala vertical é ajustada para que a altura x tenha um número inteiro de
+	// pixels, o que mantém textos pequenos nítidos. As posições horizontais não são
+	// alteradas. As instruções bytecode das fontes não são executadas
+	KHintingVertical
+)
+
+const (
+	// KSyntheticItalicShear
+	// en: Shear applied to fonts without an italic face, as done by the browsers
+	//
+	// pt_br: Inclinação aplicada a fontes sem uma face itálica, como feito pelos
+	// navegadores
+	KSyntheticItalicShear = 0.2
+
+	// KSyntheticBoldFactor
+	// en: Width of the stroke added to fonts without a bold face, as a fraction of
+	// the size
+	//
+	// pt_br: Largura do contorno adicionado a fontes sem uma face negrito, como uma
+	// fração do tamanho
+	KSyntheticBoldFactor = 1.0 / 24.0
+)
+
+// Metrics
+// en: Measures of a text, with the same meaning as the TextMetrics of the
+// browsers, in pixels, for textBaseline alphabetic and textAlign start
+//
+// pt_br: Medidas de um texto, com o mesmo significado do TextMetrics dos
+// navegadores, em pixels, para textBaseline alphabetic e textAlign start
+type Metrics struct {
+	Width                    float64
+	ActualBoundingBoxLeft    float64
+	ActualBoundingBoxRight   float64
+	ActualBoundingBoxAscent  float64
+	ActualBoundingBoxDescent float64
+	FontBoundingBoxAscent    float64
+	FontBoundingBoxDescent   float64
+	EmHeightAscent           float64
+	EmHeightDescent          float64
+	HangingBaseline          float64
+	AlphabeticBaseline       float64
+	IdeographicBaseline      float64
+}
+
+// ToTextMetrics
+// en: Converts the metrics to the type returned by IDraw.MeasureText()
+//
+// pt_br: Converte as medidas para o tipo retornado por IDraw.MeasureText()
+func (el Metrics) ToTextMetrics() iotmakerPlatformTextMetrics.TextMetrics {
+	return iotmakerPlatformTextMetrics.TextMetrics{
+		Width:                    el.Width,
+		ActualBoundingBoxLeft:    el.ActualBoundingBoxLeft,
+		ActualBoundingBoxRight:   el.ActualBoundingBoxRight,
+		ActualBoundingBoxAscent:  el.ActualBoundingBoxAscent,
+		ActualBoundingBoxDescent: el.ActualBoundingBoxDescent,
+		FontBoundingBoxAscent:    el.FontBoundingBoxAscent,
+		FontBoundingBoxDescent:   el.FontBoundingBoxDescent,
+		EmHeightAscent:           el.EmHeightAscent,
+		EmHeightDescent:          el.EmHeightDescent,
+		HangingBaseline:          el.HangingBaseline,
+		AlphabeticBaseline:       el.AlphabeticBaseline,
+		IdeographicBaseline:      el.IdeographicBaseline,
+	}
+}
+
+// Face
+// en: Font with a size, able to measure and draw text on images. A Face keeps a
+// rasterizer for reuse and must not be used by two goroutines at the same time
+//
+// pt_br: Fonte com um tamanho, capaz de medir e desenhar texto em imagens. Uma
+// Face mantém um rasterizador para reuso e não deve ser usada por duas goroutines
+// ao mesmo tempo
+type Face struct {
+	font    *Font
+	size    float64
+	hinting Hinting
+
+	scaleX float64
+	scaleY float64
+
+	syntheticBold   bool
+	syntheticItalic bool
+
+	direction bidi.Direction
+
+	rasterizer *Rasterizer
+}
+
+// placedGlyph
+// en: Glyph with its horizontal position, in pixels, from the start of the text
+//
+// pt_br: Glifo com a sua posição horizontal, em pixels, a partir do início do
+// texto
+type placedGlyph struct {
+	glyph uint16
+	x     float64
+}
+
+// NewFace
+// en: Returns a face of the font with the size, in pixels
+//
+// pt_br: Retorna uma face da fonte com o tamanho, em pixels
+func NewFace(font *Font, size float64, hinting Hinting) *Face {
+	face := &Face{
+		font:       font,
+		size:       size,
+		hinting:    hinting,
+		scaleX:     size / font.unitsPerEm,
+		scaleY:     size / font.unitsPerEm,
+		rasterizer: NewRasterizer(0, 0),
+	}
+	if hinting == KHintingVertical && font.xHeight > 0 {
+		if pixels := math.Round(font.xHeight * face.scaleY); pixels > 0 {
+			face.scaleY = pixels / font.xHeight
+		}
+	}
+	return face
+}
+
+// SetSynthetic
+// en: Enables the synthetic bold and italic, used by the Registry when the family
+// does not have the face requested
+//
+// pt_br: Habilita o negrito e o itálico sintéticos, usados pelo Registry quando a
+// família não tem a face pedida
+func (el *Face) SetSynthetic(bold, italic bool) {
+	el.syntheticBold, el.syntheticItalic = bold, italic
+}
+
+// SetDirection
+// en: Defines the base direction of the text, like IDraw.SetDirection(). Text with
+// right to left characters is shaped and reordered with bidi.Visual() before it
+// is measured and drawn
+//
+// pt_br: Define a direção base do texto, como IDraw.SetDirection(). Texto com
+// caracteres da direita para a esquerda passa por shaping e é reordenado com
+// bidi.Visual() antes de ser medido e desenhado
+func (el *Face) SetDirection(direction bidi.Direction) {
+	el.direction = direction
+}
+
+// GetDirection
+// en: Returns the base direction of the text
+//
+// pt_br: Retorna a direção base do texto
+func (el *Face) GetDirection() bidi.Direction {
+	return el.direction
+}
+
+// GetFont
+// en: Returns the font of the face
+//
+// pt_br: Retorna a fonte da face
+func (el *Face) GetFont() *Font {
+	return el.font
+}
+
+// GetSize
+// en: Returns the size of the face, in pixels
+//
+// pt_br: Retorna o tamanho da face, em pixels
+func (el *Face) GetSize() float64 {
+	return el.size
+}
+
+// GetAscent
+// en: Returns the distance, in pixels, from the baseline to the top of the line
+//
+// pt_br: Retorna a distância, em pixels, da linha de base ao topo da linha
+func (el *Face) GetAscent() float64 {
+	return el.font.ascender * el.scaleY
+}
+
+// GetDescent
+// en: Returns the distance, in pixels, from the baseline to the bottom of the line,
+// as a positive number
+//
+// pt_br: Retorna a distância, em pixels, da linha de base ao fundo da linha, como
+// um número positivo
+func (el *Face) GetDescent() float64 {
+	return -el.font.descender * el.scaleY
+}
+
+// GetLineHeight
+// en: Returns the normal line height, in pixels: ascent, descent and line gap
+//
+// pt_br: Retorna a altura de linha normal, em pixels: ascendente, descendente e
+// espaço entre linhas
+func (el *Face) GetLineHeight() float64 {
+	return (el.font.ascender - el.font.descender + el.font.lineGap) * el.scaleY
+}
+
+// GetXHeight
+// en: Returns the height of the lowercase letters, in pixels
+//
+// pt_br: Retorna a altura das letras minúsculas, em pixels
+func (el *Face) GetXHeight() float64 {
+	return el.font.xHeight * el.scaleY
+}
+
+// GetCapHeight
+// en: Returns the height of the uppercase letters, in pixels
+//
+// pt_br: Retorna a altura das letras maiúsculas, em pixels
+func (el *Face) GetCapHeight() float64 {
+	return el.font.capHeight * el.scaleY
+}
+
+// Measure
+// en: Returns the metrics of the text
+//
+// pt_br: Retorna as medidas do texto
+func (el *Face) Measure(text string) Metrics {
+	glyphs, width := el.layout(text)
+	metrics := Metrics{
+		Width:                  width,
+		FontBoundingBoxAscent:  el.GetAscent(),
+		FontBoundingBoxDescent: el.GetDescent(),
+		HangingBaseline:        0.8 * el.GetAscent(),
+		IdeographicBaseline:    -el.GetDescent(),
+	}
+	if em := el.font.ascender - el.font.descender; em > 0 {
+		metrics.EmHeightAscent = el.size * el.font.ascender / em
+		metrics.EmHeightDescent = el.size - metrics.EmHeightAscent
+	}
+
+	minX, minY, maxX, maxY, found := el.bounds(glyphs, 1)
+	if found {
+		metrics.ActualBoundingBoxLeft = -minX
+		metrics.ActualBoundingBoxRight = maxX
+		metrics.ActualBoundingBoxAscent = -minY
+		metrics.ActualBoundingBoxDescent = maxY
+	}
+	return metrics
+}
+
+// MeasureText
+// en: Same as IDraw.MeasureText(), for headless backends
+//
+// pt_br: O mesmo que IDraw.MeasureText(), para backends sem navegador
+func (el *Face) MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics {
+	return el.Measure(text).ToTextMetrics()
+}
+
+// FillText
+// en: Draws the text on the image, as IDraw.FillText()
+//
+//	x, y: Start of the alphabetic baseline
+//	maxWidth: Optional; a wider text is compressed horizontally to this width
+//
+// pt_br: Desenha o texto na imagem, como IDraw.FillText()
+//
+//	x, y: Início da linha de base alfabética
+//	maxWidth: Opcional; um texto mais largo é comprimido horizontalmente para
+//	          esta largura
+func (el *Face) FillText(destination draw.Image, text string, x, y float64, fill color.Color, maxWidth ...float64) {
+	el.drawText(destination, text, x, y, 0, fill, maxWidth)
+}
+
+// StrokeText
+// en: Draws the outline of the glyphs on the image, as IDraw.StrokeText()
+//
+//	x, y: Start of the alphabetic baseline
+//	lineWidth: Width of the line, in pixels
+//	maxWidth: Optional; a wider text is compressed horizontally to this width
+//
+// pt_br: Desenha o contorno dos glifos na imagem, como IDraw.StrokeText()
+//
+//	x, y: Início da linha de base alfabética
+//	lineWidth: Largura da linha, em pixels
+//	maxWidth: Opcional; um texto mais largo é comprimido horizontalmente para
+//	          esta largura
+func (el *Face) StrokeText(destination draw.Image, text string, x, y, lineWidth float64, stroke color.Color, maxWidth ...float64) {
+	if lineWidth <= 0 {
+		return
+	}
+	el.drawText(destination, text, x, y, lineWidth, stroke, maxWidth)
+}
+
+// Path
+// en: Sends the outlines of the text to the pather, in pixels, with the baseline
+// starting at x, y
+//
+// pt_br: Envia os contornos do texto para o pather, em pixels, com a linha de base
+// começando em x, y
+func (el *Face) Path(pather Pather, text string, x, y float64) {
+	glyphs, _ := el.layout(text)
+	for _, placed := range glyphs {
+		el.font.Outline(placed.glyph).Path(pather, el.transform(x+placed.x, y, 1))
+	}
+}
+
+// GlyphAdvance
+// en: Returns the advance of the character, in pixels
+//
+// pt_br: Retorna o avanço do caractere, em pixels
+func (el *Face) GlyphAdvance(character rune) float64 {
+	_, width := el.layout(string(character))
+	return width
+}
+
+// layout
+// en: Places each glyph of the text, in visual order, and returns the total width
+//
+// pt_br: Posiciona cada glifo do texto, em ordem visual, e retorna a largura total
+func (el *Face) layout(text string) (glyphs []placedGlyph, width float64) {
+	text = bidi.Visual(text, el.direction)
+	glyphs = make([]placedGlyph, 0, len(text))
+	previous, hasPrevious := uint16(0), false
+	for _, character := range text {
+		glyph := el.font.GlyphIndex(character)
+		if hasPrevious {
+			width += el.font.Kerning(previous, glyph) * el.scaleX
+		}
+		glyphs = append(glyphs, placedGlyph{glyph: glyph, x: width})
+
+		width += el.font.Advance(glyph) * el.scaleX
+		if el.syntheticBold {
+			width += el.size * KSyntheticBoldFactor
+		}
+		previous, hasPrevious = glyph, true
+	}
+	return
+}
+
+// transform
+// en: Returns the conversion from font units of a glyph at x, y to pixels
+//
+// pt_br: Retorna a conversão de unidades da fonte de um glifo em x, y para pixels
+func (el *Face) transform(x, y, compression float64) func(fontX, fontY float64) (float64, float64) {
+	shear := 0.0
+	if el.syntheticItalic {
+		shear = KSyntheticItalicShear
+	}
+	return func(fontX, fontY float64) (float64, float64) {
+		return x + (fontX+shear*fontY)*el.scaleX*compression, y - fontY*el.scaleY
+	}
+}
+
+// bounds
+// en: Returns the box of the glyphs, in pixels relative to the start of the
+// baseline; found is false when no glyph has an outline
+//
+// pt_br: Retorna a caixa dos glifos, em pixels relativos ao início da linha de
+// base; found é false quando nenhum glifo tem contorno
+func (el *Face) bounds(glyphs []placedGlyph, compression float64) (minX, minY, maxX, maxY float64, found bool) {
+	minX, minY = math.Inf(1), math.Inf(1)
+	maxX, maxY = math.Inf(-1), math.Inf(-1)
+	for _, placed := range glyphs {
+		outline := el.font.Outline(placed.glyph)
+		if len(outline) == 0 {
+			continue
+		}
+		left, bottom, right, top := outline.Bounds()
+		transform := el.transform(placed.x*compression, 0, compression)
+		for _, corner := range [][2]float64{{left, bottom}, {left, top}, {right, bottom}, {right, top}} {
+			x, y := transform(corner[0], corner[1])
+			minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+			minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+		}
+		found = true
+	}
+	if found && el.syntheticBold {
+		half := el.size * KSyntheticBoldFactor / 2
+		minX, minY, maxX, maxY = minX-half, minY-half, maxX+half, maxY+half
+	}
+	return
+}
+
+// drawText
+// en: Rasterizes the text, filled when lineWidth is zero, and composes it over the
+// image
+//
+// pt_br: Rasteriza o texto, preenchido quando lineWidth é zero, e o compõe sobre a
+// imagem
+func (el *Face) drawText(destination draw.Image, text string, x, y, lineWidth float64, paint color.Color, maxWidth []float64) {
+	glyphs, width := el.layout(text)
+	compression := 1.0
+	if len(maxWidth) > 0 && maxWidth[0] >= 0 && width > maxWidth[0] {
+		if maxWidth[0] == 0 {
+			return
+		}
+		compression = maxWidth[0] / width
+	}
+	if el.hinting == KHintingVertical {
+		y = math.Round(y)
+	}
+
+	minX, minY, maxX, maxY, found := el.bounds(glyphs, compression)
+	if !found {
+		return
+	}
+	padding := 1 + lineWidth/2
+	left := int(math.Floor(x + minX - padding))
+	top := int(math.Floor(y + minY - padding))
+	right := int(math.Ceil(x + maxX + padding))
+	bottom := int(math.Ceil(y + maxY + padding))
+
+	area := image.Rect(left, top, right, bottom).Intersect(destination.Bounds())
+	if area.Empty() {
+		return
+	}
+	originX, originY := x-float64(area.Min.X), y-float64(area.Min.Y)
+
+	var mask *image.Alpha
+	switch {
+	case lineWidth > 0:
+		mask = el.strokeMask(glyphs, area, originX, originY, lineWidth, compression)
+	case el.syntheticBold:
+		mask = maxMask(
+			el.fillMask(glyphs, area, originX, originY, compression),
+			el.strokeMask(glyphs, area, originX, originY, el.size*KSyntheticBoldFactor, compression),
+		)
+	default:
+		mask = el.fillMask(glyphs, area, originX, originY, compression)
+	}
+
+	draw.DrawMask(destination, area, image.NewUniform(paint), image.Point{}, mask, image.Point{}, draw.Over)
+}
+
+// fillMask
+// en: Returns the coverage of the filled glyphs
+//
+// pt_br: Retorna a cobertura dos glifos preenchidos
+func (el *Face) fillMask(glyphs []placedGlyph, area image.Rectangle, originX, originY, compression float64) *image.Alpha {
+	el.rasterizer.Reset(area.Dx(), area.Dy())
+	for _, placed := range glyphs {
+		transform := el.transform(originX+placed.x*compression, originY, compression)
+		el.font.Outline(placed.glyph).Path(el.rasterizer, transform)
+	}
+	return el.rasterizer.Mask()
+}
+
+// strokeMask
+// en: Returns the coverage of the outline of the glyphs
+//
+// pt_br: Retorna a cobertura do contorno dos glifos
+func (el *Face) strokeMask(glyphs []placedGlyph, area image.Rectangle, originX, originY, lineWidth, compression float64) *image.Alpha {
+	flattener := &polylines{}
+	for _, placed := range glyphs {
+		transform := el.transform(originX+placed.x*compression, originY, compression)
+		el.font.Outline(placed.glyph).Path(flattener, transform)
+	}
+	el.rasterizer.Reset(area.Dx(), area.Dy())
+	flattener.stroke(el.rasterizer, lineWidth)
+	return el.rasterizer.Mask()
+}
+
+// maxMask
+// en: Returns the union of two masks of the same size
+//
+// pt_br: Retorna a união de duas máscaras do mesmo tamanho
+func maxMask(a, b *image.Alpha) *image.Alpha {
+	for i := range a.Pix {
+		if b.Pix[i] > a.Pix[i] {
+			a.Pix[i] = b.Pix[i]
+		}
+	}
+	return a
+}
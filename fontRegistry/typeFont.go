@@ -0,0 +1,430 @@
+package fontRegistry
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf16"
+)
+
+// Style
+// en: Style of a font, as the CSS font-style property
+//
+// pt_br: Estilo de uma fonte, como a propriedade CSS font-style
+type Style int
+
+const (
+	KStyleNormal Style = iota
+	KStyleItalic
+	KStyleOblique
+)
+
+// String
+// en: Returns the CSS keyword of the style
+//
+// pt_br: Retorna a palavra chave CSS do estilo
+func (el Style) String() string {
+	switch el {
+	case KStyleItalic:
+		return "italic"
+	case KStyleOblique:
+		return "oblique"
+	}
+	return "normal"
+}
+
+// ErrCFFNotSupported
+// en: Returned for OpenType fonts with PostScript (CFF) outlines. Only TrueType
+// outlines, the glyf table, are supported; this covers .ttf files and the .otf
+// files with TrueType outlines
+//
+// pt_br: Retornado para fontes OpenType com contornos PostScript (CFF). Apenas
+// contornos TrueType, a tabela glyf, são suportados; isto cobre arquivos .ttf e os
+// arquivos .otf com contornos TrueType
+var ErrCFFNotSupported = errors.New("fontRegistry: OpenType fonts with CFF outlines are not supported")
+
+// Font
+// en: TrueType font parsed from a .ttf, .otf or .ttc file. A Font has no size;
+// use NewFace() to draw and measure text with it
+//
+// pt_br: Fonte TrueType interpretada de um arquivo .ttf, .otf ou .ttc. Uma Font
+// não tem tamanho; use NewFace() para desenhar e medir texto com ela
+type Font struct {
+	family     string
+	weight     int
+	style      Style
+	unitsPerEm float64
+
+	ascender  float64
+	descender float64
+	lineGap   float64
+	xHeight   float64
+	capHeight float64
+
+	numGlyphs   int
+	numHMetrics int
+	locaLong    bool
+
+	glyf []byte
+	loca []byte
+	hmtx []byte
+
+	cmap map[rune]uint16
+	kern map[uint32]int16
+}
+
+// Parse
+// en: Parses a font file. For collections (.ttc) the first font is returned; use
+// ParseIndex() for the others
+//
+// pt_br: Interpreta um arquivo de fonte. Para coleções (.ttc) a primeira fonte é
+// retornada; use ParseIndex() para as outras
+func Parse(data []byte) (*Font, error) {
+	return ParseIndex(data, 0)
+}
+
+// ParseIndex
+// en: Parses the font of the index inside a collection (.ttc); for a single font
+// the index must be 0
+//
+// pt_br: Interpreta a fonte do índice dentro de uma coleção (.ttc); para uma fonte
+// única o índice deve ser 0
+func ParseIndex(data []byte, index int) (*Font, error) {
+	offset := 0
+	if string(slice(data, 0, 4)) == "ttcf" {
+		count := int(u32(data, 8))
+		if index < 0 || index >= count {
+			return nil, fmt.Errorf("fontRegistry: collection has %v fonts, index %v", count, index)
+		}
+		offset = int(u32(data, 12+4*index))
+	} else if index != 0 {
+		return nil, fmt.Errorf("fontRegistry: index %v of a file with a single font", index)
+	}
+
+	switch version := u32(data, offset); version {
+	case 0x00010000, 0x74727565:
+	case 0x4f54544f:
+		return nil, ErrCFFNotSupported
+	default:
+		return nil, fmt.Errorf("fontRegistry: unknown font format 0x%08x", version)
+	}
+
+	tables := make(map[string][]byte)
+	numTables := int(u16(data, offset+4))
+	for i := 0; i < numTables; i += 1 {
+		record := offset + 12 + 16*i
+		table := slice(data, int(u32(data, record+8)), int(u32(data, record+12)))
+		if table == nil {
+			return nil, fmt.Errorf("fontRegistry: table %q out of the file", slice(data, record, 4))
+		}
+		tables[string(slice(data, record, 4))] = table
+	}
+
+	if tables["CFF "] != nil || tables["CFF2"] != nil {
+		return nil, ErrCFFNotSupported
+	}
+	for _, name := range []string{"head", "hhea", "maxp", "hmtx", "cmap", "loca", "glyf"} {
+		if tables[name] == nil {
+			return nil, fmt.Errorf("fontRegistry: missing table %q", name)
+		}
+	}
+
+	font := &Font{
+		glyf: tables["glyf"],
+		loca: tables["loca"],
+		hmtx: tables["hmtx"],
+	}
+	if err := font.parseHead(tables["head"], tables["hhea"], tables["maxp"]); err != nil {
+		return nil, err
+	}
+	font.parseOS2(tables["OS/2"])
+	font.family = parseFamily(tables["name"])
+
+	var err error
+	if font.cmap, err = parseCmap(tables["cmap"]); err != nil {
+		return nil, err
+	}
+	font.kern = parseKern(tables["kern"])
+
+	if font.xHeight == 0 {
+		font.xHeight = font.glyphTop('x')
+	}
+	if font.capHeight == 0 {
+		font.capHeight = font.glyphTop('H')
+	}
+	return font, nil
+}
+
+// GetFamily
+// en: Returns the family name, from the name table
+//
+// pt_br: Retorna o nome da família, da tabela name
+func (el *Font) GetFamily() string {
+	return el.family
+}
+
+// GetWeight
+// en: Returns the weight, from 100 (thin) to 900 (black); 400 is normal
+//
+// pt_br: Retorna o peso, de 100 (fino) a 900 (preto); 400 é normal
+func (el *Font) GetWeight() int {
+	return el.weight
+}
+
+// GetStyle
+// en: Returns the style of the font
+//
+// pt_br: Retorna o estilo da fonte
+func (el *Font) GetStyle() Style {
+	return el.style
+}
+
+// GetUnitsPerEm
+// en: Returns the number of font units in the em square
+//
+// pt_br: Retorna o número de unidades da fonte no quadrado em
+func (el *Font) GetUnitsPerEm() float64 {
+	return el.unitsPerEm
+}
+
+// GetNumGlyphs
+// en: Returns the number of glyphs of the font
+//
+// pt_br: Retorna o número de glifos da fonte
+func (el *Font) GetNumGlyphs() int {
+	return el.numGlyphs
+}
+
+// GlyphIndex
+// en: Returns the glyph of the character; 0, the missing glyph, when the font does
+// not have it
+//
+// pt_br: Retorna o glifo do caractere; 0, o glifo ausente, quando a fonte não o
+// tem
+func (el *Font) GlyphIndex(character rune) uint16 {
+	return el.cmap[character]
+}
+
+// HasGlyph
+// en: Returns true when the font has a glyph for the character
+//
+// pt_br: Retorna true quando a fonte tem um glifo para o caractere
+func (el *Font) HasGlyph(character rune) bool {
+	_, found := el.cmap[character]
+	return found
+}
+
+// Advance
+// en: Returns the advance width of the glyph, in font units
+//
+// pt_br: Retorna a largura de avanço do glifo, em unidades da fonte
+func (el *Font) Advance(glyph uint16) float64 {
+	index := int(glyph)
+	if index >= el.numHMetrics {
+		index = el.numHMetrics - 1
+	}
+	return float64(u16(el.hmtx, 4*index))
+}
+
+// Kerning
+// en: Returns the kerning between two glyphs, in font units, from the kern table.
+// Kerning of the GPOS table is not read
+//
+// pt_br: Retorna o kerning entre dois glifos, em unidades da fonte, da tabela
+// kern. O kerning da tabela GPOS não é lido
+func (el *Font) Kerning(left, right uint16) float64 {
+	return float64(el.kern[uint32(left)<<16|uint32(right)])
+}
+
+// parseHead
+// en: Reads the tables head, hhea and maxp
+//
+// pt_br: Lê as tabelas head, hhea e maxp
+func (el *Font) parseHead(head, hhea, maxp []byte) error {
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return errors.New("fontRegistry: truncated head, hhea or maxp table")
+	}
+	el.unitsPerEm = float64(u16(head, 18))
+	if el.unitsPerEm == 0 {
+		return errors.New("fontRegistry: unitsPerEm is zero")
+	}
+	el.locaLong = i16(head, 50) != 0
+	if u16(head, 44)&0x02 != 0 {
+		el.style = KStyleItalic
+	}
+	el.weight = 400
+	if u16(head, 44)&0x01 != 0 {
+		el.weight = 700
+	}
+
+	el.ascender = float64(i16(hhea, 4))
+	el.descender = float64(i16(hhea, 6))
+	el.lineGap = float64(i16(hhea, 8))
+	el.numHMetrics = int(u16(hhea, 34))
+	el.numGlyphs = int(u16(maxp, 4))
+	if el.numHMetrics == 0 || len(el.hmtx) < 4*el.numHMetrics {
+		return errors.New("fontRegistry: invalid hmtx table")
+	}
+	return nil
+}
+
+// parseOS2
+// en: Reads weight, style, x-height and cap height from the OS/2 table, when
+// present
+//
+// pt_br: Lê peso, estilo, altura x e altura das maiúsculas da tabela OS/2, quando
+// presente
+func (el *Font) parseOS2(table []byte) {
+	if len(table) < 78 {
+		return
+	}
+	if weight := int(u16(table, 4)); weight >= 1 && weight <= 1000 {
+		el.weight = weight
+	}
+	selection := u16(table, 62)
+	switch {
+	case selection&0x0001 != 0:
+		el.style = KStyleItalic
+	case selection&0x0200 != 0:
+		el.style = KStyleOblique
+	}
+	if u16(table, 0) >= 2 && len(table) >= 90 {
+		el.xHeight = float64(i16(table, 86))
+		el.capHeight = float64(i16(table, 88))
+	}
+}
+
+// parseFamily
+// en: Returns the typographic family name (id 16) or the family name (id 1)
+//
+// pt_br: Retorna o nome de família tipográfica (id 16) ou o nome de família (id 1)
+func parseFamily(table []byte) string {
+	count := int(u16(table, 2))
+	storage := int(u16(table, 4))
+	names := make(map[uint16]string)
+	for i := 0; i < count; i += 1 {
+		record := 6 + 12*i
+		platform, language, id := u16(table, record), u16(table, record+4), u16(table, record+6)
+		if id != 1 && id != 16 {
+			continue
+		}
+		value := slice(table, storage+int(u16(table, record+10)), int(u16(table, record+8)))
+		switch {
+		case platform == 3 && (language == 0x0409 || names[id] == ""):
+			units := make([]uint16, len(value)/2)
+			for j := range units {
+				units[j] = u16(value, 2*j)
+			}
+			names[id] = string(utf16.Decode(units))
+		case platform == 1 && names[id] == "":
+			runes := make([]rune, len(value))
+			for j, character := range value {
+				runes[j] = rune(character)
+			}
+			names[id] = string(runes)
+		}
+	}
+	if names[16] != "" {
+		return names[16]
+	}
+	return names[1]
+}
+
+// parseCmap
+// en: Reads the best Unicode subtable of the cmap table; formats 4 and 12 are
+// supported
+//
+// pt_br: Lê a melhor subtabela Unicode da tabela cmap; os formatos 4 e 12 são
+// suportados
+func parseCmap(table []byte) (map[rune]uint16, error) {
+	best, bestScore := -1, 0
+	for i := 0; i < int(u16(table, 2)); i += 1 {
+		record := 4 + 8*i
+		platform, encoding := u16(table, record), u16(table, record+2)
+		offset := int(u32(table, record+4))
+		format := u16(table, offset)
+
+		score := 0
+		switch {
+		case format == 12 && (platform == 3 && encoding == 10 || platform == 0):
+			score = 3
+		case format == 4 && (platform == 3 && encoding == 1 || platform == 0):
+			score = 2
+		case format == 4 && platform == 3 && encoding == 0:
+			score = 1
+		}
+		if score > bestScore {
+			best, bestScore = offset, score
+		}
+	}
+	if best == -1 {
+		return nil, errors.New("fontRegistry: no Unicode cmap subtable of format 4 or 12")
+	}
+
+	mapping := make(map[rune]uint16)
+	if u16(table, best) == 12 {
+		groups := int(u32(table, best+12))
+		for i := 0; i < groups; i += 1 {
+			group := best + 16 + 12*i
+			start, end, glyph := u32(table, group), u32(table, group+4), u32(table, group+8)
+			if end < start || end > 0x10ffff {
+				continue
+			}
+			for character := start; character <= end; character += 1 {
+				mapping[rune(character)] = uint16(glyph + character - start)
+			}
+		}
+		return mapping, nil
+	}
+
+	segments := int(u16(table, best+6)) / 2
+	ends := best + 14
+	starts := ends + 2*segments + 2
+	deltas := starts + 2*segments
+	ranges := deltas + 2*segments
+	for i := 0; i < segments; i += 1 {
+		start, end := u16(table, starts+2*i), u16(table, ends+2*i)
+		delta, rangeOffset := u16(table, deltas+2*i), int(u16(table, ranges+2*i))
+		for character := int(start); character <= int(end) && character != 0xffff; character += 1 {
+			var glyph uint16
+			if rangeOffset == 0 {
+				glyph = uint16(character) + delta
+			} else {
+				glyph = u16(table, ranges+2*i+rangeOffset+2*(character-int(start)))
+				if glyph != 0 {
+					glyph += delta
+				}
+			}
+			if glyph != 0 {
+				mapping[rune(character)] = glyph
+			}
+		}
+	}
+	return mapping, nil
+}
+
+// parseKern
+// en: Reads the horizontal pairs of format 0 of the kern table
+//
+// pt_br: Lê os pares horizontais do formato 0 da tabela kern
+func parseKern(table []byte) map[uint32]int16 {
+	pairs := make(map[uint32]int16)
+	if u16(table, 0) != 0 {
+		return pairs
+	}
+	offset := 4
+	for i := 0; i < int(u16(table, 2)); i += 1 {
+		length, coverage := int(u16(table, offset+2)), u16(table, offset+4)
+		if coverage>>8 == 0 && coverage&0x01 != 0 && coverage&0x04 == 0 {
+			count := int(u16(table, offset+6))
+			for j := 0; j < count; j += 1 {
+				pair := offset + 14 + 6*j
+				pairs[u32(table, pair)] += i16(table, pair+4)
+			}
+		}
+		if length == 0 {
+			break
+		}
+		offset += length
+	}
+	return pairs
+}
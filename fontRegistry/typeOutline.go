@@ -0,0 +1,317 @@
+package fontRegistry
+
+import "math"
+
+// KMaxCompositeDepth
+// en: Maximum depth of composite glyphs, protects against recursive fonts
+//
+// pt_br: Profundidade máxima de glifos compostos, protege contra fontes recursivas
+const KMaxCompositeDepth = 8
+
+// Point
+// en: Point of a glyph contour, in font units with y up
+//
+// pt_br: Ponto de um contorno de glifo, em unidades da fonte com y para cima
+type Point struct {
+	X       float64
+	Y       float64
+	OnCurve bool
+}
+
+// Outline
+// en: Contours of a glyph, as quadratic B-splines of TrueType
+//
+// pt_br: Contornos de um glifo, como B-splines quadráticas do TrueType
+type Outline [][]Point
+
+// Pather
+// en: Receives the path of an outline; implemented by Rasterizer and compatible
+// with the path methods of IDraw after a conversion of types
+//
+// pt_br: Recebe o caminho de um contorno; implementado por Rasterizer e compatível
+// com os métodos de caminho de IDraw após uma conversão de tipos
+type Pather interface {
+	MoveTo(x, y float64)
+	LineTo(x, y float64)
+	QuadTo(controlX, controlY, x, y float64)
+	ClosePath()
+}
+
+// Outline
+// en: Returns the contours of the glyph; composite glyphs are expanded
+//
+// pt_br: Retorna os contornos do glifo; glifos compostos são expandidos
+func (el *Font) Outline(glyph uint16) Outline {
+	return el.outline(glyph, 0)
+}
+
+// Bounds
+// en: Returns the bounding box of the outline, in font units; all zero for an
+// empty outline
+//
+// pt_br: Retorna o retângulo envolvente do contorno, em unidades da fonte; tudo
+// zero para um contorno vazio
+func (el Outline) Bounds() (minX, minY, maxX, maxY float64) {
+	minX, minY = math.Inf(1), math.Inf(1)
+	maxX, maxY = math.Inf(-1), math.Inf(-1)
+	for _, contour := range el {
+		for _, point := range contour {
+			minX, maxX = math.Min(minX, point.X), math.Max(maxX, point.X)
+			minY, maxY = math.Min(minY, point.Y), math.Max(maxY, point.Y)
+		}
+	}
+	if math.IsInf(minX, 1) {
+		return 0, 0, 0, 0
+	}
+	return
+}
+
+// Path
+// en: Sends the contours to the pather, converting each point with transform
+//
+//	transform: Converts a point in font units to the coordinates of the pather
+//
+// pt_br: Envia os contornos para o pather, convertendo cada ponto com transform
+//
+//	transform: Converte um ponto em unidades da fonte para as coordenadas do
+//	           pather
+func (el Outline) Path(pather Pather, transform func(x, y float64) (float64, float64)) {
+	for _, contour := range el {
+		if len(contour) == 0 {
+			continue
+		}
+
+		// en: the contour starts on an on curve point; when there is none, at the
+		// middle of the first two off curve points
+		// pt_br: o contorno começa em um ponto sobre a curva; quando não há nenhum,
+		// no meio dos dois primeiros pontos fora da curva
+		first := 0
+		for first < len(contour) && !contour[first].OnCurve {
+			first += 1
+		}
+		sequence := make([]Point, 0, len(contour)+1)
+		var start Point
+		if first == len(contour) {
+			next := contour[1%len(contour)]
+			start = Point{X: (contour[0].X + next.X) / 2, Y: (contour[0].Y + next.Y) / 2, OnCurve: true}
+			sequence = append(append(sequence, contour[1:]...), contour[0])
+		} else {
+			start = contour[first]
+			sequence = append(append(sequence, contour[first+1:]...), contour[:first]...)
+		}
+		sequence = append(sequence, start)
+		pather.MoveTo(transform(start.X, start.Y))
+
+		var control Point
+		hasControl := false
+		for _, point := range sequence {
+			if point.OnCurve {
+				if hasControl {
+					controlX, controlY := transform(control.X, control.Y)
+					x, y := transform(point.X, point.Y)
+					pather.QuadTo(controlX, controlY, x, y)
+				} else {
+					pather.LineTo(transform(point.X, point.Y))
+				}
+				hasControl = false
+				continue
+			}
+			if hasControl {
+				middleX, middleY := transform((control.X+point.X)/2, (control.Y+point.Y)/2)
+				controlX, controlY := transform(control.X, control.Y)
+				pather.QuadTo(controlX, controlY, middleX, middleY)
+			}
+			control, hasControl = point, true
+		}
+		pather.ClosePath()
+	}
+}
+
+// glyphData
+// en: Returns the data of the glyph in the glyf table, or nil for an empty glyph
+//
+// pt_br: Retorna os dados do glifo na tabela glyf, ou nil para um glifo vazio
+func (el *Font) glyphData(glyph uint16) []byte {
+	if int(glyph) >= el.numGlyphs {
+		return nil
+	}
+	var start, end int
+	if el.locaLong {
+		start, end = int(u32(el.loca, 4*int(glyph))), int(u32(el.loca, 4*int(glyph)+4))
+	} else {
+		start, end = 2*int(u16(el.loca, 2*int(glyph))), 2*int(u16(el.loca, 2*int(glyph)+2))
+	}
+	if end <= start {
+		return nil
+	}
+	return slice(el.glyf, start, end-start)
+}
+
+func (el *Font) outline(glyph uint16, depth int) Outline {
+	data := el.glyphData(glyph)
+	if len(data) < 10 || depth > KMaxCompositeDepth {
+		return nil
+	}
+	contours := int(i16(data, 0))
+	if contours < 0 {
+		return el.composite(data, depth)
+	}
+
+	ends := make([]int, contours)
+	for i := range ends {
+		ends[i] = int(u16(data, 10+2*i))
+	}
+	if contours == 0 {
+		return nil
+	}
+	count := ends[contours-1] + 1
+	offset := 10 + 2*contours
+	offset += 2 + int(u16(data, offset))
+
+	flags := make([]uint8, 0, count)
+	for len(flags) < count && offset < len(data) {
+		flag := u8(data, offset)
+		offset += 1
+		flags = append(flags, flag)
+		if flag&0x08 != 0 {
+			repeat := int(u8(data, offset))
+			offset += 1
+			for j := 0; j < repeat && len(flags) < count; j += 1 {
+				flags = append(flags, flag)
+			}
+		}
+	}
+	if len(flags) < count {
+		return nil
+	}
+
+	points := make([]Point, count)
+	value := 0
+	for i, flag := range flags {
+		switch {
+		case flag&0x02 != 0:
+			delta := int(u8(data, offset))
+			offset += 1
+			if flag&0x10 == 0 {
+				delta = -delta
+			}
+			value += delta
+		case flag&0x10 == 0:
+			value += int(i16(data, offset))
+			offset += 2
+		}
+		points[i].X = float64(value)
+		points[i].OnCurve = flag&0x01 != 0
+	}
+	value = 0
+	for i, flag := range flags {
+		switch {
+		case flag&0x04 != 0:
+			delta := int(u8(data, offset))
+			offset += 1
+			if flag&0x20 == 0 {
+				delta = -delta
+			}
+			value += delta
+		case flag&0x20 == 0:
+			value += int(i16(data, offset))
+			offset += 2
+		}
+		points[i].Y = float64(value)
+	}
+
+	outline := make(Outline, 0, contours)
+	start := 0
+	for _, end := range ends {
+		if end < start || end >= count {
+			return nil
+		}
+		outline = append(outline, points[start:end+1])
+		start = end + 1
+	}
+	return outline
+}
+
+// composite
+// en: Expands a composite glyph. Components positioned by matching points, instead
+// of offsets, are placed at the origin
+//
+// pt_br: Expande um glifo composto. Componentes posicionados por pontos
+// correspondentes, em vez de deslocamentos, são colocados na origem
+func (el *Font) composite(data []byte, depth int) Outline {
+	const (
+		kArgsAreWords    = 0x0001
+		kArgsAreXY       = 0x0002
+		kScale           = 0x0008
+		kMoreComponents  = 0x0020
+		kXYScale         = 0x0040
+		kTwoByTwo        = 0x0080
+		kScaledOffset    = 0x0800
+		kUnscaledOffsets = 0x1000
+	)
+
+	outline := make(Outline, 0)
+	offset := 10
+	for {
+		flags, component := u16(data, offset), u16(data, offset+2)
+		offset += 4
+
+		var dx, dy float64
+		if flags&kArgsAreWords != 0 {
+			dx, dy = float64(i16(data, offset)), float64(i16(data, offset+2))
+			offset += 4
+		} else {
+			dx, dy = float64(int8(u8(data, offset))), float64(int8(u8(data, offset+1)))
+			offset += 2
+		}
+		if flags&kArgsAreXY == 0 {
+			dx, dy = 0, 0
+		}
+
+		a, b, c, d := 1.0, 0.0, 0.0, 1.0
+		switch {
+		case flags&kScale != 0:
+			a = f2dot14(data, offset)
+			d = a
+			offset += 2
+		case flags&kXYScale != 0:
+			a, d = f2dot14(data, offset), f2dot14(data, offset+2)
+			offset += 4
+		case flags&kTwoByTwo != 0:
+			a, b = f2dot14(data, offset), f2dot14(data, offset+2)
+			c, d = f2dot14(data, offset+4), f2dot14(data, offset+6)
+			offset += 8
+		}
+		if flags&kScaledOffset != 0 && flags&kUnscaledOffsets == 0 {
+			dx, dy = a*dx+c*dy, b*dx+d*dy
+		}
+
+		for _, contour := range el.outline(component, depth+1) {
+			transformed := make([]Point, len(contour))
+			for i, point := range contour {
+				transformed[i] = Point{
+					X:       a*point.X + c*point.Y + dx,
+					Y:       b*point.X + d*point.Y + dy,
+					OnCurve: point.OnCurve,
+				}
+			}
+			outline = append(outline, transformed)
+		}
+
+		if flags&kMoreComponents == 0 || offset >= len(data) {
+			break
+		}
+	}
+	return outline
+}
+
+// glyphTop
+// en: Returns the top of the glyph of the character, used when the OS/2 table does
+// not inform x-height or cap height
+//
+// pt_br: Retorna o topo do glifo do caractere, usado quando a tabela OS/2 não
+// informa altura x ou altura das maiúsculas
+func (el *Font) glyphTop(character rune) float64 {
+	_, _, _, top := el.Outline(el.GlyphIndex(character)).Bounds()
+	return top
+}
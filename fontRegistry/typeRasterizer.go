@@ -0,0 +1,239 @@
+package fontRegistry
+
+import (
+	"image"
+	"math"
+)
+
+// KFlatness
+// en: Maximum distance, in pixels, between a curve and the lines that replace it
+//
+// pt_br: Distância máxima, em pixels, entre uma curva e as linhas que a substituem
+const KFlatness = 0.1
+
+// Rasterizer
+// en: Anti-aliased scanline rasterizer. Each line adds its signed area to an
+// accumulation buffer and the coverage of a pixel is the absolute value of the
+// running sum of the row, limited to 1. This is exact for the non-zero rule of
+// fonts as long as the contours do not overlap with the same direction
+//
+// pt_br: Rasterizador de linhas de varredura com anti-aliasing. Cada linha soma a
+// sua área com sinal em um buffer de acumulação e a cobertura de um pixel é o
+// valor absoluto da soma acumulada da linha, limitada a 1. Isto é exato para a
+// regra non-zero das fontes desde que os contornos não se sobreponham com a mesma
+// direção
+type Rasterizer struct {
+	width        int
+	height       int
+	stride       int
+	accumulation []float64
+
+	startX, startY float64
+	penX, penY     float64
+}
+
+// NewRasterizer
+// en: Returns a rasterizer for a mask with the size, in pixels
+//
+// pt_br: Retorna um rasterizador para uma máscara com o tamanho, em pixels
+func NewRasterizer(width, height int) *Rasterizer {
+	rasterizer := &Rasterizer{}
+	rasterizer.Reset(width, height)
+	return rasterizer
+}
+
+// Reset
+// en: Clears the rasterizer and changes its size, reusing the memory when possible
+//
+// pt_br: Limpa o rasterizador e muda o seu tamanho, reutilizando a memória quando
+// possível
+func (el *Rasterizer) Reset(width, height int) {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	el.width, el.height, el.stride = width, height, width+2
+	size := el.stride * height
+	if cap(el.accumulation) < size {
+		el.accumulation = make([]float64, size)
+	} else {
+		el.accumulation = el.accumulation[:size]
+		for i := range el.accumulation {
+			el.accumulation[i] = 0
+		}
+	}
+}
+
+// MoveTo
+// en: Starts a new contour, closing the previous one
+//
+// pt_br: Começa um novo contorno, fechando o anterior
+func (el *Rasterizer) MoveTo(x, y float64) {
+	el.ClosePath()
+	el.startX, el.startY = x, y
+	el.penX, el.penY = x, y
+}
+
+// LineTo
+// en: Adds a line from the pen to the point
+//
+// pt_br: Adiciona uma linha da caneta até o ponto
+func (el *Rasterizer) LineTo(x, y float64) {
+	el.line(el.penX, el.penY, x, y)
+	el.penX, el.penY = x, y
+}
+
+// QuadTo
+// en: Adds a quadratic Bézier curve from the pen to the point
+//
+// pt_br: Adiciona uma curva de Bézier quadrática da caneta até o ponto
+func (el *Rasterizer) QuadTo(controlX, controlY, x, y float64) {
+	deviation := math.Hypot(el.penX-2*controlX+x, el.penY-2*controlY+y)
+	segments := int(math.Ceil(math.Sqrt(deviation / (8 * KFlatness))))
+	if segments < 1 {
+		segments = 1
+	}
+	startX, startY := el.penX, el.penY
+	for i := 1; i <= segments; i += 1 {
+		t := float64(i) / float64(segments)
+		u := 1 - t
+		el.LineTo(u*u*startX+2*u*t*controlX+t*t*x, u*u*startY+2*u*t*controlY+t*t*y)
+	}
+}
+
+// CubeTo
+// en: Adds a cubic Bézier curve from the pen to the point
+//
+// pt_br: Adiciona uma curva de Bézier cúbica da caneta até o ponto
+func (el *Rasterizer) CubeTo(control1X, control1Y, control2X, control2Y, x, y float64) {
+	deviation := math.Max(
+		math.Hypot(el.penX-2*control1X+control2X, el.penY-2*control1Y+control2Y),
+		math.Hypot(control1X-2*control2X+x, control1Y-2*control2Y+y),
+	)
+	segments := int(math.Ceil(math.Sqrt(deviation * 3 / (4 * KFlatness))))
+	if segments < 1 {
+		segments = 1
+	}
+	startX, startY := el.penX, el.penY
+	for i := 1; i <= segments; i += 1 {
+		t := float64(i) / float64(segments)
+		u := 1 - t
+		el.LineTo(
+			u*u*u*startX+3*u*u*t*control1X+3*u*t*t*control2X+t*t*t*x,
+			u*u*u*startY+3*u*u*t*control1Y+3*u*t*t*control2Y+t*t*t*y,
+		)
+	}
+}
+
+// ClosePath
+// en: Closes the contour with a line back to its start
+//
+// pt_br: Fecha o contorno com uma linha de volta ao seu início
+func (el *Rasterizer) ClosePath() {
+	if el.penX != el.startX || el.penY != el.startY {
+		el.LineTo(el.startX, el.startY)
+	}
+}
+
+// Mask
+// en: Closes the current contour and returns the coverage of each pixel
+//
+// pt_br: Fecha o contorno atual e retorna a cobertura de cada pixel
+func (el *Rasterizer) Mask() *image.Alpha {
+	el.ClosePath()
+	mask := image.NewAlpha(image.Rect(0, 0, el.width, el.height))
+	for y := 0; y < el.height; y += 1 {
+		sum := 0.0
+		row := el.accumulation[y*el.stride : (y+1)*el.stride]
+		for x := 0; x < el.width; x += 1 {
+			sum += row[x]
+			coverage := math.Min(1, math.Abs(sum))
+			mask.Pix[y*mask.Stride+x] = uint8(coverage*255 + 0.5)
+		}
+	}
+	return mask
+}
+
+// line
+// en: Adds the signed area of the line to the accumulation buffer, row by row
+//
+// pt_br: Soma a área com sinal da linha ao buffer de acumulação, linha por linha
+func (el *Rasterizer) line(x0, y0, x1, y1 float64) {
+	if y0 == y1 {
+		return
+	}
+
+	// en: a line crossing a vertical border is split there, so the part outside can
+	// be clamped to the border without changing the part inside
+	// pt_br: uma linha que cruza uma borda vertical é dividida ali, para que a parte
+	// de fora possa ser limitada à borda sem alterar a parte de dentro
+	for _, border := range []float64{0, float64(el.width)} {
+		if (x0 < border && x1 > border) || (x0 > border && x1 < border) {
+			y := y0 + (y1-y0)*(border-x0)/(x1-x0)
+			el.line(x0, y0, border, y)
+			el.line(border, y, x1, y1)
+			return
+		}
+	}
+
+	direction := 1.0
+	if y0 > y1 {
+		direction = -1
+		x0, y0, x1, y1 = x1, y1, x0, y0
+	}
+	limit := float64(el.width)
+	x0, x1 = math.Max(0, math.Min(limit, x0)), math.Max(0, math.Min(limit, x1))
+
+	slope := (x1 - x0) / (y1 - y0)
+	x := x0
+	if y0 < 0 {
+		x -= y0 * slope
+	}
+	first := int(math.Max(0, math.Floor(y0)))
+	last := int(math.Min(float64(el.height), math.Ceil(y1)))
+
+	for y := first; y < last; y += 1 {
+		row := el.accumulation[y*el.stride : (y+1)*el.stride]
+		dy := math.Min(float64(y+1), y1) - math.Max(float64(y), y0)
+		next := x + slope*dy
+		delta := dy * direction
+
+		left, right := x, next
+		if left > right {
+			left, right = right, left
+		}
+		leftFloor := math.Floor(left)
+		leftIndex := int(leftFloor)
+		rightCeil := math.Ceil(right)
+		rightIndex := int(rightCeil)
+
+		if rightIndex <= leftIndex+1 {
+			middle := 0.5*(x+next) - leftFloor
+			row[leftIndex] += delta - delta*middle
+			row[leftIndex+1] += delta * middle
+		} else {
+			inverse := 1 / (right - left)
+			leftFraction := left - leftFloor
+			areaLeft := 0.5 * inverse * (1 - leftFraction) * (1 - leftFraction)
+			rightFraction := right - rightCeil + 1
+			areaRight := 0.5 * inverse * rightFraction * rightFraction
+
+			row[leftIndex] += delta * areaLeft
+			if rightIndex == leftIndex+2 {
+				row[leftIndex+1] += delta * (1 - areaLeft - areaRight)
+			} else {
+				area := inverse * (1.5 - leftFraction)
+				row[leftIndex+1] += delta * (area - areaLeft)
+				for index := leftIndex + 2; index < rightIndex-1; index += 1 {
+					row[index] += delta * inverse
+				}
+				areaLast := area + float64(rightIndex-leftIndex-3)*inverse
+				row[rightIndex-1] += delta * (1 - areaLast - areaRight)
+			}
+			row[rightIndex] += delta * areaRight
+		}
+		x = next
+	}
+}
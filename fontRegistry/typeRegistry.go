@@ -0,0 +1,429 @@
+package fontRegistry
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// ErrNoFonts
+// en: Returned by Match() when the registry is empty
+//
+// pt_br: Retornado por Match() quando o registro está vazio
+var ErrNoFonts = errors.New("fontRegistry: no fonts loaded")
+
+// KGenericFamilies
+// en: Generic CSS families, resolved by Registry.SetGeneric() or by the usual
+// families of each one
+//
+// pt_br: Famílias CSS genéricas, resolvidas por Registry.SetGeneric() ou pelas
+// famílias usuais de cada uma
+var KGenericFamilies = []string{"serif", "sans-serif", "monospace", "cursive", "fantasy", "system-ui"}
+
+type faceKey struct {
+	font   *Font
+	size   float64
+	bold   bool
+	italic bool
+}
+
+// Registry
+// en: Collection of fonts with the font matching of CSS. Load the fonts with
+// LoadFile(), LoadDir() or LoadFS() (which accepts an embed.FS), then use Query(),
+// FaceFor() or Face() to obtain faces for FillText(), StrokeText() and
+// MeasureText() in headless backends
+//
+// pt_br: Coleção de fontes com a seleção de fontes do CSS. Carregue as fontes com
+// LoadFile(), LoadDir() ou LoadFS() (que aceita um embed.FS), depois use Query(),
+// FaceFor() ou Face() para obter faces para FillText(), StrokeText() e
+// MeasureText() em backends sem navegador
+type Registry struct {
+	fonts   []*Font
+	generic map[string]string
+	hinting Hinting
+	faces   map[faceKey]*Face
+}
+
+// NewRegistry
+// en: Returns an empty registry with vertical hinting
+//
+// pt_br: Retorna um registro vazio com hinting vertical
+func NewRegistry() *Registry {
+	return &Registry{
+		generic: make(map[string]string),
+		hinting: KHintingVertical,
+		faces:   make(map[faceKey]*Face),
+	}
+}
+
+// SetHinting
+// en: Defines the hinting of the faces created from now on
+//
+// pt_br: Define o hinting das faces criadas a partir de agora
+func (el *Registry) SetHinting(hinting Hinting) {
+	el.hinting = hinting
+	el.faces = make(map[faceKey]*Face)
+}
+
+// SetGeneric
+// en: Defines the family used for a generic family, like sans-serif
+//
+// pt_br: Define a família usada para uma família genérica, como sans-serif
+func (el *Registry) SetGeneric(generic, family string) {
+	el.generic[strings.ToLower(generic)] = family
+}
+
+// Add
+// en: Adds a parsed font
+//
+// pt_br: Adiciona uma fonte interpretada
+func (el *Registry) Add(font *Font) {
+	el.fonts = append(el.fonts, font)
+}
+
+// AddData
+// en: Parses and adds the fonts of a .ttf, .otf or .ttc file
+//
+// pt_br: Interpreta e adiciona as fontes de um arquivo .ttf, .otf ou .ttc
+func (el *Registry) AddData(data []byte) error {
+	count := 1
+	if string(slice(data, 0, 4)) == "ttcf" {
+		count = int(u32(data, 8))
+	}
+	for index := 0; index < count; index += 1 {
+		font, err := ParseIndex(data, index)
+		if err != nil {
+			return err
+		}
+		el.Add(font)
+	}
+	return nil
+}
+
+// LoadFile
+// en: Loads the fonts of a file on disk
+//
+// pt_br: Carrega as fontes de um arquivo no disco
+func (el *Registry) LoadFile(name string) error {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	if err = el.AddData(data); err != nil {
+		return fmt.Errorf("%v: %w", name, err)
+	}
+	return nil
+}
+
+// LoadDir
+// en: Loads every .ttf, .otf and .ttc file of a directory and its subdirectories
+//
+// pt_br: Carrega todos os arquivos .ttf, .otf e .ttc de um diretório e dos seus
+// subdiretórios
+func (el *Registry) LoadDir(directory string) error {
+	return el.LoadFS(os.DirFS(directory))
+}
+
+// LoadFS
+// en: Loads every .ttf, .otf and .ttc file of a file system, like an embed.FS.
+// Files that fail do not stop the loading of the others; the first error is
+// returned at the end
+//
+//	patterns: Optional patterns of fs.Glob(), like "fonts/*.ttf"; without
+//	          patterns the whole file system is searched
+//
+// pt_br: Carrega todos os arquivos .ttf, .otf e .ttc de um sistema de arquivos,
+// como um embed.FS. Arquivos que falham não interrompem o carregamento dos outros;
+// o primeiro erro é retornado no final
+//
+//	patterns: Padrões opcionais de fs.Glob(), como "fonts/*.ttf"; sem padrões
+//	          todo o sistema de arquivos é pesquisado
+func (el *Registry) LoadFS(fileSystem fs.FS, patterns ...string) (err error) {
+	names := make([]string, 0)
+	if len(patterns) == 0 {
+		err = fs.WalkDir(fileSystem, ".", func(name string, entry fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return walkErr
+			}
+			switch strings.ToLower(path.Ext(name)) {
+			case ".ttf", ".otf", ".ttc":
+				if !entry.IsDir() {
+					names = append(names, name)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, pattern := range patterns {
+		var matches []string
+		if matches, err = fs.Glob(fileSystem, pattern); err != nil {
+			return err
+		}
+		names = append(names, matches...)
+	}
+
+	var first error
+	for _, name := range names {
+		data, readErr := fs.ReadFile(fileSystem, name)
+		if readErr == nil {
+			readErr = el.AddData(data)
+		}
+		if readErr != nil && first == nil {
+			first = fmt.Errorf("%v: %w", name, readErr)
+		}
+	}
+	return first
+}
+
+// GetFamilies
+// en: Returns the names of the families loaded, in alphabetical order
+//
+// pt_br: Retorna os nomes das famílias carregadas, em ordem alfabética
+func (el *Registry) GetFamilies() []string {
+	unique := make(map[string]bool)
+	for _, font := range el.fonts {
+		unique[font.GetFamily()] = true
+	}
+	families := make([]string, 0, len(unique))
+	for family := range unique {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	return families
+}
+
+// Match
+// en: Returns the font that best matches the description, following the font
+// matching of CSS: the first family of the list with fonts, then the closest
+// style and weight among all the fonts of that family. Generic families are
+// resolved by resolveGeneric(). When no family is found, the generic sans-serif
+// is used and then the family of the first font loaded
+//
+// pt_br: Retorna a fonte que melhor corresponde à descrição, seguindo a seleção de
+// fontes do CSS: a primeira família da lista com fontes, depois o estilo e o peso
+// mais próximos entre todas as fontes daquela família. Famílias genéricas são
+// resolvidas por resolveGeneric(). Quando nenhuma família é encontrada, a genérica
+// sans-serif é usada e depois a família da primeira fonte carregada
+func (el *Registry) Match(description Description) (*Font, error) {
+	if len(el.fonts) == 0 {
+		return nil, ErrNoFonts
+	}
+
+	families := append(append([]string{}, description.Families...), "sans-serif")
+	for _, family := range families {
+		if el.isGeneric(family) {
+			family = el.resolveGeneric(family)
+		}
+		if candidates := el.familyFonts(family); len(candidates) != 0 {
+			return bestMatch(candidates, description), nil
+		}
+	}
+	return bestMatch(el.familyFonts(el.fonts[0].GetFamily()), description), nil
+}
+
+// kGenericDefaults
+// en: Families tried, in order, for each generic family not defined by SetGeneric()
+//
+// pt_br: Famílias tentadas, em ordem, para cada família genérica não definida por
+// SetGeneric()
+var kGenericDefaults = map[string][]string{
+	"serif":      {"DejaVu Serif", "Liberation Serif", "Noto Serif", "Times New Roman", "Times", "Georgia"},
+	"sans-serif": {"DejaVu Sans", "Liberation Sans", "Noto Sans", "Arial", "Helvetica", "Roboto"},
+	"monospace":  {"DejaVu Sans Mono", "Liberation Mono", "Noto Sans Mono", "Courier New", "Consolas", "Menlo"},
+	"cursive":    {"Comic Sans MS", "Comic Neue", "URW Chancery L", "Apple Chancery"},
+	"fantasy":    {"Impact", "Papyrus", "Luminari"},
+	"system-ui":  {"Segoe UI", "Roboto", "Ubuntu", "Cantarell", "Noto Sans", "DejaVu Sans"},
+}
+
+// isGeneric
+// en: Returns true for the names of KGenericFamilies and of SetGeneric()
+//
+// pt_br: Retorna true para os nomes de KGenericFamilies e de SetGeneric()
+func (el *Registry) isGeneric(family string) bool {
+	family = strings.ToLower(family)
+	if _, found := el.generic[family]; found {
+		return true
+	}
+	_, found := kGenericDefaults[family]
+	return found
+}
+
+// resolveGeneric
+// en: Returns the family of a generic family: the one defined by SetGeneric(), the
+// first of kGenericDefaults that is loaded or, at last, the first family loaded
+// whose name suggests the generic family, like "Mono" for monospace
+//
+// pt_br: Retorna a família de uma família genérica: a definida por SetGeneric(), a
+// primeira de kGenericDefaults que está carregada ou, por último, a primeira família
+// carregada cujo nome sugere a família genérica, como "Mono" para monospace
+func (el *Registry) resolveGeneric(generic string) string {
+	generic = strings.ToLower(generic)
+	if family, found := el.generic[generic]; found {
+		return family
+	}
+	for _, family := range kGenericDefaults[generic] {
+		if len(el.familyFonts(family)) != 0 {
+			return family
+		}
+	}
+	for _, family := range el.GetFamilies() {
+		if inferGeneric(family) == generic {
+			return family
+		}
+	}
+	return generic
+}
+
+// inferGeneric
+// en: Returns the generic family suggested by the name of a family
+//
+// pt_br: Retorna a família genérica sugerida pelo nome de uma família
+func inferGeneric(family string) string {
+	name := strings.ToLower(family)
+	switch {
+	case strings.Contains(name, "mono") || strings.Contains(name, "code") || strings.Contains(name, "courier"):
+		return "monospace"
+	case strings.Contains(name, "serif") && !strings.Contains(name, "sans"):
+		return "serif"
+	}
+	return "sans-serif"
+}
+
+// familyFonts
+// en: Returns all the fonts of a family
+//
+// pt_br: Retorna todas as fontes de uma família
+func (el *Registry) familyFonts(family string) []*Font {
+	candidates := make([]*Font, 0)
+	for _, font := range el.fonts {
+		if strings.EqualFold(font.GetFamily(), family) {
+			candidates = append(candidates, font)
+		}
+	}
+	return candidates
+}
+
+// Face
+// en: Returns a face for the description. Synthetic bold and italic are enabled
+// when the matched font is lighter than 600 for a bold request or is normal for
+// an italic request. Faces are cached
+//
+// pt_br: Retorna uma face para a descrição. Negrito e itálico sintéticos são
+// habilitados quando a fonte encontrada é mais leve do que 600 para um pedido de
+// negrito ou é normal para um pedido de itálico. Faces ficam em cache
+func (el *Registry) Face(description Description) (*Face, error) {
+	font, err := el.Match(description)
+	if err != nil {
+		return nil, err
+	}
+	key := faceKey{
+		font:   font,
+		size:   description.Size,
+		bold:   description.Weight >= 600 && font.GetWeight() < 600,
+		italic: description.Style != KStyleNormal && font.GetStyle() == KStyleNormal,
+	}
+	if face, found := el.faces[key]; found {
+		return face, nil
+	}
+	face := NewFace(font, description.Size, el.hinting)
+	face.SetSynthetic(key.bold, key.italic)
+	el.faces[key] = face
+	return face, nil
+}
+
+// Query
+// en: Returns a face for a CSS font shorthand, like "bold 14px Roboto, sans-serif"
+//
+// pt_br: Retorna uma face para um atalho CSS font, como "bold 14px Roboto,
+// sans-serif"
+func (el *Registry) Query(css string) (*Face, error) {
+	description, err := ParseCSS(css)
+	if err != nil {
+		return nil, err
+	}
+	return el.Face(description)
+}
+
+// FaceFor
+// en: Returns a face for the font.Font passed to IDraw.Font()
+//
+// pt_br: Retorna uma face para o font.Font passado para IDraw.Font()
+func (el *Registry) FaceFor(value font.Font) (*Face, error) {
+	return el.Face(FromFont(value))
+}
+
+// bestMatch
+// en: Selects the style first and the weight after, as CSS Fonts level 4
+//
+// pt_br: Seleciona o estilo primeiro e o peso depois, como o CSS Fonts nível 4
+func bestMatch(candidates []*Font, description Description) *Font {
+	order := map[Style][]Style{
+		KStyleNormal:  {KStyleNormal, KStyleOblique, KStyleItalic},
+		KStyleItalic:  {KStyleItalic, KStyleOblique, KStyleNormal},
+		KStyleOblique: {KStyleOblique, KStyleItalic, KStyleNormal},
+	}[description.Style]
+	for _, style := range order {
+		sameStyle := make([]*Font, 0)
+		for _, font := range candidates {
+			if font.GetStyle() == style {
+				sameStyle = append(sameStyle, font)
+			}
+		}
+		if len(sameStyle) != 0 {
+			candidates = sameStyle
+			break
+		}
+	}
+
+	best := candidates[0]
+	bestScore := math.Inf(1)
+	for _, font := range candidates {
+		if score := weightDistance(description.Weight, font.GetWeight()); score < bestScore {
+			best, bestScore = font, score
+		}
+	}
+	return best
+}
+
+// weightDistance
+// en: Returns a score, smaller is better, that reproduces the order of weights of
+// CSS: for 400 to 500, heavier weights up to 500 first, then lighter, then the
+// heavier ones; below 400 lighter first; above 500 heavier first
+//
+// pt_br: Retorna uma pontuação, menor é melhor, que reproduz a ordem de pesos do
+// CSS: para 400 a 500, pesos maiores até 500 primeiro, depois menores, depois os
+// maiores; abaixo de 400 menores primeiro; acima de 500 maiores primeiro
+func weightDistance(desired, available int) float64 {
+	difference := float64(available - desired)
+	switch {
+	case desired >= 400 && desired <= 500:
+		switch {
+		case available >= desired && available <= 500:
+			return difference
+		case available < desired:
+			return 1000 - difference
+		default:
+			return 2000 + difference
+		}
+	case desired < 400:
+		if available <= desired {
+			return -difference
+		}
+		return 1000 + difference
+	default:
+		if available >= desired {
+			return difference
+		}
+		return 1000 - difference
+	}
+}
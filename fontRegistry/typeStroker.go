@@ -0,0 +1,102 @@
+package fontRegistry
+
+import "math"
+
+// KJoinSegments
+// en: Number of sides of the polygon used for round joins
+//
+// pt_br: Número de lados do polígono usado nas junções arredondadas
+const KJoinSegments = 16
+
+// polylines
+// en: Pather that flattens the curves and keeps the contours as closed polylines,
+// used to stroke text
+//
+// pt_br: Pather que achata as curvas e guarda os contornos como polilinhas
+// fechadas, usado para contornar texto
+type polylines struct {
+	contours [][][2]float64
+}
+
+func (el *polylines) MoveTo(x, y float64) {
+	el.contours = append(el.contours, [][2]float64{{x, y}})
+}
+
+func (el *polylines) LineTo(x, y float64) {
+	last := len(el.contours) - 1
+	el.contours[last] = append(el.contours[last], [2]float64{x, y})
+}
+
+func (el *polylines) QuadTo(controlX, controlY, x, y float64) {
+	last := len(el.contours) - 1
+	start := el.contours[last][len(el.contours[last])-1]
+	deviation := math.Hypot(start[0]-2*controlX+x, start[1]-2*controlY+y)
+	segments := int(math.Ceil(math.Sqrt(deviation / (8 * KFlatness))))
+	if segments < 1 {
+		segments = 1
+	}
+	for i := 1; i <= segments; i += 1 {
+		t := float64(i) / float64(segments)
+		u := 1 - t
+		el.LineTo(u*u*start[0]+2*u*t*controlX+t*t*x, u*u*start[1]+2*u*t*controlY+t*t*y)
+	}
+}
+
+func (el *polylines) ClosePath() {}
+
+// stroke
+// en: Adds to the rasterizer a rectangle for each segment and a circle for each
+// vertex, all with the same direction, so the union is covered once
+//
+// pt_br: Adiciona ao rasterizador um retângulo para cada segmento e um círculo
+// para cada vértice, todos com a mesma direção, assim a união é coberta uma vez
+func (el *polylines) stroke(rasterizer *Rasterizer, lineWidth float64) {
+	half := lineWidth / 2
+	for _, contour := range el.contours {
+		for i := range contour {
+			start, end := contour[i], contour[(i+1)%len(contour)]
+			length := math.Hypot(end[0]-start[0], end[1]-start[1])
+			if length > 0 {
+				normalX := -(end[1] - start[1]) / length * half
+				normalY := (end[0] - start[0]) / length * half
+				polygon(rasterizer, [][2]float64{
+					{start[0] + normalX, start[1] + normalY},
+					{end[0] + normalX, end[1] + normalY},
+					{end[0] - normalX, end[1] - normalY},
+					{start[0] - normalX, start[1] - normalY},
+				})
+			}
+
+			circle := make([][2]float64, KJoinSegments)
+			for j := range circle {
+				angle := 2 * math.Pi * float64(j) / KJoinSegments
+				circle[j] = [2]float64{start[0] + half*math.Cos(angle), start[1] + half*math.Sin(angle)}
+			}
+			polygon(rasterizer, circle)
+		}
+	}
+}
+
+// polygon
+// en: Adds a closed polygon with positive area to the rasterizer, reversing the
+// points when needed
+//
+// pt_br: Adiciona um polígono fechado com área positiva ao rasterizador,
+// invertendo os pontos quando necessário
+func polygon(rasterizer *Rasterizer, points [][2]float64) {
+	area := 0.0
+	for i := range points {
+		next := points[(i+1)%len(points)]
+		area += points[i][0]*next[1] - next[0]*points[i][1]
+	}
+	if area < 0 {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+	rasterizer.MoveTo(points[0][0], points[0][1])
+	for _, point := range points[1:] {
+		rasterizer.LineTo(point[0], point[1])
+	}
+	rasterizer.ClosePath()
+}
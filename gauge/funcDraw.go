@@ -0,0 +1,171 @@
+package gauge
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/chart"
+)
+
+// kArcStep
+// en: Maximum angle, in radians, of each line used to draw arcs
+//
+// pt_br: Ângulo máximo, em radianos, de cada linha usada para desenhar arcos
+const kArcStep = math.Pi / 90
+
+// arcTo
+// en: Adds an arc to the path with lines, from start to end, in radians
+// clockwise from the 3 o'clock direction, like the canvas
+//
+// pt_br: Adiciona um arco ao caminho com linhas, de start a end, em radianos no
+// sentido horário a partir da direção das 3 horas, como o canvas
+func arcTo(platform Platform, cx, cy, radius, start, end float64, move bool) {
+	steps := int(math.Ceil(math.Abs(end-start) / kArcStep))
+	if steps < 1 {
+		steps = 1
+	}
+	for i := 0; i <= steps; i += 1 {
+		angle := start + (end-start)*float64(i)/float64(steps)
+		x, y := cx+radius*math.Cos(angle), cy+radius*math.Sin(angle)
+		if i == 0 && move {
+			platform.MoveTo(x, y)
+		} else {
+			platform.LineTo(x, y)
+		}
+	}
+}
+
+// circle
+// en: Fills and optionally strokes a circle
+//
+// pt_br: Preenche e opcionalmente contorna um círculo
+func circle(platform Platform, cx, cy, radius float64, fill interface{}, stroke color.RGBA, lineWidth float64) {
+	platform.BeginPath()
+	arcTo(platform, cx, cy, radius, 0, 2*math.Pi, true)
+	platform.ClosePath(cx+radius, cy)
+	if fill != nil {
+		platform.SetFillStyle(fill)
+		platform.Fill()
+	}
+	if stroke.A != 0 && lineWidth > 0 {
+		platform.SetStrokeStyle(stroke)
+		platform.SetLineWidth(lineWidth)
+		platform.Stroke()
+	}
+}
+
+// band
+// en: Fills the ring sector between two radii and two angles
+//
+// pt_br: Preenche o setor de anel entre dois raios e dois ângulos
+func band(platform Platform, cx, cy, inner, outer, start, end float64, fill color.RGBA) {
+	platform.SetFillStyle(fill)
+	platform.BeginPath()
+	arcTo(platform, cx, cy, outer, start, end, true)
+	arcTo(platform, cx, cy, inner, end, start, false)
+	platform.ClosePath(cx+outer*math.Cos(start), cy+outer*math.Sin(start))
+	platform.Fill()
+}
+
+// rectangle
+// en: Fills a rectangle with float coordinates, as a path
+//
+// pt_br: Preenche um retângulo com coordenadas de ponto flutuante, como caminho
+func rectangle(platform Platform, x, y, width, height float64, fill color.RGBA) {
+	platform.SetFillStyle(fill)
+	platform.BeginPath()
+	platform.MoveTo(x, y)
+	platform.LineTo(x+width, y)
+	platform.LineTo(x+width, y+height)
+	platform.LineTo(x, y+height)
+	platform.ClosePath(x, y)
+	platform.Fill()
+}
+
+// scaleTicks
+// en: Returns the major ticks of a scale: count equal intervals, or nice values
+// from chart.ValueTicks() when count is zero, limited to the scale
+//
+// pt_br: Retorna as marcas principais de uma escala: count intervalos iguais, ou
+// valores agradáveis de chart.ValueTicks() quando count é zero, limitados à
+// escala
+func scaleTicks(minimum, maximum float64, count, automatic int) (ticks []float64, step float64) {
+	if maximum <= minimum {
+		return []float64{minimum}, 0
+	}
+	if count > 0 {
+		step = (maximum - minimum) / float64(count)
+		ticks = make([]float64, count+1)
+		for i := range ticks {
+			ticks[i] = minimum + float64(i)*step
+		}
+		return
+	}
+	all, step := chart.ValueTicks(minimum, maximum, automatic)
+	ticks = make([]float64, 0, len(all))
+	for _, tick := range all {
+		if tick >= minimum-step*1e-9 && tick <= maximum+step*1e-9 {
+			ticks = append(ticks, tick)
+		}
+	}
+	return
+}
+
+// centerText
+// en: Draws a text centered horizontally and vertically at the point
+//
+// pt_br: Desenha um texto centralizado horizontal e verticalmente no ponto
+func centerText(platform Platform, text string, x, y float64) {
+	metrics := platform.MeasureText(text)
+	ascent, descent := metrics.ActualBoundingBoxAscent, metrics.ActualBoundingBoxDescent
+	if ascent+descent <= 0 {
+		ascent, descent = metrics.FontBoundingBoxAscent, metrics.FontBoundingBoxDescent
+	}
+	if ascent+descent <= 0 {
+		ascent = metrics.Width / float64(len([]rune(text))+1) * 1.4
+	}
+	platform.FillText(text, round(x-metrics.Width/2), round(y+(ascent-descent)/2))
+}
+
+// rangeColor
+// en: Returns the color of the range that contains the value
+//
+// pt_br: Retorna a cor da faixa que contém o valor
+func rangeColor(ranges []Range, value float64, fallback color.RGBA) color.RGBA {
+	for _, item := range ranges {
+		if value >= math.Min(item.From, item.To) && value <= math.Max(item.From, item.To) {
+			return item.Color
+		}
+	}
+	return fallback
+}
+
+// defaultColor
+// en: Returns fallback when value is the zero color
+//
+// pt_br: Retorna fallback quando value é a cor zero
+func defaultColor(value, fallback color.RGBA) color.RGBA {
+	if value == (color.RGBA{}) {
+		return fallback
+	}
+	return value
+}
+
+// formatLabel
+// en: Formats a value with the custom function or with chart.FormatValue()
+//
+// pt_br: Formata um valor com a função personalizada ou com chart.FormatValue()
+func formatLabel(format func(value float64) string, value, step float64) string {
+	if format != nil {
+		return format(value)
+	}
+	return chart.FormatValue(value, step)
+}
+
+func round(value float64) int {
+	return int(math.Round(value))
+}
+
+func clamp(value, minimum, maximum float64) float64 {
+	return math.Max(minimum, math.Min(maximum, value))
+}
@@ -0,0 +1,181 @@
+package gauge
+
+import (
+	"image/color"
+	"time"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// LedConfig
+// en: Configuration of a LED indicator
+//
+//	X, Y, Size: Top left corner and diameter of the LED, in pixels
+//	OnColor: Color when lit; zero uses KDefaultAccentColor
+//	OffColor: Color when off; zero uses OnColor with 0.35 less OKLCH lightness
+//	Label: Optional text written to the right of the LED
+//	Font: Optional; when nil, the current font of the platform is used
+//	TextColor, RimColor: Zero uses the KDefault colors
+//	BlinkInterval: Half period of the blink while SetBlink(true); zero blinks
+//	               every 500ms
+//
+// pt_br: Configuração de um indicador LED
+//
+//	X, Y, Size: Canto superior esquerdo e diâmetro do LED, em pixels
+//	OnColor: Cor quando aceso; zero usa KDefaultAccentColor
+//	OffColor: Cor quando apagado; zero usa OnColor com 0.35 a menos de
+//	          luminosidade OKLCH
+//	Label: Texto opcional escrito à direita do LED
+//	Font: Opcional; quando nil, a fonte atual da plataforma é usada
+//	TextColor, RimColor: Zero usa as cores KDefault
+//	BlinkInterval: Meio período da piscada enquanto SetBlink(true); zero pisca a
+//	               cada 500ms
+type LedConfig struct {
+	X             float64
+	Y             float64
+	Size          float64
+	OnColor       color.RGBA
+	OffColor      color.RGBA
+	Label         string
+	Font          *font.Font
+	TextColor     color.RGBA
+	RimColor      color.RGBA
+	BlinkInterval time.Duration
+}
+
+// Led
+// en: Round indicator light, on, off or blinking
+//
+// pt_br: Luz indicadora redonda, acesa, apagada ou piscando
+type Led struct {
+	widget
+	Config LedConfig
+	on     bool
+	blink  bool
+	lit    bool
+	start  time.Time
+}
+
+// NewLed
+// en: Returns a LED that is off
+//
+// pt_br: Retorna um LED apagado
+func NewLed(id string, platform, scratchPad iotmakerPlatformIDraw.IDraw, config LedConfig) *Led {
+	return &Led{
+		widget: widget{id: id, platform: platform, scratchPad: scratchPad},
+		Config: config,
+	}
+}
+
+// SetOn
+// en: Turns the LED on or off; it also stops the blink
+//
+// pt_br: Acende ou apaga o LED; também para a piscada
+func (el *Led) SetOn(on bool) {
+	el.on, el.lit, el.blink = on, on, false
+}
+
+// GetOn
+// en: Returns true when the LED is on or blinking
+//
+// pt_br: Retorna true quando o LED está aceso ou piscando
+func (el *Led) GetOn() bool {
+	return el.on || el.blink
+}
+
+// SetBlink
+// en: Starts or stops the blink; the LED starts lit at the next Update()
+//
+// pt_br: Inicia ou para a piscada; o LED começa aceso no próximo Update()
+func (el *Led) SetBlink(blink bool) {
+	el.blink, el.start = blink, time.Time{}
+	if !blink {
+		el.lit = el.on
+	}
+}
+
+// Update
+// en: Advances the blink; returns true when the LED must be redrawn
+//
+// pt_br: Avança a piscada; retorna true quando o LED precisa ser redesenhado
+func (el *Led) Update(now time.Time) bool {
+	if !el.blink {
+		return false
+	}
+	if el.start.IsZero() {
+		el.start = now
+	}
+	interval := el.Config.BlinkInterval
+	if interval <= 0 {
+		interval = 500 * time.Millisecond
+	}
+	lit := (now.Sub(el.start)/interval)%2 == 0
+	changed := lit != el.lit
+	el.lit = lit
+	return changed
+}
+
+// GetBounds
+// en: Returns the rectangle of the LED, without the label
+//
+// pt_br: Retorna o retângulo do LED, sem o rótulo
+func (el *Led) GetBounds() (x, y, width, height float64) {
+	return el.Config.X, el.Config.Y, el.Config.Size, el.Config.Size
+}
+
+// SetBounds
+// en: Moves and resizes the LED to the largest square centered in the box, as
+// layout.Target; the label is drawn outside the box
+//
+// pt_br: Move e redimensiona o LED para o maior quadrado centralizado na caixa,
+// como layout.Target; o rótulo é desenhado fora da caixa
+func (el *Led) SetBounds(bounds collision.AABB) {
+	el.Config.X, el.Config.Y, el.Config.Size = square(bounds)
+}
+
+// Draw
+// en: Draws the LED on its platform
+//
+// pt_br: Desenha o LED na sua plataforma
+func (el *Led) Draw() {
+	if el.platform != nil {
+		el.DrawOn(el.platform)
+	}
+}
+
+// DrawOn
+// en: Draws the LED on the platform given, inside Save() and Restore(). The lit
+// LED has a radial highlight towards its top left
+//
+// pt_br: Desenha o LED na plataforma dada, dentro de Save() e Restore(). O LED
+// aceso tem um brilho radial em direção ao seu canto superior esquerdo
+func (el *Led) DrawOn(platform Platform) {
+	config := el.Config
+	platform.Save()
+	defer platform.Restore()
+	if config.Font != nil {
+		platform.Font(*config.Font)
+	}
+
+	radius := config.Size / 2
+	cx, cy := config.X+radius, config.Y+radius
+	on := defaultColor(config.OnColor, KDefaultAccentColor)
+	var fill interface{} = defaultColor(config.OffColor, colorUtils.Darken(on, 0.35))
+	if el.lit {
+		gradient := platform.CreateRadialGradient(cx-radius*0.3, cy-radius*0.3, 0, cx, cy, radius)
+		platform.AddColorStopPosition(gradient, 0, colorUtils.Lighten(on, 0.25))
+		platform.AddColorStopPosition(gradient, 0.6, on)
+		platform.AddColorStopPosition(gradient, 1, colorUtils.Darken(on, 0.1))
+		fill = gradient
+	}
+	circle(platform, cx, cy, radius, fill, defaultColor(config.RimColor, KDefaultTickColor), 1)
+
+	if config.Label != "" {
+		platform.SetFillStyle(defaultColor(config.TextColor, KDefaultTextColor))
+		width := platform.MeasureText(config.Label).Width
+		centerText(platform, config.Label, config.X+config.Size+radius*0.5+width/2, cy)
+	}
+}
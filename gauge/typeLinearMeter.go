@@ -0,0 +1,223 @@
+package gauge
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// LinearMeterConfig
+// en: Configuration of a linear bar meter
+//
+//	X, Y, Width, Height: Rectangle of the meter, scale labels included
+//	Vertical: Fills from bottom to top instead of from left to right
+//	Min, Max: Limits of the scale
+//	Ranges: The bar takes the color of the range that contains the value
+//	MajorTicks: Number of intervals between labeled ticks; 0 chooses nice values
+//	MinorTicks: Number of minor ticks inside each major interval
+//	Format: Optional; formats the labels
+//	Font: Optional; when nil, the current font of the platform is used
+//	TrackColor, BarColor, TickColor, TextColor: Zero uses the KDefault colors
+//	AnimationDuration: Time of the bar movement; 0 moves it at once
+//
+// pt_br: Configuração de um medidor de barra linear
+//
+//	X, Y, Width, Height: Retângulo do medidor, rótulos da escala incluídos
+//	Vertical: Preenche de baixo para cima em vez de da esquerda para a direita
+//	Min, Max: Limites da escala
+//	Ranges: A barra assume a cor da faixa que contém o valor
+//	MajorTicks: Número de intervalos entre marcas com rótulo; 0 escolhe valores
+//	            agradáveis
+//	MinorTicks: Número de marcas menores dentro de cada intervalo principal
+//	Format: Opcional; formata os rótulos
+//	Font: Opcional; quando nil, a fonte atual da plataforma é usada
+//	TrackColor, BarColor, TickColor, TextColor: Zero usa as cores KDefault
+//	AnimationDuration: Tempo do movimento da barra; 0 a move imediatamente
+type LinearMeterConfig struct {
+	X                 float64
+	Y                 float64
+	Width             float64
+	Height            float64
+	Vertical          bool
+	Min               float64
+	Max               float64
+	Ranges            []Range
+	MajorTicks        int
+	MinorTicks        int
+	Format            func(value float64) string
+	Font              *font.Font
+	TrackColor        color.RGBA
+	BarColor          color.RGBA
+	TickColor         color.RGBA
+	TextColor         color.RGBA
+	AnimationDuration time.Duration
+}
+
+// LinearMeter
+// en: Horizontal or vertical bar filled up to the value, with a scale
+//
+// pt_br: Barra horizontal ou vertical preenchida até o valor, com uma escala
+type LinearMeter struct {
+	widget
+	Config LinearMeterConfig
+	value  animation
+}
+
+// NewLinearMeter
+// en: Returns a linear meter with the bar at Min
+//
+// pt_br: Retorna um medidor linear com a barra em Min
+func NewLinearMeter(id string, platform, scratchPad iotmakerPlatformIDraw.IDraw, config LinearMeterConfig) *LinearMeter {
+	if config.Max <= config.Min {
+		config.Max = config.Min + 100
+	}
+	meter := &LinearMeter{
+		widget: widget{id: id, platform: platform, scratchPad: scratchPad},
+		Config: config,
+	}
+	meter.value.set(config.Min, 0)
+	return meter
+}
+
+// SetValue
+// en: Moves the bar to the value, limited to the scale, in
+// Config.AnimationDuration; call Update() on each frame while it moves
+//
+// pt_br: Move a barra para o valor, limitado à escala, em
+// Config.AnimationDuration; chame Update() a cada quadro enquanto ela se move
+func (el *LinearMeter) SetValue(value float64) {
+	el.value.set(clamp(value, el.Config.Min, el.Config.Max), el.Config.AnimationDuration)
+}
+
+// GetValue
+// en: Returns the target value and the value shown by the bar now
+//
+// pt_br: Retorna o valor alvo e o valor mostrado pela barra agora
+func (el *LinearMeter) GetValue() (target, shown float64) {
+	return el.value.target, el.value.current
+}
+
+// Update
+// en: Advances the bar animation; returns true when the meter must be redrawn
+//
+// pt_br: Avança a animação da barra; retorna true quando o medidor precisa ser
+// redesenhado
+func (el *LinearMeter) Update(now time.Time) bool {
+	return el.value.update(now)
+}
+
+// GetBounds
+// en: Returns the rectangle of the meter
+//
+// pt_br: Retorna o retângulo do medidor
+func (el *LinearMeter) GetBounds() (x, y, width, height float64) {
+	return el.Config.X, el.Config.Y, el.Config.Width, el.Config.Height
+}
+
+// SetBounds
+// en: Moves and resizes the meter to the box, as layout.Target
+//
+// pt_br: Move e redimensiona o medidor para a caixa, como layout.Target
+func (el *LinearMeter) SetBounds(bounds collision.AABB) {
+	el.Config.X, el.Config.Y = bounds.X, bounds.Y
+	el.Config.Width, el.Config.Height = bounds.Width, bounds.Height
+}
+
+// Draw
+// en: Draws the meter on its platform
+//
+// pt_br: Desenha o medidor na sua plataforma
+func (el *LinearMeter) Draw() {
+	if el.platform != nil {
+		el.DrawOn(el.platform)
+	}
+}
+
+// DrawOn
+// en: Draws the meter on the platform given, inside Save() and Restore(). The
+// track takes 40% of the thickness; ticks and labels take the rest, below a
+// horizontal track or to the right of a vertical one
+//
+// pt_br: Desenha o medidor na plataforma dada, dentro de Save() e Restore(). A
+// trilha ocupa 40% da espessura; marcas e rótulos ocupam o resto, abaixo de uma
+// trilha horizontal ou à direita de uma vertical
+func (el *LinearMeter) DrawOn(platform Platform) {
+	config := el.Config
+	platform.Save()
+	defer platform.Restore()
+	if config.Font != nil {
+		platform.Font(*config.Font)
+	}
+
+	length, thickness := config.Width, config.Height
+	if config.Vertical {
+		length, thickness = config.Height, config.Width
+	}
+	track := thickness * 0.4
+	tickColor := defaultColor(config.TickColor, KDefaultTickColor)
+	fraction := (el.value.current - config.Min) / (config.Max - config.Min)
+	bar := rangeColor(config.Ranges, el.value.current, defaultColor(config.BarColor, KDefaultAccentColor))
+
+	// en: position along the scale and across it, converted to the screen
+	// pt_br: posição ao longo da escala e através dela, convertida para a tela
+	point := func(along, across float64) (x, y float64) {
+		if config.Vertical {
+			return config.X + across, config.Y + config.Height - along
+		}
+		return config.X + along, config.Y + across
+	}
+	box := func(from, to, across, size float64, fill color.RGBA) {
+		x0, y0 := point(from, across)
+		x1, y1 := point(to, across+size)
+		rectangle(platform, math.Min(x0, x1), math.Min(y0, y1), math.Abs(x1-x0), math.Abs(y1-y0), fill)
+	}
+
+	box(0, length, 0, track, defaultColor(config.TrackColor, KDefaultFaceColor))
+	for _, item := range config.Ranges {
+		from := (clamp(item.From, config.Min, config.Max) - config.Min) / (config.Max - config.Min)
+		to := (clamp(item.To, config.Min, config.Max) - config.Min) / (config.Max - config.Min)
+		box(from*length, to*length, track, track*0.15, item.Color)
+	}
+	box(0, fraction*length, 0, track, bar)
+
+	ticks, step := scaleTicks(config.Min, config.Max, config.MajorTicks, int(math.Max(2, length/60)))
+	position := func(value float64) float64 {
+		return (value - config.Min) / (config.Max - config.Min) * length
+	}
+	platform.SetStrokeStyle(tickColor)
+	platform.SetLineWidth(1)
+	platform.BeginPath()
+	for i, tick := range ticks {
+		x, y := point(position(tick), track)
+		platform.MoveTo(x, y)
+		platform.LineTo(point(position(tick), track*1.5))
+		if i == len(ticks)-1 || config.MinorTicks <= 0 {
+			continue
+		}
+		for minor := 1; minor <= config.MinorTicks; minor += 1 {
+			along := position(tick + (ticks[i+1]-tick)*float64(minor)/float64(config.MinorTicks+1))
+			x, y := point(along, track)
+			platform.MoveTo(x, y)
+			platform.LineTo(point(along, track*1.25))
+		}
+	}
+	platform.Stroke()
+
+	platform.SetFillStyle(defaultColor(config.TextColor, KDefaultTextColor))
+	for _, tick := range ticks {
+		label := formatLabel(config.Format, tick, step)
+		if config.Vertical {
+			x, y := point(position(tick), track*1.7)
+			width := platform.MeasureText(label).Width
+			centerText(platform, label, x+width/2, y)
+			continue
+		}
+		half := platform.MeasureText(label).Width / 2
+		x := clamp(config.X+position(tick), config.X+half, config.X+config.Width-half)
+		centerText(platform, label, x, config.Y+(track*1.5+thickness)/2)
+	}
+}
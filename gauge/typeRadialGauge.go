@@ -0,0 +1,249 @@
+package gauge
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// RadialGaugeConfig
+// en: Configuration of a radial gauge
+//
+//	X, Y, Size: Top left corner and side of the square of the dial, in pixels
+//	Min, Max: Limits of the scale
+//	StartAngle, EndAngle: Angles of Min and Max, in degrees clockwise from the
+//	                      3 o'clock direction; both zero use 135 and 405, a
+//	                      sweep of 270 degrees open at the bottom
+//	Ranges: Colored bands of the scale
+//	MajorTicks: Number of intervals between labeled ticks; 0 chooses nice values
+//	MinorTicks: Number of minor ticks inside each major interval
+//	Title, Unit: Texts above and below the center
+//	ShowValue: Writes the current value below the center
+//	Format: Optional; formats labels and the value
+//	Font: Optional; when nil, the current font of the platform is used
+//	FaceColor, TickColor, TextColor, NeedleColor: Zero uses the KDefault colors
+//	AnimationDuration: Time of the needle movement; 0 moves it at once
+//
+// pt_br: Configuração de um mostrador radial
+//
+//	X, Y, Size: Canto superior esquerdo e lado do quadrado do mostrador, em
+//	            pixels
+//	Min, Max: Limites da escala
+//	StartAngle, EndAngle: Ângulos de Min e Max, em graus no sentido horário a
+//	                      partir da direção das 3 horas; ambos zero usam 135 e
+//	                      405, uma abertura de 270 graus aberta embaixo
+//	Ranges: Faixas coloridas da escala
+//	MajorTicks: Número de intervalos entre marcas com rótulo; 0 escolhe valores
+//	            agradáveis
+//	MinorTicks: Número de marcas menores dentro de cada intervalo principal
+//	Title, Unit: Textos acima e abaixo do centro
+//	ShowValue: Escreve o valor atual abaixo do centro
+//	Format: Opcional; formata os rótulos e o valor
+//	Font: Opcional; quando nil, a fonte atual da plataforma é usada
+//	FaceColor, TickColor, TextColor, NeedleColor: Zero usa as cores KDefault
+//	AnimationDuration: Tempo do movimento do ponteiro; 0 o move imediatamente
+type RadialGaugeConfig struct {
+	X                 float64
+	Y                 float64
+	Size              float64
+	Min               float64
+	Max               float64
+	StartAngle        float64
+	EndAngle          float64
+	Ranges            []Range
+	MajorTicks        int
+	MinorTicks        int
+	Title             string
+	Unit              string
+	ShowValue         bool
+	Format            func(value float64) string
+	Font              *font.Font
+	FaceColor         color.RGBA
+	TickColor         color.RGBA
+	TextColor         color.RGBA
+	NeedleColor       color.RGBA
+	AnimationDuration time.Duration
+}
+
+// RadialGauge
+// en: Dial with a needle, colored ranges and a labeled scale
+//
+// pt_br: Mostrador com ponteiro, faixas coloridas e escala com rótulos
+type RadialGauge struct {
+	widget
+	Config RadialGaugeConfig
+	value  animation
+}
+
+// NewRadialGauge
+// en: Returns a radial gauge with the needle at Min
+//
+//	platform: Where Draw() paints
+//	scratchPad: Optional platform used for hit tests
+//
+// pt_br: Retorna um mostrador radial com o ponteiro em Min
+//
+//	platform: Onde Draw() pinta
+//	scratchPad: Plataforma opcional usada em testes de colisão
+func NewRadialGauge(id string, platform, scratchPad iotmakerPlatformIDraw.IDraw, config RadialGaugeConfig) *RadialGauge {
+	if config.StartAngle == 0 && config.EndAngle == 0 {
+		config.StartAngle, config.EndAngle = 135, 405
+	}
+	if config.Max <= config.Min {
+		config.Max = config.Min + 100
+	}
+	gauge := &RadialGauge{
+		widget: widget{id: id, platform: platform, scratchPad: scratchPad},
+		Config: config,
+	}
+	gauge.value.set(config.Min, 0)
+	return gauge
+}
+
+// SetValue
+// en: Moves the needle to the value, limited to the scale, in
+// Config.AnimationDuration; call Update() on each frame while it moves
+//
+// pt_br: Move o ponteiro para o valor, limitado à escala, em
+// Config.AnimationDuration; chame Update() a cada quadro enquanto ele se move
+func (el *RadialGauge) SetValue(value float64) {
+	el.value.set(clamp(value, el.Config.Min, el.Config.Max), el.Config.AnimationDuration)
+}
+
+// GetValue
+// en: Returns the target value and the value shown by the needle now
+//
+// pt_br: Retorna o valor alvo e o valor mostrado pelo ponteiro agora
+func (el *RadialGauge) GetValue() (target, shown float64) {
+	return el.value.target, el.value.current
+}
+
+// Update
+// en: Advances the needle animation; returns true when the gauge must be redrawn
+//
+// pt_br: Avança a animação do ponteiro; retorna true quando o mostrador precisa ser
+// redesenhado
+func (el *RadialGauge) Update(now time.Time) bool {
+	return el.value.update(now)
+}
+
+// GetBounds
+// en: Returns the rectangle of the gauge
+//
+// pt_br: Retorna o retângulo do mostrador
+func (el *RadialGauge) GetBounds() (x, y, width, height float64) {
+	return el.Config.X, el.Config.Y, el.Config.Size, el.Config.Size
+}
+
+// SetBounds
+// en: Moves and resizes the gauge to the largest square centered in the box, as
+// layout.Target
+//
+// pt_br: Move e redimensiona o mostrador para o maior quadrado centralizado na
+// caixa, como layout.Target
+func (el *RadialGauge) SetBounds(bounds collision.AABB) {
+	el.Config.X, el.Config.Y, el.Config.Size = square(bounds)
+}
+
+// Draw
+// en: Draws the gauge on its platform
+//
+// pt_br: Desenha o mostrador na sua plataforma
+func (el *RadialGauge) Draw() {
+	if el.platform != nil {
+		el.DrawOn(el.platform)
+	}
+}
+
+// angle
+// en: Returns the angle of the value, in radians
+//
+// pt_br: Retorna o ângulo do valor, em radianos
+func (el *RadialGauge) angle(value float64) float64 {
+	config := el.Config
+	fraction := (clamp(value, config.Min, config.Max) - config.Min) / (config.Max - config.Min)
+	return (config.StartAngle + (config.EndAngle-config.StartAngle)*fraction) * math.Pi / 180
+}
+
+// DrawOn
+// en: Draws the gauge on the platform given, inside Save() and Restore()
+//
+// pt_br: Desenha o mostrador na plataforma dada, dentro de Save() e Restore()
+func (el *RadialGauge) DrawOn(platform Platform) {
+	config := el.Config
+	platform.Save()
+	defer platform.Restore()
+	if config.Font != nil {
+		platform.Font(*config.Font)
+	}
+
+	radius := config.Size / 2
+	cx, cy := config.X+radius, config.Y+radius
+	tickColor := defaultColor(config.TickColor, KDefaultTickColor)
+	textColor := defaultColor(config.TextColor, KDefaultTextColor)
+	needleColor := defaultColor(config.NeedleColor, KDefaultAccentColor)
+
+	circle(platform, cx, cy, radius*0.98, defaultColor(config.FaceColor, KDefaultFaceColor), tickColor, radius*0.02)
+
+	for _, item := range config.Ranges {
+		band(platform, cx, cy, radius*0.78, radius*0.86, el.angle(item.From), el.angle(item.To), item.Color)
+	}
+
+	// en: ticks and labels
+	// pt_br: marcas e rótulos
+	ticks, step := scaleTicks(config.Min, config.Max, config.MajorTicks, 8)
+	platform.SetStrokeStyle(tickColor)
+	platform.SetLineWidth(math.Max(1, radius*0.015))
+	platform.BeginPath()
+	for i, tick := range ticks {
+		angle := el.angle(tick)
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		platform.MoveTo(cx+radius*0.88*cos, cy+radius*0.88*sin)
+		platform.LineTo(cx+radius*0.74*cos, cy+radius*0.74*sin)
+		if i == len(ticks)-1 || config.MinorTicks <= 0 {
+			continue
+		}
+		for minor := 1; minor <= config.MinorTicks; minor += 1 {
+			value := tick + (ticks[i+1]-tick)*float64(minor)/float64(config.MinorTicks+1)
+			angle := el.angle(value)
+			cos, sin := math.Cos(angle), math.Sin(angle)
+			platform.MoveTo(cx+radius*0.88*cos, cy+radius*0.88*sin)
+			platform.LineTo(cx+radius*0.81*cos, cy+radius*0.81*sin)
+		}
+	}
+	platform.Stroke()
+
+	platform.SetFillStyle(textColor)
+	for _, tick := range ticks {
+		angle := el.angle(tick)
+		centerText(platform, formatLabel(config.Format, tick, step), cx+radius*0.6*math.Cos(angle), cy+radius*0.6*math.Sin(angle))
+	}
+	if config.Title != "" {
+		centerText(platform, config.Title, cx, cy-radius*0.3)
+	}
+	if config.ShowValue || config.Unit != "" {
+		text := config.Unit
+		if config.ShowValue {
+			text = formatLabel(config.Format, el.value.current, step/10) + config.Unit
+		}
+		centerText(platform, text, cx, cy+radius*0.45)
+	}
+
+	// en: needle, a thin triangle with a hub
+	// pt_br: ponteiro, um triângulo fino com um eixo
+	angle := el.angle(el.value.current)
+	cos, sin := math.Cos(angle), math.Sin(angle)
+	half := radius * 0.035
+	platform.SetFillStyle(needleColor)
+	platform.BeginPath()
+	platform.MoveTo(cx+radius*0.84*cos, cy+radius*0.84*sin)
+	platform.LineTo(cx-half*sin-radius*0.12*cos, cy+half*cos-radius*0.12*sin)
+	platform.LineTo(cx+half*sin-radius*0.12*cos, cy-half*cos-radius*0.12*sin)
+	platform.ClosePath(cx+radius*0.84*cos, cy+radius*0.84*sin)
+	platform.Fill()
+	circle(platform, cx, cy, radius*0.07, tickColor, color.RGBA{}, 0)
+}
@@ -0,0 +1,319 @@
+package gauge
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// kSegments
+// en: Lit segments of each character, bits 0 to 6 are the segments a to g:
+// top, top right, bottom right, bottom, bottom left, top left and middle
+//
+// pt_br: Segmentos acesos de cada caractere, os bits 0 a 6 são os segmentos a a
+// g: topo, topo direito, base direita, base, base esquerda, topo esquerdo e meio
+var kSegments = map[rune]uint8{
+	' ': 0x00, '-': 0x40, '_': 0x08, '=': 0x48, '\'': 0x02, '"': 0x22,
+	'0': 0x3f, '1': 0x06, '2': 0x5b, '3': 0x4f, '4': 0x66,
+	'5': 0x6d, '6': 0x7d, '7': 0x07, '8': 0x7f, '9': 0x6f,
+	'A': 0x77, 'B': 0x7c, 'C': 0x39, 'D': 0x5e, 'E': 0x79, 'F': 0x71,
+	'G': 0x3d, 'H': 0x76, 'I': 0x30, 'J': 0x1e, 'L': 0x38, 'N': 0x54,
+	'O': 0x5c, 'P': 0x73, 'Q': 0x67, 'R': 0x50, 'S': 0x6d, 'T': 0x78,
+	'U': 0x3e, 'Y': 0x6e,
+}
+
+// SevenSegmentConfig
+// en: Configuration of a seven-segment display
+//
+//	X, Y: Top left corner of the display
+//	DigitWidth, DigitHeight: Size of each digit cell, in pixels; zero height
+//	                         uses twice the width
+//	Digits: Number of digit cells; texts are aligned to the right
+//	Skew: Horizontal slant of the digits, as the tangent of the angle; 0.1 is
+//	      a common value
+//	OnColor: Color of the lit segments; zero uses KDefaultAccentColor
+//	OffColor: Color of the unlit segments, the ghost digits; zero draws nothing
+//	Background: Fill of the display; zero draws nothing
+//
+// pt_br: Configuração de um mostrador de sete segmentos
+//
+//	X, Y: Canto superior esquerdo do mostrador
+//	DigitWidth, DigitHeight: Tamanho de cada célula de dígito, em pixels;
+//	                         altura zero usa o dobro da largura
+//	Digits: Número de células de dígito; textos são alinhados à direita
+//	Skew: Inclinação horizontal dos dígitos, como a tangente do ângulo; 0.1 é um
+//	      valor comum
+//	OnColor: Cor dos segmentos acesos; zero usa KDefaultAccentColor
+//	OffColor: Cor dos segmentos apagados, os dígitos fantasma; zero não desenha
+//	          nada
+//	Background: Preenchimento do mostrador; zero não desenha nada
+type SevenSegmentConfig struct {
+	X           float64
+	Y           float64
+	DigitWidth  float64
+	DigitHeight float64
+	Digits      int
+	Skew        float64
+	OnColor     color.RGBA
+	OffColor    color.RGBA
+	Background  color.RGBA
+}
+
+// cell
+// en: Segments and decimal point of a digit cell
+//
+// pt_br: Segmentos e ponto decimal de uma célula de dígito
+type cell struct {
+	segments uint8
+	point    bool
+}
+
+// SevenSegment
+// en: Row of seven-segment digits with decimal points
+//
+// pt_br: Fileira de dígitos de sete segmentos com pontos decimais
+type SevenSegment struct {
+	widget
+	Config SevenSegmentConfig
+	text   string
+	cells  []cell
+}
+
+// NewSevenSegment
+// en: Returns a blank seven-segment display
+//
+// pt_br: Retorna um mostrador de sete segmentos em branco
+func NewSevenSegment(id string, platform, scratchPad iotmakerPlatformIDraw.IDraw, config SevenSegmentConfig) *SevenSegment {
+	if config.Digits < 1 {
+		config.Digits = 1
+	}
+	if config.DigitHeight <= 0 {
+		config.DigitHeight = config.DigitWidth * 2
+	}
+	return &SevenSegment{
+		widget: widget{id: id, platform: platform, scratchPad: scratchPad},
+		Config: config,
+		cells:  make([]cell, config.Digits),
+	}
+}
+
+// SetText
+// en: Shows a text aligned to the right. Digits, the letters of kSegments in any
+// case, space, '-', '_', '=' and quotes are supported; other characters are
+// blank. A '.' lights the decimal point of the previous cell. When the text is
+// longer than the display, the rightmost cells are kept
+//
+// pt_br: Mostra um texto alinhado à direita. Dígitos, as letras de kSegments em
+// qualquer caixa, espaço, '-', '_', '=' e aspas são suportados; outros
+// caracteres ficam em branco. Um '.' acende o ponto decimal da célula anterior.
+// Quando o texto é maior do que o mostrador, as células mais à direita são
+// mantidas
+func (el *SevenSegment) SetText(text string) {
+	el.text = text
+	cells := make([]cell, 0, len(text))
+	for _, character := range text {
+		if character == '.' || character == ',' {
+			if len(cells) == 0 || cells[len(cells)-1].point {
+				cells = append(cells, cell{})
+			}
+			cells[len(cells)-1].point = true
+			continue
+		}
+		cells = append(cells, cell{segments: kSegments[unicode.ToUpper(character)]})
+	}
+
+	el.cells = make([]cell, el.Config.Digits)
+	for i := 1; i <= len(cells) && i <= len(el.cells); i += 1 {
+		el.cells[len(el.cells)-i] = cells[len(cells)-i]
+	}
+}
+
+// SetValue
+// en: Shows a number with the decimals given; values that do not fit in the
+// display show dashes
+//
+// pt_br: Mostra um número com as casas decimais dadas; valores que não cabem no
+// mostrador mostram traços
+func (el *SevenSegment) SetValue(value float64, decimals int) {
+	text := strconv.FormatFloat(value, 'f', decimals, 64)
+	digits := len(text)
+	for _, character := range text {
+		if character == '.' {
+			digits -= 1
+		}
+	}
+	if digits > el.Config.Digits || math.IsNaN(value) || math.IsInf(value, 0) {
+		el.SetText(strings.Repeat("-", el.Config.Digits))
+		return
+	}
+	el.SetText(text)
+}
+
+// GetText
+// en: Returns the last text shown
+//
+// pt_br: Retorna o último texto mostrado
+func (el *SevenSegment) GetText() string {
+	return el.text
+}
+
+// GetBounds
+// en: Returns the rectangle of the display, skew included
+//
+// pt_br: Retorna o retângulo do mostrador, inclinação incluída
+func (el *SevenSegment) GetBounds() (x, y, width, height float64) {
+	config := el.Config
+	slant := math.Abs(config.Skew) * config.DigitHeight
+	return config.X, config.Y, config.DigitWidth*float64(config.Digits) + slant, config.DigitHeight
+}
+
+// SetBounds
+// en: Moves the display to the top left corner of the box and scales the digits,
+// keeping their proportion, Digits and Skew, to the largest size that fits in
+// it, as layout.Target
+//
+// pt_br: Move o mostrador para o canto superior esquerdo da caixa e escala os
+// dígitos, mantendo a sua proporção, Digits e Skew, para o maior tamanho que
+// cabe nela, como layout.Target
+func (el *SevenSegment) SetBounds(bounds collision.AABB) {
+	config := &el.Config
+	config.X, config.Y = bounds.X, bounds.Y
+
+	proportion := 0.5
+	if config.DigitHeight > 0 && config.DigitWidth > 0 {
+		proportion = config.DigitWidth / config.DigitHeight
+	}
+	// en: width = height * (proportion * Digits + |Skew|), see GetBounds()
+	// pt_br: largura = altura * (proporção * Digits + |Skew|), veja GetBounds()
+	height := math.Max(0, bounds.Height)
+	if widthPerHeight := proportion*float64(config.Digits) + math.Abs(config.Skew); widthPerHeight > 0 {
+		height = math.Min(height, math.Max(0, bounds.Width)/widthPerHeight)
+	}
+	config.DigitHeight, config.DigitWidth = height, height*proportion
+}
+
+// Draw
+// en: Draws the display on its platform
+//
+// pt_br: Desenha o mostrador na sua plataforma
+func (el *SevenSegment) Draw() {
+	if el.platform != nil {
+		el.DrawOn(el.platform)
+	}
+}
+
+// DrawOn
+// en: Draws the display on the platform given, inside Save() and Restore()
+//
+// pt_br: Desenha o mostrador na plataforma dada, dentro de Save() e Restore()
+func (el *SevenSegment) DrawOn(platform Platform) {
+	config := el.Config
+	platform.Save()
+	defer platform.Restore()
+
+	if config.Background.A != 0 {
+		x, y, width, height := el.GetBounds()
+		rectangle(platform, x, y, width, height, config.Background)
+	}
+	on := defaultColor(config.OnColor, KDefaultAccentColor)
+	for i, item := range el.cells {
+		left := config.X + float64(i)*config.DigitWidth
+		if config.Skew < 0 {
+			left -= config.Skew * config.DigitHeight
+		}
+		for segment := 0; segment < 7; segment += 1 {
+			lit := item.segments&(1<<segment) != 0
+			if !lit && config.OffColor.A == 0 {
+				continue
+			}
+			fill := on
+			if !lit {
+				fill = config.OffColor
+			}
+			el.segment(platform, left, segment, fill)
+		}
+		if item.point || config.OffColor.A != 0 {
+			fill := on
+			if !item.point {
+				fill = config.OffColor
+			}
+			thickness := config.DigitWidth * 0.14
+			x, y := el.skew(left+config.DigitWidth*0.92, config.Y+config.DigitHeight-thickness*0.6)
+			circle(platform, x, y, thickness*0.6, fill, color.RGBA{}, 0)
+		}
+	}
+}
+
+// skew
+// en: Slants a point of the display; the bottom line stays in place
+//
+// pt_br: Inclina um ponto do mostrador; a linha da base fica no lugar
+func (el *SevenSegment) skew(x, y float64) (float64, float64) {
+	return x + el.Config.Skew*(el.Config.Y+el.Config.DigitHeight-y), y
+}
+
+// segment
+// en: Fills a segment as a hexagon with pointed ends, leaving a small gap to the
+// neighbour segments
+//
+// pt_br: Preenche um segmento como um hexágono com pontas, deixando um pequeno
+// espaço para os segmentos vizinhos
+func (el *SevenSegment) segment(platform Platform, left float64, index int, fill color.RGBA) {
+	config := el.Config
+	thickness := config.DigitWidth * 0.14
+	half, gap := thickness/2, thickness*0.12
+	// en: the digit uses 80% of the cell width, the rest is the spacing and the
+	// decimal point
+	// pt_br: o dígito usa 80% da largura da célula, o resto é o espaçamento e o
+	// ponto decimal
+	x0, x1 := left+half, left+config.DigitWidth*0.8-half
+	y0, y1, y2 := config.Y+half, config.Y+config.DigitHeight/2, config.Y+config.DigitHeight-half
+
+	var from, to [2]float64
+	horizontal := true
+	switch index {
+	case 0:
+		from, to = [2]float64{x0, y0}, [2]float64{x1, y0}
+	case 1:
+		from, to, horizontal = [2]float64{x1, y0}, [2]float64{x1, y1}, false
+	case 2:
+		from, to, horizontal = [2]float64{x1, y1}, [2]float64{x1, y2}, false
+	case 3:
+		from, to = [2]float64{x0, y2}, [2]float64{x1, y2}
+	case 4:
+		from, to, horizontal = [2]float64{x0, y1}, [2]float64{x0, y2}, false
+	case 5:
+		from, to, horizontal = [2]float64{x0, y0}, [2]float64{x0, y1}, false
+	case 6:
+		from, to = [2]float64{x0, y1}, [2]float64{x1, y1}
+	}
+
+	var points [6][2]float64
+	if horizontal {
+		a, b := from[0]+gap, to[0]-gap
+		points = [6][2]float64{{a, from[1]}, {a + half, from[1] - half}, {b - half, from[1] - half},
+			{b, from[1]}, {b - half, from[1] + half}, {a + half, from[1] + half}}
+	} else {
+		a, b := from[1]+gap, to[1]-gap
+		points = [6][2]float64{{from[0], a}, {from[0] + half, a + half}, {from[0] + half, b - half},
+			{from[0], b}, {from[0] - half, b - half}, {from[0] - half, a + half}}
+	}
+
+	platform.SetFillStyle(fill)
+	platform.BeginPath()
+	for i, point := range points {
+		x, y := el.skew(point[0], point[1])
+		if i == 0 {
+			platform.MoveTo(x, y)
+		} else {
+			platform.LineTo(x, y)
+		}
+	}
+	platform.ClosePath(el.skew(points[0][0], points[0][1]))
+	platform.Fill()
+}
@@ -0,0 +1,208 @@
+package gauge
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// ThermometerConfig
+// en: Configuration of a thermometer
+//
+//	X, Y, Width, Height: Rectangle of the thermometer, scale labels included
+//	Min, Max: Limits of the scale
+//	Ranges: The liquid takes the color of the range that contains the value
+//	MajorTicks: Number of intervals between labeled ticks; 0 chooses nice values
+//	MinorTicks: Number of minor ticks inside each major interval
+//	Unit: Text added to the labels, like "°C"
+//	Format: Optional; formats the labels
+//	Font: Optional; when nil, the current font of the platform is used
+//	GlassColor, LiquidColor, TickColor, TextColor: Zero uses the KDefault colors
+//	AnimationDuration: Time of the liquid movement; 0 moves it at once
+//
+// pt_br: Configuração de um termômetro
+//
+//	X, Y, Width, Height: Retângulo do termômetro, rótulos da escala incluídos
+//	Min, Max: Limites da escala
+//	Ranges: O líquido assume a cor da faixa que contém o valor
+//	MajorTicks: Número de intervalos entre marcas com rótulo; 0 escolhe valores
+//	            agradáveis
+//	MinorTicks: Número de marcas menores dentro de cada intervalo principal
+//	Unit: Texto adicionado aos rótulos, como "°C"
+//	Format: Opcional; formata os rótulos
+//	Font: Opcional; quando nil, a fonte atual da plataforma é usada
+//	GlassColor, LiquidColor, TickColor, TextColor: Zero usa as cores KDefault
+//	AnimationDuration: Tempo do movimento do líquido; 0 o move imediatamente
+type ThermometerConfig struct {
+	X                 float64
+	Y                 float64
+	Width             float64
+	Height            float64
+	Min               float64
+	Max               float64
+	Ranges            []Range
+	MajorTicks        int
+	MinorTicks        int
+	Unit              string
+	Format            func(value float64) string
+	Font              *font.Font
+	GlassColor        color.RGBA
+	LiquidColor       color.RGBA
+	TickColor         color.RGBA
+	TextColor         color.RGBA
+	AnimationDuration time.Duration
+}
+
+// Thermometer
+// en: Vertical tube with a bulb at the bottom, filled up to the value
+//
+// pt_br: Tubo vertical com um bulbo embaixo, preenchido até o valor
+type Thermometer struct {
+	widget
+	Config ThermometerConfig
+	value  animation
+}
+
+// NewThermometer
+// en: Returns a thermometer with the liquid at Min
+//
+// pt_br: Retorna um termômetro com o líquido em Min
+func NewThermometer(id string, platform, scratchPad iotmakerPlatformIDraw.IDraw, config ThermometerConfig) *Thermometer {
+	if config.Max <= config.Min {
+		config.Max = config.Min + 100
+	}
+	thermometer := &Thermometer{
+		widget: widget{id: id, platform: platform, scratchPad: scratchPad},
+		Config: config,
+	}
+	thermometer.value.set(config.Min, 0)
+	return thermometer
+}
+
+// SetValue
+// en: Moves the liquid to the value, limited to the scale, in
+// Config.AnimationDuration; call Update() on each frame while it moves
+//
+// pt_br: Move o líquido para o valor, limitado à escala, em
+// Config.AnimationDuration; chame Update() a cada quadro enquanto ele se move
+func (el *Thermometer) SetValue(value float64) {
+	el.value.set(clamp(value, el.Config.Min, el.Config.Max), el.Config.AnimationDuration)
+}
+
+// GetValue
+// en: Returns the target value and the value shown by the liquid now
+//
+// pt_br: Retorna o valor alvo e o valor mostrado pelo líquido agora
+func (el *Thermometer) GetValue() (target, shown float64) {
+	return el.value.target, el.value.current
+}
+
+// Update
+// en: Advances the liquid animation; returns true when the thermometer must be
+// redrawn
+//
+// pt_br: Avança a animação do líquido; retorna true quando o termômetro precisa
+// ser redesenhado
+func (el *Thermometer) Update(now time.Time) bool {
+	return el.value.update(now)
+}
+
+// GetBounds
+// en: Returns the rectangle of the thermometer
+//
+// pt_br: Retorna o retângulo do termômetro
+func (el *Thermometer) GetBounds() (x, y, width, height float64) {
+	return el.Config.X, el.Config.Y, el.Config.Width, el.Config.Height
+}
+
+// SetBounds
+// en: Moves and resizes the thermometer to the box, as layout.Target
+//
+// pt_br: Move e redimensiona o termômetro para a caixa, como layout.Target
+func (el *Thermometer) SetBounds(bounds collision.AABB) {
+	el.Config.X, el.Config.Y = bounds.X, bounds.Y
+	el.Config.Width, el.Config.Height = bounds.Width, bounds.Height
+}
+
+// Draw
+// en: Draws the thermometer on its platform
+//
+// pt_br: Desenha o termômetro na sua plataforma
+func (el *Thermometer) Draw() {
+	if el.platform != nil {
+		el.DrawOn(el.platform)
+	}
+}
+
+// DrawOn
+// en: Draws the thermometer on the platform given, inside Save() and Restore().
+// The tube and the bulb take the left 40% of the width; the scale, the rest
+//
+// pt_br: Desenha o termômetro na plataforma dada, dentro de Save() e Restore().
+// O tubo e o bulbo ocupam os 40% à esquerda da largura; a escala, o resto
+func (el *Thermometer) DrawOn(platform Platform) {
+	config := el.Config
+	platform.Save()
+	defer platform.Restore()
+	if config.Font != nil {
+		platform.Font(*config.Font)
+	}
+
+	bulb := math.Min(config.Width*0.2, config.Height*0.15)
+	tube := bulb * 0.5
+	cx := config.X + bulb
+	bulbY := config.Y + config.Height - bulb
+	top, bottom := config.Y+tube, bulbY-bulb
+	tickColor := defaultColor(config.TickColor, KDefaultTickColor)
+	liquid := rangeColor(config.Ranges, el.value.current, defaultColor(config.LiquidColor, KDefaultAccentColor))
+	position := func(value float64) float64 {
+		return bottom - (value-config.Min)/(config.Max-config.Min)*(bottom-top)
+	}
+
+	// en: glass: the tube with a round top, joined to the bulb
+	// pt_br: vidro: o tubo com o topo redondo, unido ao bulbo
+	platform.SetFillStyle(defaultColor(config.GlassColor, KDefaultFaceColor))
+	platform.SetStrokeStyle(tickColor)
+	platform.SetLineWidth(1)
+	platform.BeginPath()
+	start := math.Asin(tube / bulb)
+	arcTo(platform, cx, top, tube, math.Pi, 2*math.Pi, true)
+	arcTo(platform, cx, bulbY, bulb, -math.Pi/2+start, 3*math.Pi/2-start, false)
+	platform.ClosePath(cx-tube, top)
+	platform.Fill()
+	platform.Stroke()
+
+	// en: liquid: the bulb and the column up to the value
+	// pt_br: líquido: o bulbo e a coluna até o valor
+	circle(platform, cx, bulbY, bulb*0.75, liquid, color.RGBA{}, 0)
+	level := position(el.value.current)
+	rectangle(platform, cx-tube*0.5, level, tube, bulbY-level, liquid)
+
+	ticks, step := scaleTicks(config.Min, config.Max, config.MajorTicks, int(math.Max(2, (bottom-top)/40)))
+	platform.SetStrokeStyle(tickColor)
+	platform.BeginPath()
+	for i, tick := range ticks {
+		platform.MoveTo(cx+tube*1.5, position(tick))
+		platform.LineTo(cx+tube*1.5+bulb*0.6, position(tick))
+		if i == len(ticks)-1 || config.MinorTicks <= 0 {
+			continue
+		}
+		for minor := 1; minor <= config.MinorTicks; minor += 1 {
+			y := position(tick + (ticks[i+1]-tick)*float64(minor)/float64(config.MinorTicks+1))
+			platform.MoveTo(cx+tube*1.5, y)
+			platform.LineTo(cx+tube*1.5+bulb*0.3, y)
+		}
+	}
+	platform.Stroke()
+
+	platform.SetFillStyle(defaultColor(config.TextColor, KDefaultTextColor))
+	for _, tick := range ticks {
+		label := formatLabel(config.Format, tick, step) + config.Unit
+		width := platform.MeasureText(label).Width
+		centerText(platform, label, cx+tube*1.5+bulb*0.8+width/2, position(tick))
+	}
+}
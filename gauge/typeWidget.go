@@ -0,0 +1,183 @@
+package gauge
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// Platform
+// en: Methods of IDraw used to draw the widgets; DrawOn() accepts any
+// implementation, like a recording or headless backend
+//
+// pt_br: Métodos de IDraw usados para desenhar os widgets; DrawOn() aceita
+// qualquer implementação, como um backend de gravação ou sem navegador
+type Platform interface {
+	BeginPath()
+	MoveTo(x, y interface{})
+	LineTo(x, y interface{})
+	ClosePath(x, y interface{})
+	Fill()
+	Stroke()
+	FillRect(x, y, width, height int)
+	SetFillStyle(value interface{})
+	SetStrokeStyle(value interface{})
+	SetLineWidth(value interface{})
+	CreateRadialGradient(x0, y0, r0, x1, y1, r1 interface{}) interface{}
+	AddColorStopPosition(gradient interface{}, stop float64, color color.RGBA)
+	Font(font font.Font)
+	MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics
+	FillText(text string, x, y int, maxWidth ...int)
+	Save()
+	Restore()
+}
+
+// Range
+// en: Colored band of a scale, like the red zone of a gauge
+//
+// pt_br: Faixa colorida de uma escala, como a zona vermelha de um mostrador
+type Range struct {
+	From  float64
+	To    float64
+	Color color.RGBA
+}
+
+var (
+	// KDefaultTextColor
+	// en: Color of the texts when the configuration has the zero color
+	//
+	// pt_br: Cor dos textos quando a configuração tem a cor zero
+	KDefaultTextColor = color.RGBA{R: 0x22, G: 0x22, B: 0x22, A: 0xff}
+
+	// KDefaultTickColor
+	// en: Color of the ticks when the configuration has the zero color
+	//
+	// pt_br: Cor das marcas quando a configuração tem a cor zero
+	KDefaultTickColor = color.RGBA{R: 0x44, G: 0x44, B: 0x44, A: 0xff}
+
+	// KDefaultFaceColor
+	// en: Background of dials and tracks when the configuration has the zero color
+	//
+	// pt_br: Fundo de mostradores e trilhos quando a configuração tem a cor zero
+	KDefaultFaceColor = color.RGBA{R: 0xf4, G: 0xf4, B: 0xf4, A: 0xff}
+
+	// KDefaultAccentColor
+	// en: Color of needles, bars and lit elements when the configuration has the
+	// zero color
+	//
+	// pt_br: Cor de ponteiros, barras e elementos acesos quando a configuração tem a
+	// cor zero
+	KDefaultAccentColor = color.RGBA{R: 0xd3, G: 0x2f, B: 0x2f, A: 0xff}
+)
+
+// widget
+// en: Identity and platforms of a widget, with the GetId(), GetPlatform() and
+// GetScratchPad() methods of the Primitive contract. The widgets do not implement
+// Primitive: GetDimensions() and GetInk() are not provided, as the Primitive
+// interface is disabled in this tree (_typeIPrimitiveInterface.go) and its
+// genericTypes module is not a dependency. Use GetBounds() and SetBounds()
+// meanwhile; SetBounds() implements layout.Target, so the widgets can be placed by
+// the layout package
+//
+// pt_br: Identidade e plataformas de um widget, com os métodos GetId(),
+// GetPlatform() e GetScratchPad() do contrato Primitive. Os widgets não
+// implementam Primitive: GetDimensions() e GetInk() não são fornecidos, pois a
+// interface Primitive está desabilitada nesta árvore (_typeIPrimitiveInterface.go)
+// e o seu módulo genericTypes não é uma dependência. Use GetBounds() e
+// SetBounds() enquanto isso; SetBounds() implementa layout.Target, assim os
+// widgets podem ser posicionados pelo pacote layout
+type widget struct {
+	id         string
+	platform   iotmakerPlatformIDraw.IDraw
+	scratchPad iotmakerPlatformIDraw.IDraw
+}
+
+// GetId
+// en: Returns the id of the widget
+//
+// pt_br: Retorna o id do widget
+func (el *widget) GetId() string {
+	return el.id
+}
+
+// GetPlatform
+// en: Returns the platform where Draw() paints
+//
+// pt_br: Retorna a plataforma onde Draw() pinta
+func (el *widget) GetPlatform() iotmakerPlatformIDraw.IDraw {
+	return el.platform
+}
+
+// GetScratchPad
+// en: Returns the scratch pad platform, used for hit tests
+//
+// pt_br: Retorna a plataforma de rascunho, usada em testes de colisão
+func (el *widget) GetScratchPad() iotmakerPlatformIDraw.IDraw {
+	return el.scratchPad
+}
+
+// square
+// en: Returns the largest square centered in the box
+//
+// pt_br: Retorna o maior quadrado centralizado na caixa
+func square(bounds collision.AABB) (x, y, size float64) {
+	size = math.Max(0, math.Min(bounds.Width, bounds.Height))
+	x = bounds.X + (bounds.Width-size)/2
+	y = bounds.Y + (bounds.Height-size)/2
+	return
+}
+
+// animation
+// en: Value that moves to its target with an ease out cubic curve
+//
+// pt_br: Valor que se move até o seu alvo com uma curva ease out cubic
+type animation struct {
+	current  float64
+	from     float64
+	target   float64
+	duration time.Duration
+	start    time.Time
+	pending  bool
+	running  bool
+}
+
+// set
+// en: Defines the target; the movement starts at the next update()
+//
+// pt_br: Define o alvo; o movimento começa no próximo update()
+func (el *animation) set(value float64, duration time.Duration) {
+	el.target = value
+	if duration <= 0 {
+		el.current, el.running, el.pending = value, false, false
+		return
+	}
+	el.from, el.duration = el.current, duration
+	el.running, el.pending = true, true
+}
+
+// update
+// en: Advances the value to the instant given; returns true when it changed
+//
+// pt_br: Avança o valor até o instante dado; retorna true quando ele mudou
+func (el *animation) update(now time.Time) bool {
+	if !el.running {
+		return false
+	}
+	if el.pending {
+		el.start, el.pending = now, false
+	}
+	progress := float64(now.Sub(el.start)) / float64(el.duration)
+	if progress >= 1 {
+		el.current, el.running = el.target, false
+		return true
+	}
+	progress = math.Max(0, progress)
+	eased := 1 - math.Pow(1-progress, 3)
+	el.current = el.from + (el.target-el.from)*eased
+	return true
+}
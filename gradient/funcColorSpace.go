@@ -0,0 +1,57 @@
+package gradient
+
+import (
+	"image/color"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+)
+
+// interpolate
+// en: Returns the color between a and b at fraction, from 0.0 to 1.0, computed in
+// the color space, with the conversions of the colorUtils package
+//
+// pt_br: Retorna a cor entre a e b na fração, de 0.0 a 1.0, calculada no espaço de
+// cor, com as conversões do pacote colorUtils
+func interpolate(a, b color.RGBA, fraction float64, space Space) color.RGBA {
+	alpha := lerp(float64(a.A), float64(b.A), fraction)
+
+	switch space {
+	case KSpaceLinearRGB:
+		red := lerp(colorUtils.ToLinear(a.R), colorUtils.ToLinear(b.R), fraction)
+		green := lerp(colorUtils.ToLinear(a.G), colorUtils.ToLinear(b.G), fraction)
+		blue := lerp(colorUtils.ToLinear(a.B), colorUtils.ToLinear(b.B), fraction)
+		return color.RGBA{R: colorUtils.FromLinear(red), G: colorUtils.FromLinear(green), B: colorUtils.FromLinear(blue), A: toByte(alpha)}
+
+	case KSpaceOKLab:
+		labA, labB := colorUtils.ToOKLab(a), colorUtils.ToOKLab(b)
+		result := colorUtils.OKLab{
+			L:     lerp(labA.L, labB.L, fraction),
+			A:     lerp(labA.A, labB.A, fraction),
+			B:     lerp(labA.B, labB.B, fraction),
+			Alpha: 1,
+		}.ToRGBA()
+		result.A = toByte(alpha)
+		return result
+	}
+
+	return color.RGBA{
+		R: toByte(lerp(float64(a.R), float64(b.R), fraction)),
+		G: toByte(lerp(float64(a.G), float64(b.G), fraction)),
+		B: toByte(lerp(float64(a.B), float64(b.B), fraction)),
+		A: toByte(alpha),
+	}
+}
+
+func lerp(a, b, fraction float64) float64 {
+	return a + (b-a)*fraction
+}
+
+func toByte(value float64) uint8 {
+	if value <= 0 {
+		return 0
+	}
+	if value >= 255 {
+		return 255
+	}
+	return uint8(value + 0.5)
+}
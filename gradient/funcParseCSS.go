@@ -0,0 +1,398 @@
+package gradient
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+)
+
+// ParseCSS
+// en: Parses a CSS gradient, like "linear-gradient(45deg, red, blue 80%)" or
+// "radial-gradient(circle at 30% 40%, white, black)"
+//
+//	Supported functions: linear-gradient(), radial-gradient(),
+//	repeating-linear-gradient() and repeating-radial-gradient()
+//	Linear: an angle (deg, rad, grad, turn) or "to <side>" / "to <corner>"
+//	Radial: circle or ellipse, a size keyword or a radius in px, and
+//	"at <position>" with keywords or percentages
+//	Both accept "in srgb", "in srgb-linear" and "in oklab"
+//	Stops: a color followed by zero, one or two positions in % or px; color
+//	hints (a position without color) are not supported
+//
+// pt_br: Interpreta um gradiente CSS, como "linear-gradient(45deg, red, blue 80%)"
+// ou "radial-gradient(circle at 30% 40%, white, black)"
+//
+//	Funções suportadas: linear-gradient(), radial-gradient(),
+//	repeating-linear-gradient() e repeating-radial-gradient()
+//	Linear: um ângulo (deg, rad, grad, turn) ou "to <lado>" / "to <canto>"
+//	Radial: circle ou ellipse, uma palavra chave de tamanho ou um raio em px, e
+//	"at <posição>" com palavras chave ou porcentagens
+//	Ambos aceitam "in srgb", "in srgb-linear" e "in oklab"
+//	Paradas: uma cor seguida de zero, uma ou duas posições em % ou px; dicas de
+//	cor (uma posição sem cor) não são suportadas
+func ParseCSS(value string) (gradient Gradient, err error) {
+	value = strings.TrimSpace(value)
+	open := strings.IndexByte(value, '(')
+	if open == -1 || !strings.HasSuffix(value, ")") {
+		return Gradient{}, fmt.Errorf("gradient: expected function at %q", value)
+	}
+
+	name := strings.ToLower(strings.TrimSpace(value[:open]))
+	if strings.HasPrefix(name, "repeating-") {
+		gradient.Repeating = true
+		name = strings.TrimPrefix(name, "repeating-")
+	}
+	switch name {
+	case "linear-gradient":
+		gradient.Kind = KLinear
+		gradient.Angle = 180
+	case "radial-gradient":
+		gradient.Kind = KRadial
+		gradient.CenterX = 0.5
+		gradient.CenterY = 0.5
+	default:
+		return Gradient{}, fmt.Errorf("gradient: unsupported function %v()", value[:open])
+	}
+
+	arguments := split(value[open+1:len(value)-1], ',')
+	if len(arguments) == 0 {
+		return Gradient{}, fmt.Errorf("gradient: %v(): missing color stops", name)
+	}
+
+	var isConfiguration bool
+	if gradient.Kind == KLinear {
+		isConfiguration, err = gradient.parseLinearConfiguration(arguments[0])
+	} else {
+		isConfiguration, err = gradient.parseRadialConfiguration(arguments[0])
+	}
+	if err != nil {
+		return Gradient{}, fmt.Errorf("gradient: %v(): %v", name, err)
+	}
+	if isConfiguration {
+		arguments = arguments[1:]
+	}
+
+	for _, argument := range arguments {
+		var stops []ColorStop
+		stops, err = parseStop(argument)
+		if err != nil {
+			return Gradient{}, fmt.Errorf("gradient: %v(): %v", name, err)
+		}
+		gradient.Stops = append(gradient.Stops, stops...)
+	}
+	if len(gradient.Stops) < 2 {
+		return Gradient{}, fmt.Errorf("gradient: %v(): at least two color stops are required", name)
+	}
+	return
+}
+
+// MustParseCSS
+// en: Same as ParseCSS(), but panics on error. Intended for constant values
+//
+// pt_br: O mesmo que ParseCSS(), mas entra em pânico em caso de erro. Destinado a
+// valores constantes
+func MustParseCSS(value string) Gradient {
+	gradient, err := ParseCSS(value)
+	if err != nil {
+		panic(err)
+	}
+	return gradient
+}
+
+// parseLinearConfiguration
+// en: Parses the first argument of linear-gradient(); returns false when it is a
+// color stop
+//
+// pt_br: Interpreta o primeiro argumento de linear-gradient(); retorna false
+// quando é uma parada de cor
+func (el *Gradient) parseLinearConfiguration(argument string) (isConfiguration bool, err error) {
+	words := strings.Fields(strings.ToLower(argument))
+	words, isConfiguration, err = el.parseSpace(words)
+	if err != nil || len(words) == 0 {
+		return
+	}
+
+	if words[0] == "to" {
+		return true, el.parseDirection(words[1:])
+	}
+
+	angle, found, err := parseAngle(words[0])
+	if err != nil || !found {
+		return isConfiguration, err
+	}
+	if len(words) != 1 {
+		return true, fmt.Errorf("unexpected %q", strings.Join(words[1:], " "))
+	}
+	el.Angle = angle
+	return true, nil
+}
+
+// parseDirection
+// en: Parses the words after "to"
+//
+// pt_br: Interpreta as palavras depois de "to"
+func (el *Gradient) parseDirection(words []string) error {
+	if len(words) == 0 || len(words) > 2 {
+		return fmt.Errorf("invalid direction %q", strings.Join(words, " "))
+	}
+
+	var horizontal, vertical int
+	for _, word := range words {
+		switch {
+		case word == "left" && horizontal == 0:
+			horizontal = -1
+		case word == "right" && horizontal == 0:
+			horizontal = 1
+		case word == "top" && vertical == 0:
+			vertical = -1
+		case word == "bottom" && vertical == 0:
+			vertical = 1
+		default:
+			return fmt.Errorf("invalid direction %q", strings.Join(words, " "))
+		}
+	}
+
+	if horizontal != 0 && vertical != 0 {
+		el.Corner = true
+		el.CornerX = horizontal
+		el.CornerY = vertical
+		return nil
+	}
+	el.Angle = math.Atan2(float64(horizontal), float64(-vertical)) * 180 / math.Pi
+	if el.Angle < 0 {
+		el.Angle += 360
+	}
+	return nil
+}
+
+// parseRadialConfiguration
+// en: Parses the first argument of radial-gradient(); returns false when it is a
+// color stop
+//
+// pt_br: Interpreta o primeiro argumento de radial-gradient(); retorna false
+// quando é uma parada de cor
+func (el *Gradient) parseRadialConfiguration(argument string) (isConfiguration bool, err error) {
+	words := strings.Fields(strings.ToLower(argument))
+	words, isConfiguration, err = el.parseSpace(words)
+	if err != nil {
+		return
+	}
+
+	for len(words) > 0 {
+		word := words[0]
+		switch word {
+		case "circle", "ellipse":
+		case "closest-side":
+			el.Size = KSizeClosestSide
+		case "farthest-side":
+			el.Size = KSizeFarthestSide
+		case "closest-corner":
+			el.Size = KSizeClosestCorner
+		case "farthest-corner":
+			el.Size = KSizeFarthestCorner
+		case "at":
+			return true, el.parsePosition(words[1:])
+		default:
+			if !strings.HasSuffix(word, "px") {
+				return isConfiguration, nil
+			}
+			var radius float64
+			radius, err = strconv.ParseFloat(strings.TrimSuffix(word, "px"), 64)
+			if err != nil || radius < 0 {
+				return true, fmt.Errorf("invalid radius %q", word)
+			}
+			el.Size = KSizeExplicit
+			el.Radius = math.Max(el.Radius, radius)
+		}
+		isConfiguration = true
+		words = words[1:]
+	}
+	return
+}
+
+// parsePosition
+// en: Parses the words after "at"
+//
+// pt_br: Interpreta as palavras depois de "at"
+func (el *Gradient) parsePosition(words []string) error {
+	if len(words) == 0 || len(words) > 2 {
+		return fmt.Errorf("invalid position %q", strings.Join(words, " "))
+	}
+	if len(words) == 1 {
+		words = append(words, "center")
+	}
+	if words[0] == "top" || words[0] == "bottom" || words[1] == "left" || words[1] == "right" {
+		words[0], words[1] = words[1], words[0]
+	}
+
+	keywords := []map[string]float64{
+		{"left": 0, "center": 0.5, "right": 1},
+		{"top": 0, "center": 0.5, "bottom": 1},
+	}
+	var values [2]float64
+	for i, word := range words {
+		if value, found := keywords[i][word]; found {
+			values[i] = value
+			continue
+		}
+		if !strings.HasSuffix(word, "%") {
+			return fmt.Errorf("invalid position %q", strings.Join(words, " "))
+		}
+		value, err := strconv.ParseFloat(strings.TrimSuffix(word, "%"), 64)
+		if err != nil {
+			return fmt.Errorf("invalid position %q", strings.Join(words, " "))
+		}
+		values[i] = value / 100
+	}
+	el.CenterX, el.CenterY = values[0], values[1]
+	return nil
+}
+
+// parseSpace
+// en: Removes "in <color space>" from the words
+//
+// pt_br: Remove "in <espaço de cor>" das palavras
+func (el *Gradient) parseSpace(words []string) (remaining []string, found bool, err error) {
+	for i := 0; i < len(words); i += 1 {
+		if words[i] != "in" {
+			continue
+		}
+		if i+1 == len(words) {
+			return words, true, fmt.Errorf("missing color space after \"in\"")
+		}
+		switch words[i+1] {
+		case "srgb":
+			el.Space = KSpaceSRGB
+		case "srgb-linear", "linear-rgb":
+			el.Space = KSpaceLinearRGB
+		case "oklab":
+			el.Space = KSpaceOKLab
+		default:
+			return words, true, fmt.Errorf("unsupported color space %q", words[i+1])
+		}
+		remaining = append(append(remaining, words[:i]...), words[i+2:]...)
+		return remaining, true, nil
+	}
+	return words, false, nil
+}
+
+// parseStop
+// en: Parses a color followed by up to two positions; two positions give two stops
+// with the same color
+//
+// pt_br: Interpreta uma cor seguida de até duas posições; duas posições geram duas
+// paradas com a mesma cor
+func parseStop(argument string) (stops []ColorStop, err error) {
+	words := split(argument, ' ')
+	if len(words) == 0 || len(words) > 3 {
+		return nil, fmt.Errorf("invalid color stop %q", argument)
+	}
+
+	stop := ColorStop{}
+	stop.Color, err = colorUtils.Parse(words[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 1 {
+		return []ColorStop{stop}, nil
+	}
+
+	for _, word := range words[1:] {
+		stop.Position, stop.Unit, err = parseStopPosition(word)
+		if err != nil {
+			return nil, err
+		}
+		stops = append(stops, stop)
+	}
+	return
+}
+
+// parseStopPosition
+// en: Parses a position in % or px
+//
+// pt_br: Interpreta uma posição em % ou px
+func parseStopPosition(word string) (position float64, unit Unit, err error) {
+	word = strings.ToLower(word)
+	switch {
+	case strings.HasSuffix(word, "%"):
+		position, err = strconv.ParseFloat(strings.TrimSuffix(word, "%"), 64)
+		position /= 100
+		unit = KUnitFraction
+	case strings.HasSuffix(word, "px"):
+		position, err = strconv.ParseFloat(strings.TrimSuffix(word, "px"), 64)
+		unit = KUnitPixel
+	default:
+		position, err = strconv.ParseFloat(word, 64)
+		if err == nil && position != 0 {
+			err = fmt.Errorf("missing unit")
+		}
+		unit = KUnitFraction
+	}
+	if err != nil {
+		return 0, KUnitAuto, fmt.Errorf("invalid position %q", word)
+	}
+	return
+}
+
+// parseAngle
+// en: Parses an angle and returns it in degrees; found is false when the word is
+// not an angle
+//
+// pt_br: Interpreta um ângulo e o retorna em graus; found é false quando a palavra
+// não é um ângulo
+func parseAngle(word string) (angle float64, found bool, err error) {
+	units := []struct {
+		suffix string
+		scale  float64
+	}{
+		{"grad", 0.9},
+		{"turn", 360},
+		{"deg", 1},
+		{"rad", 180 / math.Pi},
+	}
+	for _, unit := range units {
+		if !strings.HasSuffix(word, unit.suffix) {
+			continue
+		}
+		angle, err = strconv.ParseFloat(strings.TrimSuffix(word, unit.suffix), 64)
+		if err != nil {
+			return 0, true, fmt.Errorf("invalid angle %q", word)
+		}
+		return angle * unit.scale, true, nil
+	}
+	return 0, false, nil
+}
+
+// split
+// en: Splits the text at the separator, ignoring separators inside parentheses and
+// removing empty parts
+//
+// pt_br: Divide o texto no separador, ignorando separadores dentro de parênteses e
+// removendo partes vazias
+func split(text string, separator rune) []string {
+	parts := make([]string, 0)
+	depth := 0
+	start := 0
+	add := func(end int) {
+		part := strings.TrimSpace(text[start:end])
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	for i, character := range text {
+		switch {
+		case character == '(':
+			depth += 1
+		case character == ')':
+			depth -= 1
+		case character == separator && depth == 0:
+			add(i)
+			start = i + 1
+		}
+	}
+	add(len(text))
+	return parts
+}
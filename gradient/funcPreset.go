@@ -0,0 +1,67 @@
+package gradient
+
+import (
+	"sort"
+	"strings"
+)
+
+// presets
+// en: Named gradients, written in CSS, created by Preset()
+//
+// pt_br: Gradientes nomeados, escritos em CSS, criados por Preset()
+var presets = map[string]string{
+	"grayscale": "linear-gradient(90deg, #000000, #ffffff)",
+	"sunset":    "linear-gradient(180deg in oklab, #0b1d51, #8c2f6d 45%, #f4743b 75%, #ffd166)",
+	"ocean":     "linear-gradient(180deg in oklab, #a8edea, #2a9df4 50%, #03256c)",
+	"forest":    "linear-gradient(180deg in oklab, #d4fc79, #4caf50 50%, #1b5e20)",
+	"fire":      "linear-gradient(0deg in oklab, #5c0000, #d00000 35%, #ff8c00 70%, #ffee58)",
+	"heat":      "linear-gradient(90deg in oklab, #000080, #0000ff, #00ffff, #ffff00, #ff0000, #800000)",
+	"traffic":   "linear-gradient(90deg in oklab, #2e7d32, #fbc02d 50%, #c62828)",
+	"rainbow":   "linear-gradient(90deg, #ff0000, #ffa500, #ffff00, #008000, #0000ff, #4b0082, #ee82ee)",
+	"viridis":   "linear-gradient(90deg, #440154, #3b528b, #21918c, #5ec962, #fde725)",
+	"metal":     "linear-gradient(180deg in srgb-linear, #f5f5f5, #9e9e9e 45%, #e0e0e0 55%, #616161)",
+	"spotlight": "radial-gradient(circle at 50% 50%, #ffffff, #ffffff00 70%)",
+	"vignette":  "radial-gradient(ellipse at center, #00000000 60%, #000000b3)",
+}
+
+// Preset
+// en: Returns a copy of a named gradient; found is false for unknown names
+//
+//	name: Name listed by PresetNames(), case insensitive
+//
+// pt_br: Retorna uma cópia de um gradiente nomeado; found é false para nomes
+// desconhecidos
+//
+//	name: Nome listado por PresetNames(), sem diferenciar maiúsculas
+func Preset(name string) (gradient Gradient, found bool) {
+	value, found := presets[strings.ToLower(name)]
+	if !found {
+		return Gradient{}, false
+	}
+	return MustParseCSS(value), true
+}
+
+// PresetNames
+// en: Returns the names of the presets in alphabetical order
+//
+// pt_br: Retorna os nomes dos gradientes predefinidos em ordem alfabética
+func PresetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterPreset
+// en: Adds or replaces a named gradient, written in CSS
+//
+// pt_br: Adiciona ou substitui um gradiente nomeado, escrito em CSS
+func RegisterPreset(name, css string) error {
+	if _, err := ParseCSS(css); err != nil {
+		return err
+	}
+	presets[strings.ToLower(name)] = css
+	return nil
+}
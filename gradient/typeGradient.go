@@ -0,0 +1,563 @@
+package gradient
+
+import (
+	"image/color"
+	"math"
+	"sort"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// Kind
+// en: Kind of gradient
+//
+// pt_br: Tipo de gradiente
+type Kind int
+
+const (
+	// KLinear
+	// en: Gradient along a line, created with CreateLinearGradient()
+	//
+	// pt_br: Gradiente ao longo de uma linha, criado com CreateLinearGradient()
+	KLinear Kind = iota
+
+	// KRadial
+	// en: Gradient from a center point, created with CreateRadialGradient()
+	//
+	// pt_br: Gradiente a partir de um ponto central, criado com
+	// CreateRadialGradient()
+	KRadial
+)
+
+// Space
+// en: Color space used to interpolate the colors between two stops
+//
+// pt_br: Espaço de cor usado para interpolar as cores entre duas paradas
+type Space int
+
+const (
+	// KSpaceSRGB
+	// en: Interpolation of the sRGB values, the native behaviour of the canvas
+	//
+	// pt_br: Interpolação dos valores sRGB, o comportamento nativo do canvas
+	KSpaceSRGB Space = iota
+
+	// KSpaceLinearRGB
+	// en: Interpolation of the linear light values, avoids dark middle colors
+	//
+	// pt_br: Interpolação dos valores de luz linear, evita cores escuras no meio
+	KSpaceLinearRGB
+
+	// KSpaceOKLab
+	// en: Interpolation in the perceptual OKLab space, gives even steps of lightness
+	//
+	// pt_br: Interpolação no espaço perceptual OKLab, dá passos uniformes de
+	// luminosidade
+	KSpaceOKLab
+)
+
+// Unit
+// en: Unit of the position of a color stop
+//
+// pt_br: Unidade da posição de uma parada de cor
+type Unit int
+
+const (
+	// KUnitAuto
+	// en: Position not informed, calculated between the neighbour stops as in CSS
+	//
+	// pt_br: Posição não informada, calculada entre as paradas vizinhas como no CSS
+	KUnitAuto Unit = iota
+
+	// KUnitFraction
+	// en: Position from 0.0 (start) to 1.0 (end) of the gradient
+	//
+	// pt_br: Posição de 0.0 (início) a 1.0 (fim) do gradiente
+	KUnitFraction
+
+	// KUnitPixel
+	// en: Position in pixels from the start of the gradient
+	//
+	// pt_br: Posição em pixels a partir do início do gradiente
+	KUnitPixel
+)
+
+// Size
+// en: Size of the ending shape of a radial gradient, as in CSS
+//
+// pt_br: Tamanho da forma final de um gradiente radial, como no CSS
+type Size int
+
+const (
+	KSizeFarthestCorner Size = iota
+	KSizeClosestSide
+	KSizeClosestCorner
+	KSizeFarthestSide
+
+	// KSizeExplicit
+	// en: Uses the value of Gradient.Radius
+	//
+	// pt_br: Usa o valor de Gradient.Radius
+	KSizeExplicit
+)
+
+// KSamplesPerSegment
+// en: Number of extra color stops added between two stops when the interpolation
+// space is not KSpaceSRGB
+//
+// pt_br: Número de paradas de cor extras adicionadas entre duas paradas quando o
+// espaço de interpolação não é KSpaceSRGB
+const KSamplesPerSegment = 8
+
+// KMaxRepetitions
+// en: Maximum number of periods expanded by a repeating gradient. A period so small
+// that more repetitions are needed is finer than the pixels of any usual drawing,
+// so the gradient is replaced by the average color of the period
+//
+// pt_br: Número máximo de períodos expandidos por um gradiente repetido. Um período
+// tão pequeno que precise de mais repetições é mais fino do que os pixels de
+// qualquer desenho comum, assim o gradiente é substituído pela cor média do período
+const KMaxRepetitions = 1024
+
+// ColorStop
+// en: Color of the gradient at a position
+//
+// pt_br: Cor do gradiente em uma posição
+type ColorStop struct {
+	Color    color.RGBA
+	Position float64
+	Unit     Unit
+}
+
+// Gradient
+// en: Description of a gradient, independent of the size of the drawing. Create it
+// with ParseCSS(), Preset() or as a literal, and call Apply() to create the
+// platform gradient for a rectangle
+//
+//	Kind: KLinear or KRadial
+//	Angle: Direction of a linear gradient in degrees, as in CSS: 0 points up, 90
+//	       points right and 180 (the default of CSS) points down
+//	Corner: When true, the linear gradient points to the corner given by
+//	        CornerX and CornerY, as in "to top right", and Angle is ignored
+//	CornerX: -1 for left, 1 for right
+//	CornerY: -1 for top, 1 for bottom
+//	CenterX, CenterY: Center of a radial gradient, 0.0 to 1.0 of the rectangle
+//	Size: Size of a radial gradient
+//	Radius: Radius, in pixels, used with KSizeExplicit
+//	Repeating: When true the stops repeat along the whole gradient
+//	Space: Color space used for interpolation
+//	Stops: Color stops
+//
+// pt_br: Descrição de um gradiente, independente do tamanho do desenho. Crie com
+// ParseCSS(), Preset() ou como um literal, e chame Apply() para criar o gradiente
+// da plataforma para um retângulo
+//
+//	Kind: KLinear ou KRadial
+//	Angle: Direção de um gradiente linear em graus, como no CSS: 0 aponta para
+//	       cima, 90 aponta para a direita e 180 (o padrão do CSS) aponta para
+//	       baixo
+//	Corner: Quando true, o gradiente linear aponta para o canto dado por CornerX
+//	        e CornerY, como em "to top right", e Angle é ignorado
+//	CornerX: -1 para esquerda, 1 para direita
+//	CornerY: -1 para cima, 1 para baixo
+//	CenterX, CenterY: Centro de um gradiente radial, 0.0 a 1.0 do retângulo
+//	Size: Tamanho de um gradiente radial
+//	Radius: Raio em pixels, usado com KSizeExplicit
+//	Repeating: Quando true as paradas se repetem ao longo de todo o gradiente
+//	Space: Espaço de cor usado na interpolação
+//	Stops: Paradas de cor
+type Gradient struct {
+	Kind      Kind
+	Angle     float64
+	Corner    bool
+	CornerX   int
+	CornerY   int
+	CenterX   float64
+	CenterY   float64
+	Size      Size
+	Radius    float64
+	Repeating bool
+	Space     Space
+	Stops     []ColorStop
+}
+
+// NewLinear
+// en: Returns a linear gradient with the angle, in degrees, and colors evenly
+// distributed
+//
+// pt_br: Retorna um gradiente linear com o ângulo, em graus, e cores distribuídas
+// uniformemente
+func NewLinear(angle float64, colors ...color.RGBA) Gradient {
+	gradient := Gradient{Kind: KLinear, Angle: angle}
+	for _, stopColor := range colors {
+		gradient.Stops = append(gradient.Stops, ColorStop{Color: stopColor})
+	}
+	return gradient
+}
+
+// NewRadial
+// en: Returns a circular radial gradient centered on the rectangle, with colors
+// evenly distributed up to the farthest corner
+//
+// pt_br: Retorna um gradiente radial circular centralizado no retângulo, com cores
+// distribuídas uniformemente até o canto mais distante
+func NewRadial(colors ...color.RGBA) Gradient {
+	gradient := Gradient{Kind: KRadial, CenterX: 0.5, CenterY: 0.5}
+	for _, stopColor := range colors {
+		gradient.Stops = append(gradient.Stops, ColorStop{Color: stopColor})
+	}
+	return gradient
+}
+
+// Apply
+// en: Creates the gradient on the platform for the rectangle and adds the color
+// stops. The returned value is accepted by SetFillStyle() and SetStrokeStyle()
+//
+//	platform: Any IDraw, or another ICanvasGradient
+//	x, y, width, height: Rectangle painted by the gradient
+//
+//	Note: the canvas only draws circular radial gradients, so elliptical CSS
+//	gradients use a circle with the larger radius of the ellipse
+//
+// pt_br: Cria o gradiente na plataforma para o retângulo e adiciona as paradas de
+// cor. O valor retornado é aceito por SetFillStyle() e SetStrokeStyle()
+//
+//	platform: Qualquer IDraw, ou outro ICanvasGradient
+//	x, y, width, height: Retângulo pintado pelo gradiente
+//
+//	Nota: o canvas desenha apenas gradientes radiais circulares, então
+//	gradientes CSS elípticos usam um círculo com o maior raio da elipse
+func (el Gradient) Apply(platform iotmakerPlatformIDraw.ICanvasGradient, x, y, width, height float64) interface{} {
+	var handle interface{}
+	var length float64
+
+	switch el.Kind {
+	case KRadial:
+		centerX := x + el.CenterX*width
+		centerY := y + el.CenterY*height
+		length = el.radialRadius(centerX-x, centerY-y, width, height)
+		handle = platform.CreateRadialGradient(centerX, centerY, 0, centerX, centerY, length)
+	default:
+		var x0, y0, x1, y1 float64
+		x0, y0, x1, y1, length = el.linearLine(x, y, width, height)
+		handle = platform.CreateLinearGradient(x0, y0, x1, y1)
+	}
+
+	for _, stop := range el.Resolve(length) {
+		platform.AddColorStopPosition(handle, stop.Position, stop.Color)
+	}
+	return handle
+}
+
+// ApplyFill
+// en: Creates the gradient with Apply() and sets it as fill style
+//
+// pt_br: Cria o gradiente com Apply() e o define como estilo de preenchimento
+func (el Gradient) ApplyFill(platform iotmakerPlatformIDraw.ICanvasGradient, x, y, width, height float64) {
+	platform.SetFillStyle(el.Apply(platform, x, y, width, height))
+}
+
+// ApplyStroke
+// en: Creates the gradient with Apply() and sets it as stroke style
+//
+// pt_br: Cria o gradiente com Apply() e o define como estilo de contorno
+func (el Gradient) ApplyStroke(platform iotmakerPlatformIDraw.ICanvasGradient, x, y, width, height float64) {
+	platform.SetStrokeStyle(el.Apply(platform, x, y, width, height))
+}
+
+// Resolve
+// en: Returns the color stops ready for AddColorStopPosition(): positions from 0.0
+// to 1.0 in increasing order, repetition expanded and extra stops added for
+// interpolation spaces other than sRGB
+//
+//	length: Length of the gradient line (or radius), in pixels, used by stops
+//	        with KUnitPixel
+//
+// pt_br: Retorna as paradas de cor prontas para AddColorStopPosition(): posições
+// de 0.0 a 1.0 em ordem crescente, repetição expandida e paradas extras adicionadas
+// para espaços de interpolação diferentes de sRGB
+//
+//	length: Comprimento da linha do gradiente (ou raio) em pixels, usado pelas
+//	        paradas com KUnitPixel
+func (el Gradient) Resolve(length float64) []ColorStop {
+	stops := el.fixPositions(length)
+	if len(stops) == 0 {
+		return stops
+	}
+
+	if el.Repeating {
+		stops = repeat(stops)
+	} else {
+		stops = clip(stops)
+	}
+
+	if el.Space == KSpaceSRGB {
+		return stops
+	}
+	expanded := make([]ColorStop, 0, len(stops)*KSamplesPerSegment)
+	for i := 0; i < len(stops)-1; i += 1 {
+		expanded = append(expanded, stops[i])
+		if stops[i+1].Position == stops[i].Position {
+			continue
+		}
+		for sample := 1; sample < KSamplesPerSegment; sample += 1 {
+			fraction := float64(sample) / KSamplesPerSegment
+			expanded = append(expanded, ColorStop{
+				Color:    interpolate(stops[i].Color, stops[i+1].Color, fraction, el.Space),
+				Position: stops[i].Position + (stops[i+1].Position-stops[i].Position)*fraction,
+				Unit:     KUnitFraction,
+			})
+		}
+	}
+	return append(expanded, stops[len(stops)-1])
+}
+
+// ColorAt
+// en: Returns the color of the gradient at a position from 0.0 to 1.0, using the
+// interpolation space of the gradient
+//
+// pt_br: Retorna a cor do gradiente em uma posição de 0.0 a 1.0, usando o espaço de
+// interpolação do gradiente
+func (el Gradient) ColorAt(position float64) color.RGBA {
+	stops := el.fixPositions(1)
+	if el.Repeating {
+		stops = repeat(stops)
+	}
+	return sample(stops, position, el.Space)
+}
+
+// fixPositions
+// en: Converts every position to a fraction and fills the missing ones, following
+// the rules of CSS: the first stop defaults to 0, the last to 1, stops without
+// position are evenly spaced and a position smaller than the previous one is
+// raised to it
+//
+// pt_br: Converte todas as posições para frações e preenche as que faltam,
+// seguindo as regras do CSS: a primeira parada tem padrão 0, a última 1, paradas
+// sem posição são espaçadas uniformemente e uma posição menor do que a anterior é
+// elevada até ela
+func (el Gradient) fixPositions(length float64) []ColorStop {
+	stops := append([]ColorStop{}, el.Stops...)
+	if len(stops) == 0 {
+		return stops
+	}
+	if len(stops) == 1 {
+		stops = append(stops, stops[0])
+		stops[1].Unit = KUnitAuto
+	}
+
+	for i := range stops {
+		if stops[i].Unit == KUnitPixel {
+			if length > 0 {
+				stops[i].Position = stops[i].Position / length
+			} else {
+				stops[i].Position = 0
+			}
+			stops[i].Unit = KUnitFraction
+		}
+	}
+	if stops[0].Unit == KUnitAuto {
+		stops[0].Position = 0
+		stops[0].Unit = KUnitFraction
+	}
+	last := len(stops) - 1
+	if stops[last].Unit == KUnitAuto {
+		stops[last].Position = math.Max(1, stops[0].Position)
+		stops[last].Unit = KUnitFraction
+	}
+
+	maximum := stops[0].Position
+	for i := range stops {
+		if stops[i].Unit == KUnitAuto {
+			continue
+		}
+		if stops[i].Position < maximum {
+			stops[i].Position = maximum
+		}
+		maximum = stops[i].Position
+	}
+
+	for i := 1; i < last; i += 1 {
+		if stops[i].Unit != KUnitAuto {
+			continue
+		}
+		end := i
+		for stops[end].Unit == KUnitAuto {
+			end += 1
+		}
+		start := i - 1
+		step := (stops[end].Position - stops[start].Position) / float64(end-start)
+		for j := i; j < end; j += 1 {
+			stops[j].Position = stops[start].Position + step*float64(j-start)
+			stops[j].Unit = KUnitFraction
+		}
+	}
+	return stops
+}
+
+// linearLine
+// en: Returns the gradient line of CSS: it passes through the center of the
+// rectangle and its ends are the points where the perpendicular lines touch the
+// corners
+//
+// pt_br: Retorna a linha de gradiente do CSS: ela passa pelo centro do retângulo e
+// as suas pontas são os pontos onde as linhas perpendiculares tocam os cantos
+func (el Gradient) linearLine(x, y, width, height float64) (x0, y0, x1, y1, length float64) {
+	angle := el.Angle * math.Pi / 180
+	directionX, directionY := math.Sin(angle), -math.Cos(angle)
+	if el.Corner {
+		directionX = float64(el.CornerX) * height
+		directionY = float64(el.CornerY) * width
+		size := math.Hypot(directionX, directionY)
+		if size != 0 {
+			directionX, directionY = directionX/size, directionY/size
+		}
+	}
+
+	length = math.Abs(width*directionX) + math.Abs(height*directionY)
+	centerX, centerY := x+width/2, y+height/2
+	x0 = centerX - directionX*length/2
+	y0 = centerY - directionY*length/2
+	x1 = centerX + directionX*length/2
+	y1 = centerY + directionY*length/2
+	return
+}
+
+// radialRadius
+// en: Returns the radius of the ending circle for the size keyword
+//
+// pt_br: Retorna o raio do círculo final para a palavra chave de tamanho
+func (el Gradient) radialRadius(centerX, centerY, width, height float64) float64 {
+	left, right := math.Abs(centerX), math.Abs(width-centerX)
+	top, bottom := math.Abs(centerY), math.Abs(height-centerY)
+	corners := []float64{
+		math.Hypot(left, top), math.Hypot(right, top),
+		math.Hypot(left, bottom), math.Hypot(right, bottom),
+	}
+	sort.Float64s(corners)
+
+	switch el.Size {
+	case KSizeExplicit:
+		return el.Radius
+	case KSizeClosestSide:
+		return math.Min(math.Min(left, right), math.Min(top, bottom))
+	case KSizeFarthestSide:
+		return math.Max(math.Max(left, right), math.Max(top, bottom))
+	case KSizeClosestCorner:
+		return corners[0]
+	}
+	return corners[3]
+}
+
+// repeat
+// en: Expands the stops of a repeating gradient over the range from 0.0 to 1.0
+//
+// pt_br: Expande as paradas de um gradiente repetido sobre a faixa de 0.0 a 1.0
+func repeat(stops []ColorStop) []ColorStop {
+	first := stops[0].Position
+	period := stops[len(stops)-1].Position - first
+	if period <= 0 {
+		return clip(stops)
+	}
+
+	start := first - math.Ceil(first/period)*period
+	count := math.Ceil((1 - start) / period)
+	if count > KMaxRepetitions {
+		average := averageColor(stops)
+		return []ColorStop{
+			{Color: average, Position: 0, Unit: KUnitFraction},
+			{Color: average, Position: 1, Unit: KUnitFraction},
+		}
+	}
+
+	list := make([]ColorStop, 0, int(count)*len(stops))
+	for i := 0; i < int(count); i += 1 {
+		offset := start + float64(i)*period
+		for _, stop := range stops {
+			stop.Position = stop.Position - first + offset
+			list = append(list, stop)
+		}
+	}
+	return clip(list)
+}
+
+// averageColor
+// en: Returns the average color of the stops over their range, in sRGB
+//
+// pt_br: Retorna a cor média das paradas sobre a sua faixa, em sRGB
+func averageColor(stops []ColorStop) color.RGBA {
+	const samples = 64
+	first := stops[0].Position
+	period := stops[len(stops)-1].Position - first
+	var red, green, blue, alpha float64
+	for i := 0; i < samples; i += 1 {
+		value := sample(stops, first+period*(float64(i)+0.5)/samples, KSpaceSRGB)
+		red += float64(value.R)
+		green += float64(value.G)
+		blue += float64(value.B)
+		alpha += float64(value.A)
+	}
+	return color.RGBA{
+		R: uint8(math.Round(red / samples)),
+		G: uint8(math.Round(green / samples)),
+		B: uint8(math.Round(blue / samples)),
+		A: uint8(math.Round(alpha / samples)),
+	}
+}
+
+// clip
+// en: Limits the stops to the range from 0.0 to 1.0, adding stops with the
+// interpolated color at the limits
+//
+// pt_br: Limita as paradas à faixa de 0.0 a 1.0, adicionando paradas com a cor
+// interpolada nos limites
+func clip(stops []ColorStop) []ColorStop {
+	result := make([]ColorStop, 0, len(stops)+2)
+	if stops[0].Position > 0 || stops[len(stops)-1].Position < 0 {
+		result = append(result, ColorStop{Color: sample(stops, 0, KSpaceSRGB), Position: 0, Unit: KUnitFraction})
+	}
+	for i, stop := range stops {
+		if stop.Position < 0 && i+1 < len(stops) && stops[i+1].Position > 0 {
+			result = append(result, ColorStop{Color: sample(stops, 0, KSpaceSRGB), Position: 0, Unit: KUnitFraction})
+			continue
+		}
+		if stop.Position > 1 && i > 0 && stops[i-1].Position < 1 {
+			result = append(result, ColorStop{Color: sample(stops, 1, KSpaceSRGB), Position: 1, Unit: KUnitFraction})
+			continue
+		}
+		if stop.Position < 0 || stop.Position > 1 {
+			continue
+		}
+		result = append(result, stop)
+	}
+	if result[len(result)-1].Position < 1 && stops[len(stops)-1].Position > 1 {
+		result = append(result, ColorStop{Color: sample(stops, 1, KSpaceSRGB), Position: 1, Unit: KUnitFraction})
+	}
+	return result
+}
+
+// sample
+// en: Returns the color at the position, keeping the first and the last colors
+// outside the stops
+//
+// pt_br: Retorna a cor na posição, mantendo a primeira e a última cor fora das
+// paradas
+func sample(stops []ColorStop, position float64, space Space) color.RGBA {
+	if len(stops) == 0 {
+		return color.RGBA{}
+	}
+	if position <= stops[0].Position {
+		return stops[0].Color
+	}
+	for i := 1; i < len(stops); i += 1 {
+		if position > stops[i].Position {
+			continue
+		}
+		distance := stops[i].Position - stops[i-1].Position
+		if distance == 0 {
+			return stops[i].Color
+		}
+		return interpolate(stops[i-1].Color, stops[i].Color, (position-stops[i-1].Position)/distance, space)
+	}
+	return stops[len(stops)-1].Color
+}
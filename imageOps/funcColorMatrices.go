@@ -0,0 +1,126 @@
+package imageOps
+
+import "math"
+
+// NewBrightnessMatrix
+// en: Returns the matrix that multiplies the color channels by amount; 0 is black
+// and 1 keeps the colors
+//
+// pt_br: Retorna a matriz que multiplica os canais de cor por amount; 0 é preto e 1
+// mantém as cores
+func NewBrightnessMatrix(amount float64) ColorMatrix {
+	return ColorMatrix{
+		amount, 0, 0, 0, 0,
+		0, amount, 0, 0, 0,
+		0, 0, amount, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// NewContrastMatrix
+// en: Returns the matrix that changes the contrast around the middle gray; 0 is
+// gray and 1 keeps the colors
+//
+// pt_br: Retorna a matriz que muda o contraste em volta do cinza médio; 0 é cinza e
+// 1 mantém as cores
+func NewContrastMatrix(amount float64) ColorMatrix {
+	intercept := 0.5 - 0.5*amount
+	return ColorMatrix{
+		amount, 0, 0, 0, intercept,
+		0, amount, 0, 0, intercept,
+		0, 0, amount, 0, intercept,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// NewGrayscaleMatrix
+// en: Returns the matrix that converts to gray; 0 keeps the colors and 1 is
+// completely gray
+//
+// pt_br: Retorna a matriz que converte para cinza; 0 mantém as cores e 1 é
+// completamente cinza
+func NewGrayscaleMatrix(amount float64) ColorMatrix {
+	amount = 1 - math.Min(amount, 1)
+	return ColorMatrix{
+		0.2126 + 0.7874*amount, 0.7152 - 0.7152*amount, 0.0722 - 0.0722*amount, 0, 0,
+		0.2126 - 0.2126*amount, 0.7152 + 0.2848*amount, 0.0722 - 0.0722*amount, 0, 0,
+		0.2126 - 0.2126*amount, 0.7152 - 0.7152*amount, 0.0722 + 0.9278*amount, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// NewSepiaMatrix
+// en: Returns the matrix that converts to sepia; 0 keeps the colors and 1 is
+// completely sepia
+//
+// pt_br: Retorna a matriz que converte para sépia; 0 mantém as cores e 1 é
+// completamente sépia
+func NewSepiaMatrix(amount float64) ColorMatrix {
+	amount = 1 - math.Min(amount, 1)
+	return ColorMatrix{
+		0.393 + 0.607*amount, 0.769 - 0.769*amount, 0.189 - 0.189*amount, 0, 0,
+		0.349 - 0.349*amount, 0.686 + 0.314*amount, 0.168 - 0.168*amount, 0, 0,
+		0.272 - 0.272*amount, 0.534 - 0.534*amount, 0.131 + 0.869*amount, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// NewSaturateMatrix
+// en: Returns the matrix that changes the saturation; 0 is gray, 1 keeps the colors
+// and values above 1 make the colors stronger
+//
+// pt_br: Retorna a matriz que muda a saturação; 0 é cinza, 1 mantém as cores e
+// valores acima de 1 tornam as cores mais fortes
+func NewSaturateMatrix(amount float64) ColorMatrix {
+	return ColorMatrix{
+		0.213 + 0.787*amount, 0.715 - 0.715*amount, 0.072 - 0.072*amount, 0, 0,
+		0.213 - 0.213*amount, 0.715 + 0.285*amount, 0.072 - 0.072*amount, 0, 0,
+		0.213 - 0.213*amount, 0.715 - 0.715*amount, 0.072 + 0.928*amount, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// NewHueRotateMatrix
+// en: Returns the matrix that rotates the hue of the colors by angle degrees
+//
+// pt_br: Retorna a matriz que gira o matiz das cores em angle graus
+func NewHueRotateMatrix(angle float64) ColorMatrix {
+	radians := angle * math.Pi / 180
+	cos, sin := math.Cos(radians), math.Sin(radians)
+	return ColorMatrix{
+		0.213 + cos*0.787 - sin*0.213, 0.715 - cos*0.715 - sin*0.715, 0.072 - cos*0.072 + sin*0.928, 0, 0,
+		0.213 - cos*0.213 + sin*0.143, 0.715 + cos*0.285 + sin*0.140, 0.072 - cos*0.072 - sin*0.283, 0, 0,
+		0.213 - cos*0.213 - sin*0.787, 0.715 - cos*0.715 + sin*0.715, 0.072 + cos*0.928 + sin*0.072, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// NewInvertMatrix
+// en: Returns the matrix that inverts the colors; 0 keeps the colors and 1 is
+// completely inverted
+//
+// pt_br: Retorna a matriz que inverte as cores; 0 mantém as cores e 1 é
+// completamente invertida
+func NewInvertMatrix(amount float64) ColorMatrix {
+	amount = math.Min(amount, 1)
+	scale := 1 - 2*amount
+	return ColorMatrix{
+		scale, 0, 0, 0, amount,
+		0, scale, 0, 0, amount,
+		0, 0, scale, 0, amount,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// NewOpacityMatrix
+// en: Returns the matrix that multiplies the alpha channel by amount
+//
+// pt_br: Retorna a matriz que multiplica o canal alpha por amount
+func NewOpacityMatrix(amount float64) ColorMatrix {
+	return ColorMatrix{
+		1, 0, 0, 0, 0,
+		0, 1, 0, 0, 0,
+		0, 0, 1, 0, 0,
+		0, 0, 0, math.Min(amount, 1), 0,
+	}
+}
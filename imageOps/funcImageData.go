@@ -0,0 +1,91 @@
+package imageOps
+
+import (
+	"image"
+	"image/color"
+)
+
+// FromImageData
+// en: Converts image data in the format of IDraw.GetImageData() into an
+// *image.NRGBA, the same memory layout of the canvas pixel buffer (RGBA bytes,
+// alpha not premultiplied)
+//
+//	data: map[x][y]color.RGBA
+//	x, y: Coordinate of the upper-left pixel of data
+//	width, height: Size of the area
+//
+// pt_br: Converte dados de imagem no formato de IDraw.GetImageData() em um
+// *image.NRGBA, o mesmo formato de memória dos pixels do canvas (bytes RGBA, alpha
+// não pré-multiplicado)
+//
+//	data: map[x][y]color.RGBA
+//	x, y: Coordenada do pixel superior esquerdo de data
+//	width, height: Tamanho da área
+func FromImageData(data map[int]map[int]color.RGBA, x, y, width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for pixelX := 0; pixelX < width; pixelX += 1 {
+		column := data[x+pixelX]
+		for pixelY := 0; pixelY < height; pixelY += 1 {
+			pixel := column[y+pixelY]
+			offset := img.PixOffset(pixelX, pixelY)
+			img.Pix[offset+0] = pixel.R
+			img.Pix[offset+1] = pixel.G
+			img.Pix[offset+2] = pixel.B
+			img.Pix[offset+3] = pixel.A
+		}
+	}
+	return img
+}
+
+// ToImageData
+// en: Converts an *image.NRGBA into image data in the format of
+// IDraw.GetImageData()
+//
+//	x, y: Coordinate given to the upper-left pixel of the image in the map
+//
+// pt_br: Converte um *image.NRGBA em dados de imagem no formato de
+// IDraw.GetImageData()
+//
+//	x, y: Coordenada dada ao pixel superior esquerdo da imagem no mapa
+func ToImageData(img *image.NRGBA, x, y int) map[int]map[int]color.RGBA {
+	bounds := img.Bounds()
+	data := make(map[int]map[int]color.RGBA, bounds.Dx())
+	for pixelX := bounds.Min.X; pixelX < bounds.Max.X; pixelX += 1 {
+		column := make(map[int]color.RGBA, bounds.Dy())
+		for pixelY := bounds.Min.Y; pixelY < bounds.Max.Y; pixelY += 1 {
+			offset := img.PixOffset(pixelX, pixelY)
+			column[y+pixelY-bounds.Min.Y] = color.RGBA{
+				R: img.Pix[offset+0],
+				G: img.Pix[offset+1],
+				B: img.Pix[offset+2],
+				A: img.Pix[offset+3],
+			}
+		}
+		data[x+pixelX-bounds.Min.X] = column
+	}
+	return data
+}
+
+// FromPixels
+// en: Wraps the bytes of a canvas ImageData.data (RGBA, alpha not premultiplied)
+// without copying them
+//
+// pt_br: Envolve os bytes de um ImageData.data do canvas (RGBA, alpha não
+// pré-multiplicado) sem copiá-los
+func FromPixels(pixels []uint8, width, height int) *image.NRGBA {
+	return &image.NRGBA{Pix: pixels, Stride: width * 4, Rect: image.Rect(0, 0, width, height)}
+}
+
+func luminance(red, green, blue uint8) float64 {
+	return 0.2126*float64(red) + 0.7152*float64(green) + 0.0722*float64(blue)
+}
+
+func clampByte(value float64) uint8 {
+	if value <= 0 {
+		return 0
+	}
+	if value >= 255 {
+		return 255
+	}
+	return uint8(value + 0.5)
+}
@@ -0,0 +1,38 @@
+package imageOps
+
+import (
+	"runtime"
+	"sync"
+)
+
+// parallelRows
+// en: Splits the rows from 0 to height in blocks and runs process for each block in
+// its own goroutine, one goroutine per processor
+//
+// pt_br: Divide as linhas de 0 a height em blocos e executa process para cada bloco
+// na sua própria goroutine, uma goroutine por processador
+func parallelRows(height int, process func(startY, endY int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > height {
+		workers = height
+	}
+	if workers <= 1 {
+		process(0, height)
+		return
+	}
+
+	block := (height + workers - 1) / workers
+	var wait sync.WaitGroup
+	for startY := 0; startY < height; startY += block {
+		endY := startY + block
+		if endY > height {
+			endY = height
+		}
+		wait.Add(1)
+		go func(startY, endY int) {
+			defer wait.Done()
+			process(startY, endY)
+		}(startY, endY)
+	}
+	wait.Wait()
+}
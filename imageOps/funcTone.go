@@ -0,0 +1,146 @@
+package imageOps
+
+import (
+	"image"
+	"math"
+)
+
+// Threshold
+// en: Converts the image in place to black and white. Pixels with luminance greater
+// than or equal to level become white, the others become black; alpha is kept
+//
+//	level: Luminance from 0 to 255
+//
+// pt_br: Converte a imagem no lugar para preto e branco. Pixels com luminância
+// maior ou igual a level ficam brancos, os outros ficam pretos; o alpha é mantido
+//
+//	level: Luminância de 0 a 255
+func Threshold(img *image.NRGBA, level uint8) {
+	forEachPixel(img, func(pixel []uint8) {
+		value := uint8(0)
+		if luminance(pixel[0], pixel[1], pixel[2]) >= float64(level) {
+			value = 255
+		}
+		pixel[0], pixel[1], pixel[2] = value, value, value
+	})
+}
+
+// Posterize
+// en: Reduces, in place, the number of values of each color channel
+//
+//	levels: Number of values per channel, from 2 to 256
+//
+// pt_br: Reduz, no lugar, o número de valores de cada canal de cor
+//
+//	levels: Número de valores por canal, de 2 a 256
+func Posterize(img *image.NRGBA, levels int) {
+	if levels < 2 {
+		levels = 2
+	}
+	if levels > 256 {
+		levels = 256
+	}
+	var table [256]uint8
+	step := 255 / float64(levels-1)
+	for value := range table {
+		table[value] = clampByte(math.Round(float64(value)/step) * step)
+	}
+	forEachPixel(img, func(pixel []uint8) {
+		pixel[0], pixel[1], pixel[2] = table[pixel[0]], table[pixel[1]], table[pixel[2]]
+	})
+}
+
+// LevelsOptions
+// en: Parameters of Levels()
+//
+//	InputBlack: Input value mapped to OutputBlack; lower values are clipped
+//	InputWhite: Input value mapped to OutputWhite; higher values are clipped
+//	Gamma: Correction of the middle tones; 1 is linear, values above 1 make the
+//	       image brighter. Zero is treated as 1
+//	OutputBlack: Darkest output value
+//	OutputWhite: Brightest output value
+//
+// pt_br: Parâmetros de Levels()
+//
+//	InputBlack: Valor de entrada mapeado para OutputBlack; valores menores são
+//	            cortados
+//	InputWhite: Valor de entrada mapeado para OutputWhite; valores maiores são
+//	            cortados
+//	Gamma: Correção dos meios tons; 1 é linear, valores acima de 1 tornam a
+//	       imagem mais clara. Zero é tratado como 1
+//	OutputBlack: Valor de saída mais escuro
+//	OutputWhite: Valor de saída mais claro
+type LevelsOptions struct {
+	InputBlack  uint8
+	InputWhite  uint8
+	Gamma       float64
+	OutputBlack uint8
+	OutputWhite uint8
+}
+
+// Levels
+// en: Remaps, in place, the tones of the color channels, like the levels tool of
+// image editors
+//
+// pt_br: Remapeia, no lugar, os tons dos canais de cor, como a ferramenta de níveis
+// dos editores de imagem
+func Levels(img *image.NRGBA, options LevelsOptions) {
+	gamma := options.Gamma
+	if gamma <= 0 {
+		gamma = 1
+	}
+	inputRange := float64(options.InputWhite) - float64(options.InputBlack)
+	if inputRange <= 0 {
+		inputRange = 1
+	}
+	outputRange := float64(options.OutputWhite) - float64(options.OutputBlack)
+
+	var table [256]uint8
+	for value := range table {
+		normalized := (float64(value) - float64(options.InputBlack)) / inputRange
+		normalized = math.Max(0, math.Min(1, normalized))
+		normalized = math.Pow(normalized, 1/gamma)
+		table[value] = clampByte(float64(options.OutputBlack) + normalized*outputRange)
+	}
+	forEachPixel(img, func(pixel []uint8) {
+		pixel[0], pixel[1], pixel[2] = table[pixel[0]], table[pixel[1]], table[pixel[2]]
+	})
+}
+
+// AutoLevels
+// en: Stretches the tones of the image in place so the darkest and the brightest
+// luminance use the full range, ignoring a fraction of the extreme pixels
+//
+//	clip: Fraction, from 0 to 0.5, of the pixels ignored on each side of the
+//	      histogram; 0.005 is a good value for camera snapshots
+//
+// pt_br: Estica os tons da imagem no lugar para que a luminância mais escura e a
+// mais clara usem toda a faixa, ignorando uma fração dos pixels extremos
+//
+//	clip: Fração, de 0 a 0.5, dos pixels ignorados em cada lado do histograma;
+//	      0.005 é um bom valor para fotos de câmeras
+func AutoLevels(img *image.NRGBA, clip float64) {
+	histogram := NewHistogram(img)
+	black := histogram.Percentile(histogram.Luminance, clip)
+	white := histogram.Percentile(histogram.Luminance, 1-clip)
+	if white <= black {
+		return
+	}
+	Levels(img, LevelsOptions{InputBlack: black, InputWhite: white, Gamma: 1, OutputBlack: 0, OutputWhite: 255})
+}
+
+// forEachPixel
+// en: Calls change for every pixel of the image, in parallel by rows
+//
+// pt_br: Chama change para cada pixel da imagem, em paralelo por linhas
+func forEachPixel(img *image.NRGBA, change func(pixel []uint8)) {
+	bounds := img.Bounds()
+	parallelRows(bounds.Dy(), func(startY, endY int) {
+		for y := bounds.Min.Y + startY; y < bounds.Min.Y+endY; y += 1 {
+			for x := bounds.Min.X; x < bounds.Max.X; x += 1 {
+				offset := img.PixOffset(x, y)
+				change(img.Pix[offset : offset+4 : offset+4])
+			}
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package imageOps
+
+import (
+	"image"
+)
+
+// ColorMatrix
+// en: 5x4 color matrix, in row order, applied to values from 0 to 1 with the alpha
+// not premultiplied, as in the feColorMatrix of SVG
+//
+//	R' = m[0]*R  + m[1]*G  + m[2]*B  + m[3]*A  + m[4]
+//	G' = m[5]*R  + m[6]*G  + m[7]*B  + m[8]*A  + m[9]
+//	B' = m[10]*R + m[11]*G + m[12]*B + m[13]*A + m[14]
+//	A' = m[15]*R + m[16]*G + m[17]*B + m[18]*A + m[19]
+//
+// pt_br: Matriz de cor 5x4, em ordem de linhas, aplicada a valores de 0 a 1 com
+// alpha não pré-multiplicado, como no feColorMatrix do SVG
+//
+//	R' = m[0]*R  + m[1]*G  + m[2]*B  + m[3]*A  + m[4]
+//	G' = m[5]*R  + m[6]*G  + m[7]*B  + m[8]*A  + m[9]
+//	B' = m[10]*R + m[11]*G + m[12]*B + m[13]*A + m[14]
+//	A' = m[15]*R + m[16]*G + m[17]*B + m[18]*A + m[19]
+type ColorMatrix [20]float64
+
+// NewIdentityMatrix
+// en: Returns the matrix that keeps the colors
+//
+// pt_br: Retorna a matriz que mantém as cores
+func NewIdentityMatrix() ColorMatrix {
+	return ColorMatrix{
+		1, 0, 0, 0, 0,
+		0, 1, 0, 0, 0,
+		0, 0, 1, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// Then
+// en: Returns the matrix that applies el and then next, so a sequence of color
+// operations costs a single pass over the pixels
+//
+// pt_br: Retorna a matriz que aplica el e depois next, assim uma sequência de
+// operações de cor custa uma única passagem pelos pixels
+func (el ColorMatrix) Then(next ColorMatrix) ColorMatrix {
+	var result ColorMatrix
+	for row := 0; row < 4; row += 1 {
+		for column := 0; column < 5; column += 1 {
+			var value float64
+			for k := 0; k < 4; k += 1 {
+				value += next[row*5+k] * el[k*5+column]
+			}
+			if column == 4 {
+				value += next[row*5+4]
+			}
+			result[row*5+column] = value
+		}
+	}
+	return result
+}
+
+// Transform
+// en: Applies the matrix to one color with values from 0 to 1, alpha not
+// premultiplied, and clamps the result to the range from 0 to 1
+//
+// pt_br: Aplica a matriz a uma cor com valores de 0 a 1, alpha não
+// pré-multiplicado, e limita o resultado à faixa de 0 a 1
+func (el ColorMatrix) Transform(red, green, blue, alpha float64) (r, g, b, a float64) {
+	input := [4]float64{red, green, blue, alpha}
+	var output [4]float64
+	for row := 0; row < 4; row += 1 {
+		value := el[row*5+4]
+		for k := 0; k < 4; k += 1 {
+			value += el[row*5+k] * input[k]
+		}
+		if value < 0 {
+			value = 0
+		} else if value > 1 {
+			value = 1
+		}
+		output[row] = value
+	}
+	return output[0], output[1], output[2], output[3]
+}
+
+// ApplyColorMatrix
+// en: Applies the matrix in place to every pixel of the image, in parallel by rows
+//
+// pt_br: Aplica a matriz no lugar a todos os pixels da imagem, em paralelo por
+// linhas
+func ApplyColorMatrix(img *image.NRGBA, matrix ColorMatrix) {
+	bounds := img.Bounds()
+	parallelRows(bounds.Dy(), func(startY, endY int) {
+		for y := bounds.Min.Y + startY; y < bounds.Min.Y+endY; y += 1 {
+			for x := bounds.Min.X; x < bounds.Max.X; x += 1 {
+				offset := img.PixOffset(x, y)
+				pixel := img.Pix[offset : offset+4 : offset+4]
+				red, green, blue, alpha := matrix.Transform(
+					float64(pixel[0])/255,
+					float64(pixel[1])/255,
+					float64(pixel[2])/255,
+					float64(pixel[3])/255,
+				)
+				pixel[0] = clampByte(red * 255)
+				pixel[1] = clampByte(green * 255)
+				pixel[2] = clampByte(blue * 255)
+				pixel[3] = clampByte(alpha * 255)
+			}
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package imageOps
+
+import (
+	"image"
+	"sync"
+)
+
+// Histogram
+// en: Number of pixels for each value, from 0 to 255, of each channel. Fully
+// transparent pixels are not counted in the color channels and in Luminance
+//
+// pt_br: Número de pixels para cada valor, de 0 a 255, de cada canal. Pixels
+// totalmente transparentes não são contados nos canais de cor e em Luminance
+type Histogram struct {
+	Red       [256]int
+	Green     [256]int
+	Blue      [256]int
+	Alpha     [256]int
+	Luminance [256]int
+}
+
+// NewHistogram
+// en: Returns the histogram of the image, counting the rows in parallel
+//
+// pt_br: Retorna o histograma da imagem, contando as linhas em paralelo
+func NewHistogram(img *image.NRGBA) (histogram Histogram) {
+	bounds := img.Bounds()
+	var mutex sync.Mutex
+	parallelRows(bounds.Dy(), func(startY, endY int) {
+		var partial Histogram
+		for y := bounds.Min.Y + startY; y < bounds.Min.Y+endY; y += 1 {
+			for x := bounds.Min.X; x < bounds.Max.X; x += 1 {
+				pixel := img.Pix[img.PixOffset(x, y):]
+				partial.Alpha[pixel[3]] += 1
+				if pixel[3] == 0 {
+					continue
+				}
+				partial.Red[pixel[0]] += 1
+				partial.Green[pixel[1]] += 1
+				partial.Blue[pixel[2]] += 1
+				partial.Luminance[clampByte(luminance(pixel[0], pixel[1], pixel[2]))] += 1
+			}
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		for value := 0; value < 256; value += 1 {
+			histogram.Red[value] += partial.Red[value]
+			histogram.Green[value] += partial.Green[value]
+			histogram.Blue[value] += partial.Blue[value]
+			histogram.Alpha[value] += partial.Alpha[value]
+			histogram.Luminance[value] += partial.Luminance[value]
+		}
+	})
+	return
+}
+
+// Percentile
+// en: Returns the smallest value whose accumulated count reaches the fraction of
+// the total of the channel
+//
+//	channel: One of the channels of the histogram, like histogram.Luminance
+//	fraction: Value from 0 to 1
+//
+// pt_br: Retorna o menor valor cuja contagem acumulada atinge a fração do total do
+// canal
+//
+//	channel: Um dos canais do histograma, como histogram.Luminance
+//	fraction: Valor de 0 a 1
+func (el Histogram) Percentile(channel [256]int, fraction float64) uint8 {
+	total := 0
+	for _, count := range channel {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+	limit := fraction * float64(total)
+	accumulated := 0
+	for value, count := range channel {
+		accumulated += count
+		if float64(accumulated) >= limit && accumulated > 0 {
+			return uint8(value)
+		}
+	}
+	return 255
+}
+
+// Mean
+// en: Returns the mean value of the channel
+//
+// pt_br: Retorna o valor médio do canal
+func (el Histogram) Mean(channel [256]int) float64 {
+	total, sum := 0, 0
+	for value, count := range channel {
+		total += count
+		sum += value * count
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(sum) / float64(total)
+}
@@ -0,0 +1,196 @@
+package imageOps
+
+import (
+	"image"
+)
+
+// Kernel
+// en: Convolution kernel, as in the feConvolveMatrix of SVG
+//
+//	Width, Height: Size of the kernel; must be odd numbers
+//	Values: Weights in row order, Width * Height values
+//	Divisor: The sum is divided by this value; zero uses the sum of the
+//	         weights, or 1 when the sum is zero
+//	Bias: Value from 0 to 1 added after the division
+//	PreserveAlpha: When true the alpha channel is not convolved
+//
+// pt_br: Kernel de convolução, como no feConvolveMatrix do SVG
+//
+//	Width, Height: Tamanho do kernel; devem ser números ímpares
+//	Values: Pesos em ordem de linhas, Width * Height valores
+//	Divisor: A soma é dividida por este valor; zero usa a soma dos pesos, ou 1
+//	         quando a soma é zero
+//	Bias: Valor de 0 a 1 somado após a divisão
+//	PreserveAlpha: Quando true o canal alpha não passa pela convolução
+type Kernel struct {
+	Width         int
+	Height        int
+	Values        []float64
+	Divisor       float64
+	Bias          float64
+	PreserveAlpha bool
+}
+
+// NewKernel3x3
+// en: Returns a 3x3 kernel that preserves the alpha channel
+//
+// pt_br: Retorna um kernel 3x3 que preserva o canal alpha
+func NewKernel3x3(values ...float64) Kernel {
+	return Kernel{Width: 3, Height: 3, Values: values, PreserveAlpha: true}
+}
+
+// KernelSharpen
+// en: Returns a kernel that increases the difference between neighbour pixels
+//
+// pt_br: Retorna um kernel que aumenta a diferença entre pixels vizinhos
+func KernelSharpen() Kernel {
+	return NewKernel3x3(
+		0, -1, 0,
+		-1, 5, -1,
+		0, -1, 0,
+	)
+}
+
+// KernelEdgeDetect
+// en: Returns a Laplacian kernel that keeps only the edges of the image
+//
+// pt_br: Retorna um kernel Laplaciano que mantém apenas as bordas da imagem
+func KernelEdgeDetect() Kernel {
+	return NewKernel3x3(
+		-1, -1, -1,
+		-1, 8, -1,
+		-1, -1, -1,
+	)
+}
+
+// KernelSobelHorizontal
+// en: Returns the Sobel kernel that detects horizontal changes (vertical edges)
+//
+// pt_br: Retorna o kernel de Sobel que detecta mudanças horizontais (bordas
+// verticais)
+func KernelSobelHorizontal() Kernel {
+	kernel := NewKernel3x3(
+		-1, 0, 1,
+		-2, 0, 2,
+		-1, 0, 1,
+	)
+	kernel.Bias = 0.5
+	kernel.Divisor = 4
+	return kernel
+}
+
+// KernelSobelVertical
+// en: Returns the Sobel kernel that detects vertical changes (horizontal edges)
+//
+// pt_br: Retorna o kernel de Sobel que detecta mudanças verticais (bordas
+// horizontais)
+func KernelSobelVertical() Kernel {
+	kernel := NewKernel3x3(
+		-1, -2, -1,
+		0, 0, 0,
+		1, 2, 1,
+	)
+	kernel.Bias = 0.5
+	kernel.Divisor = 4
+	return kernel
+}
+
+// KernelBoxBlur
+// en: Returns a 3x3 kernel with the average of the neighbour pixels
+//
+// pt_br: Retorna um kernel 3x3 com a média dos pixels vizinhos
+func KernelBoxBlur() Kernel {
+	return Kernel{Width: 3, Height: 3, Values: []float64{1, 1, 1, 1, 1, 1, 1, 1, 1}}
+}
+
+// Convolve
+// en: Returns a new image with the kernel applied to every pixel, in parallel by
+// rows. Pixels outside the image repeat the nearest border pixel. Colors are
+// convolved with premultiplied alpha, so transparent pixels do not darken the
+// edges
+//
+// pt_br: Retorna uma nova imagem com o kernel aplicado a todos os pixels, em
+// paralelo por linhas. Pixels fora da imagem repetem o pixel mais próximo da borda.
+// As cores passam pela convolução com alpha pré-multiplicado, assim pixels
+// transparentes não escurecem as bordas
+func Convolve(img *image.NRGBA, kernel Kernel) *image.NRGBA {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	result := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if kernel.Width <= 0 || kernel.Height <= 0 || len(kernel.Values) < kernel.Width*kernel.Height {
+		for y := 0; y < height; y += 1 {
+			source := img.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			copy(result.Pix[result.PixOffset(0, y):result.PixOffset(0, y)+width*4], img.Pix[source:source+width*4])
+		}
+		return result
+	}
+
+	divisor := kernel.Divisor
+	if divisor == 0 {
+		for _, value := range kernel.Values {
+			divisor += value
+		}
+		if divisor == 0 {
+			divisor = 1
+		}
+	}
+	halfWidth, halfHeight := kernel.Width/2, kernel.Height/2
+
+	parallelRows(height, func(startY, endY int) {
+		for y := startY; y < endY; y += 1 {
+			for x := 0; x < width; x += 1 {
+				var sum [4]float64
+				for kernelY := 0; kernelY < kernel.Height; kernelY += 1 {
+					sampleY := clampInt(y+kernelY-halfHeight, 0, height-1)
+					for kernelX := 0; kernelX < kernel.Width; kernelX += 1 {
+						weight := kernel.Values[kernelY*kernel.Width+kernelX]
+						if weight == 0 {
+							continue
+						}
+						sampleX := clampInt(x+kernelX-halfWidth, 0, width-1)
+						pixel := img.Pix[img.PixOffset(bounds.Min.X+sampleX, bounds.Min.Y+sampleY):]
+						alpha := float64(pixel[3]) / 255
+						if kernel.PreserveAlpha {
+							alpha = 1
+						}
+						sum[0] += weight * float64(pixel[0]) * alpha
+						sum[1] += weight * float64(pixel[1]) * alpha
+						sum[2] += weight * float64(pixel[2]) * alpha
+						sum[3] += weight * float64(pixel[3])
+					}
+				}
+
+				source := img.Pix[img.PixOffset(bounds.Min.X+x, bounds.Min.Y+y):]
+				target := result.Pix[result.PixOffset(x, y):]
+				alpha := float64(source[3])
+				if !kernel.PreserveAlpha {
+					alpha = sum[3]/divisor + kernel.Bias*255
+				}
+				alpha = float64(clampByte(alpha))
+				target[3] = uint8(alpha)
+				for channel := 0; channel < 3; channel += 1 {
+					value := sum[channel]/divisor + kernel.Bias*255
+					if !kernel.PreserveAlpha {
+						if alpha == 0 {
+							value = 0
+						} else {
+							value = value * 255 / alpha
+						}
+					}
+					target[channel] = clampByte(value)
+				}
+			}
+		}
+	})
+	return result
+}
+
+func clampInt(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
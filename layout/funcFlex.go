@@ -0,0 +1,275 @@
+package layout
+
+import (
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// flexItem
+// en: Child of a flex line, with its sizes on the main and cross axes
+//
+// pt_br: Filho de uma linha flex, com os seus tamanhos nos eixos principal e
+// cruzado
+type flexItem struct {
+	node        *Node
+	basis       float64
+	size        float64
+	marginStart float64
+	marginMain  float64
+	minimum     float64
+	maximum     float64
+	frozen      bool
+}
+
+// layoutNode
+// en: Sets the box of the node and arranges its children
+//
+// pt_br: Define a caixa do nó e organiza os seus filhos
+func layoutNode(node *Node, bounds collision.AABB) {
+	node.bounds = bounds
+	area := node.GetContentBounds()
+	switch node.Kind {
+	case KKindGrid:
+		layoutGrid(node, area)
+	case KKindStack:
+		layoutStack(node, area)
+	default:
+		layoutFlex(node, area)
+	}
+}
+
+// layoutFlex
+// en: Arranges the children of a flex node in its content box: the basis of each
+// child is its preferred size, lines break when Wrap is set, the free space of
+// each line is given by Grow or taken by Shrink, weighted by the basis, respecting
+// the minimum and maximum sizes, and what is left is distributed by Justify
+//
+// pt_br: Organiza os filhos de um nó flex na sua caixa de conteúdo: a base de
+// cada filho é o seu tamanho preferido, linhas quebram quando Wrap está ligado,
+// o espaço livre de cada linha é dado por Grow ou retirado por Shrink, ponderado
+// pela base, respeitando os tamanhos mínimo e máximo, e o que sobra é distribuído
+// por Justify
+func layoutFlex(node *Node, area collision.AABB) {
+	row := node.Direction == KDirectionRow
+	mainSize, crossSize, gap, lineGap := area.Width, area.Height, node.ColumnGap, node.RowGap
+	if !row {
+		mainSize, crossSize, gap, lineGap = area.Height, area.Width, node.RowGap, node.ColumnGap
+	}
+
+	children := node.visible()
+	items := make([]*flexItem, 0, len(children))
+	for _, child := range children {
+		item := &flexItem{node: child}
+		width, height := preferred(child, area.Width-child.Margin.horizontal(), area.Height-child.Margin.vertical())
+		if row {
+			item.basis, item.marginStart, item.marginMain = width, child.Margin.Left, child.Margin.horizontal()
+			item.minimum, item.maximum = child.MinWidth, child.MaxWidth
+		} else {
+			item.basis, item.marginStart, item.marginMain = height, child.Margin.Top, child.Margin.vertical()
+			item.minimum, item.maximum = child.MinHeight, child.MaxHeight
+		}
+		if item.maximum <= 0 {
+			item.maximum = math.Inf(1)
+		}
+		items = append(items, item)
+	}
+
+	// en: lines
+	// pt_br: linhas
+	lines := make([][]*flexItem, 0, 1)
+	start, used := 0, 0.0
+	for i, item := range items {
+		length := item.basis + item.marginMain
+		if node.Wrap && i > start && used+gap+length > mainSize {
+			lines = append(lines, items[start:i])
+			start, used = i, 0
+		}
+		if i > start {
+			used += gap
+		}
+		used += length
+	}
+	if start < len(items) {
+		lines = append(lines, items[start:])
+	}
+
+	crossOffset := 0.0
+	for _, line := range lines {
+		resolve(line, mainSize-gap*float64(len(line)-1))
+
+		// en: cross size of the line: the whole box for a single line
+		// pt_br: tamanho cruzado da linha: a caixa inteira para uma linha única
+		lineCross := crossSize
+		if node.Wrap {
+			lineCross = 0
+			for _, item := range line {
+				lineCross = math.Max(lineCross, cross(item, row, area))
+			}
+		}
+
+		free := mainSize - gap*float64(len(line)-1)
+		for _, item := range line {
+			free -= item.size + item.marginMain
+		}
+		offset, spacing := justify(node.Justify, free, len(line))
+		for _, item := range line {
+			offset += item.marginStart
+			if row {
+				space := collision.NewAABB(area.X+offset-item.marginStart, area.Y+crossOffset, item.size+item.marginMain, lineCross)
+				arrangeFlex(item, space, node.align(item.node), row)
+			} else {
+				space := collision.NewAABB(area.X+crossOffset, area.Y+offset-item.marginStart, lineCross, item.size+item.marginMain)
+				arrangeFlex(item, space, node.align(item.node), row)
+			}
+			offset += item.size + item.marginMain - item.marginStart + gap + spacing
+		}
+		crossOffset += lineCross + lineGap
+	}
+}
+
+// resolve
+// en: Sets the main size of the items of a line, growing or shrinking them to
+// fill the length. Items limited by their minimum or maximum are frozen and the
+// rest of the space is distributed again among the others
+//
+// pt_br: Define o tamanho principal dos itens de uma linha, crescendo ou
+// encolhendo-os para preencher o comprimento. Itens limitados pelo seu mínimo ou
+// máximo são congelados e o resto do espaço é distribuído novamente entre os
+// outros
+func resolve(line []*flexItem, length float64) {
+	for _, item := range line {
+		item.size = math.Max(item.minimum, math.Min(item.maximum, item.basis))
+		item.frozen = false
+	}
+	for attempt := 0; attempt <= len(line); attempt += 1 {
+		free := length
+		weights := 0.0
+		for _, item := range line {
+			free -= item.size + item.marginMain
+		}
+		growing := free > 0
+		for _, item := range line {
+			if item.frozen {
+				continue
+			}
+			if growing {
+				weights += item.node.Grow
+			} else {
+				weights += item.node.Shrink * item.basis
+			}
+		}
+		if free == 0 || weights <= 0 {
+			return
+		}
+
+		violated := false
+		for _, item := range line {
+			if item.frozen {
+				continue
+			}
+			weight := item.node.Shrink * item.basis
+			if growing {
+				weight = item.node.Grow
+			}
+			size := item.size + free*weight/weights
+			limited := math.Max(item.minimum, math.Min(item.maximum, math.Max(0, size)))
+			if limited != size {
+				item.size, item.frozen, violated = limited, true, true
+			}
+		}
+		if violated {
+			continue
+		}
+		for _, item := range line {
+			if item.frozen {
+				continue
+			}
+			weight := item.node.Shrink * item.basis
+			if growing {
+				weight = item.node.Grow
+			}
+			item.size += free * weight / weights
+		}
+		return
+	}
+}
+
+// cross
+// en: Returns the cross size of an item of a wrapped line, margins included, for
+// its resolved main size
+//
+// pt_br: Retorna o tamanho cruzado de um item de uma linha quebrada, margens
+// incluídas, para o seu tamanho principal resolvido
+func cross(item *flexItem, row bool, area collision.AABB) float64 {
+	node := item.node
+	if row {
+		_, height := preferred(node, item.size, area.Height-node.Margin.vertical())
+		return height + node.Margin.vertical()
+	}
+	width, _ := preferred(node, area.Width-node.Margin.horizontal(), item.size)
+	return width + node.Margin.horizontal()
+}
+
+// justify
+// en: Returns the offset of the first item and the extra space between items
+//
+// pt_br: Retorna o deslocamento do primeiro item e o espaço extra entre itens
+func justify(mode Justify, free float64, count int) (offset, spacing float64) {
+	if free <= 0 || count == 0 {
+		return 0, 0
+	}
+	switch mode {
+	case KJustifyCenter:
+		return free / 2, 0
+	case KJustifyEnd:
+		return free, 0
+	case KJustifySpaceBetween:
+		if count == 1 {
+			return 0, 0
+		}
+		return 0, free / float64(count-1)
+	case KJustifySpaceAround:
+		spacing = free / float64(count)
+		return spacing / 2, spacing
+	case KJustifySpaceEvenly:
+		spacing = free / float64(count+1)
+		return spacing, spacing
+	}
+	return 0, 0
+}
+
+// arrangeFlex
+// en: Places an item in the space of its line: the main size is the resolved one
+// and the cross size follows the alignment
+//
+// pt_br: Posiciona um item no espaço da sua linha: o tamanho principal é o
+// resolvido e o tamanho cruzado segue o alinhamento
+func arrangeFlex(item *flexItem, space collision.AABB, align Align, row bool) {
+	node := item.node
+	margin := node.Margin
+	if row {
+		crossSpace := space.Height - margin.vertical()
+		_, height := preferred(node, item.size, crossSpace)
+		if node.Height > 0 && align == KAlignStretch {
+			align = KAlignStart
+		}
+		y, height := position(align, crossSpace, height)
+		if align == KAlignStretch {
+			height = clampHeight(node, height)
+		}
+		layoutNode(node, collision.NewAABB(space.X+margin.Left, space.Y+margin.Top+y, item.size, height))
+		return
+	}
+
+	crossSpace := space.Width - margin.horizontal()
+	width, _ := preferred(node, crossSpace, item.size)
+	if node.Width > 0 && align == KAlignStretch {
+		align = KAlignStart
+	}
+	x, width := position(align, crossSpace, width)
+	if align == KAlignStretch {
+		width = clampWidth(node, width)
+	}
+	layoutNode(node, collision.NewAABB(space.X+margin.Left+x, space.Y+margin.Top, width, item.size))
+}
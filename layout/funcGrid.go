@@ -0,0 +1,252 @@
+package layout
+
+import (
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// cell
+// en: Position of a child in a grid, zero based
+//
+// pt_br: Posição de um filho em uma grade, a partir de zero
+type cell struct {
+	node       *Node
+	column     int
+	row        int
+	columnSpan int
+	rowSpan    int
+}
+
+// place
+// en: Places the children in the cells. Children with Column and Row take that
+// cell; children with only one of them take the first free cell of that column
+// or row; the others follow in reading order, after the previous automatic child,
+// like grid-auto-flow: row. Rows are created as needed
+//
+// pt_br: Posiciona os filhos nas células. Filhos com Column e Row ocupam aquela
+// célula; filhos com apenas um deles ocupam a primeira célula livre daquela
+// coluna ou linha; os outros seguem em ordem de leitura, depois do filho
+// automático anterior, como grid-auto-flow: row. Linhas são criadas conforme
+// necessário
+func place(node *Node, children []*Node) (cells []cell, columns, rows int) {
+	columns = len(node.Columns)
+	if columns == 0 {
+		columns = 1
+	}
+	rows = len(node.Rows)
+	used := make(map[[2]int]bool)
+	free := func(item cell) bool {
+		for row := item.row; row < item.row+item.rowSpan; row += 1 {
+			for column := item.column; column < item.column+item.columnSpan; column += 1 {
+				if used[[2]int{column, row}] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	cells = make([]cell, 0, len(children))
+	cursorColumn, cursorRow := 0, 0
+	for _, child := range children {
+		item := cell{node: child, columnSpan: child.ColumnSpan, rowSpan: child.RowSpan}
+		if item.columnSpan < 1 {
+			item.columnSpan = 1
+		}
+		if item.columnSpan > columns {
+			item.columnSpan = columns
+		}
+		if item.rowSpan < 1 {
+			item.rowSpan = 1
+		}
+
+		switch {
+		case child.Column > 0 && child.Row > 0:
+			item.column = int(math.Min(float64(child.Column-1), float64(columns-item.columnSpan)))
+			item.row = child.Row - 1
+		case child.Column > 0:
+			item.column = int(math.Min(float64(child.Column-1), float64(columns-item.columnSpan)))
+			for !free(item) {
+				item.row += 1
+			}
+		case child.Row > 0:
+			item.row = child.Row - 1
+			for !free(item) && item.column+item.columnSpan < columns {
+				item.column += 1
+			}
+		default:
+			item.column, item.row = cursorColumn, cursorRow
+			for {
+				if item.column+item.columnSpan > columns {
+					item.column, item.row = 0, item.row+1
+					continue
+				}
+				if free(item) {
+					break
+				}
+				item.column += 1
+			}
+			cursorColumn, cursorRow = item.column+item.columnSpan, item.row
+		}
+
+		for row := item.row; row < item.row+item.rowSpan; row += 1 {
+			for column := item.column; column < item.column+item.columnSpan; column += 1 {
+				used[[2]int{column, row}] = true
+			}
+		}
+		if item.row+item.rowSpan > rows {
+			rows = item.row + item.rowSpan
+		}
+		cells = append(cells, item)
+	}
+	return
+}
+
+// expand
+// en: Returns count tracks: the explicit ones followed by the automatic track
+//
+// pt_br: Retorna count trilhas: as explícitas seguidas pela trilha automática
+func expand(explicit []Track, count int, automatic Track) []Track {
+	tracks := make([]Track, count)
+	for i := range tracks {
+		if i < len(explicit) {
+			tracks[i] = explicit[i]
+		} else {
+			tracks[i] = automatic
+		}
+	}
+	return tracks
+}
+
+// sizeTracks
+// en: Returns the size of each track. Pixel tracks keep their size; automatic
+// tracks take the largest measure of the children that span only them; fraction
+// tracks share the space left, or behave as automatic when the space available is
+// infinite. Children that span several tracks do not enlarge them
+//
+// pt_br: Retorna o tamanho de cada trilha. Trilhas em pixels mantêm o seu
+// tamanho; trilhas automáticas assumem a maior medida dos filhos que ocupam
+// apenas elas; trilhas de fração dividem o espaço restante, ou se comportam como
+// automáticas quando o espaço disponível é infinito. Filhos que ocupam várias
+// trilhas não as aumentam
+func sizeTracks(tracks []Track, available, gap float64, cells []cell, horizontal bool, measure func(item cell) float64) []float64 {
+	sizes := make([]float64, len(tracks))
+	infinite := math.IsInf(available, 1)
+	fractions := 0.0
+	for i, track := range tracks {
+		switch {
+		case track.Unit == KUnitPixel:
+			sizes[i] = max0(track.Value)
+			continue
+		case track.Unit == KUnitFraction && !infinite:
+			fractions += max0(track.Value)
+			continue
+		}
+		for _, item := range cells {
+			start, span := item.row, item.rowSpan
+			if horizontal {
+				start, span = item.column, item.columnSpan
+			}
+			if start == i && span == 1 {
+				sizes[i] = math.Max(sizes[i], measure(item))
+			}
+		}
+	}
+
+	if !infinite && fractions > 0 {
+		remaining := max0(available - sum(sizes) - gap*float64(len(tracks)-1))
+		for i, track := range tracks {
+			if track.Unit == KUnitFraction {
+				sizes[i] = remaining * max0(track.Value) / fractions
+			}
+		}
+	}
+	return sizes
+}
+
+// spanned
+// en: Returns the size of count tracks from start, gaps included
+//
+// pt_br: Retorna o tamanho de count trilhas a partir de start, espaços incluídos
+func spanned(sizes []float64, start, count int, gap float64) float64 {
+	if count <= 0 {
+		return 0
+	}
+	return sum(sizes[start:start+count]) + gap*float64(count-1)
+}
+
+// offset
+// en: Returns the position of the track, from the start of the first one
+//
+// pt_br: Retorna a posição da trilha, a partir do início da primeira
+func offset(sizes []float64, index int, gap float64) float64 {
+	return sum(sizes[:index]) + gap*float64(index)
+}
+
+// layoutGrid
+// en: Arranges the children of a grid node in its content box
+//
+// pt_br: Organiza os filhos de um nó grade na sua caixa de conteúdo
+func layoutGrid(node *Node, area collision.AABB) {
+	children := node.visible()
+	cells, columnCount, rowCount := place(node, children)
+	columns := sizeTracks(expand(node.Columns, columnCount, Fraction(1)), area.Width, node.ColumnGap, cells, true, func(item cell) float64 {
+		width, _ := preferred(item.node, area.Width, area.Height)
+		return width + item.node.Margin.horizontal()
+	})
+	rows := sizeTracks(expand(node.Rows, rowCount, node.AutoRows), area.Height, node.RowGap, cells, false, func(item cell) float64 {
+		width := spanned(columns, item.column, item.columnSpan, node.ColumnGap) - item.node.Margin.horizontal()
+		_, height := preferred(item.node, width, area.Height)
+		return height + item.node.Margin.vertical()
+	})
+
+	for _, item := range cells {
+		space := collision.NewAABB(
+			area.X+offset(columns, item.column, node.ColumnGap),
+			area.Y+offset(rows, item.row, node.RowGap),
+			spanned(columns, item.column, item.columnSpan, node.ColumnGap),
+			spanned(rows, item.row, item.rowSpan, node.RowGap),
+		)
+		arrange(item.node, space, node.justify(), node.align(item.node))
+	}
+}
+
+// layoutStack
+// en: Arranges the children of a stack node, each one aligned in the content box
+//
+// pt_br: Organiza os filhos de um nó pilha, cada um alinhado na caixa de conteúdo
+func layoutStack(node *Node, area collision.AABB) {
+	for _, child := range node.visible() {
+		arrange(child, area, node.justify(), node.align(child))
+	}
+}
+
+// arrange
+// en: Places a child in a space with its margins, aligned on both axes, and lays
+// out its own children
+//
+// pt_br: Posiciona um filho em um espaço com as suas margens, alinhado nos dois
+// eixos, e organiza os seus próprios filhos
+func arrange(child *Node, space collision.AABB, horizontal, vertical Align) {
+	margin := child.Margin
+	width, height := space.Width-margin.horizontal(), space.Height-margin.vertical()
+	preferredWidth, preferredHeight := preferred(child, width, height)
+	if child.Width > 0 && horizontal == KAlignStretch {
+		horizontal = KAlignStart
+	}
+	if child.Height > 0 && vertical == KAlignStretch {
+		vertical = KAlignStart
+	}
+
+	x, width := position(horizontal, width, preferredWidth)
+	if horizontal == KAlignStretch {
+		width = clampWidth(child, width)
+		_, preferredHeight = preferred(child, width, height)
+	}
+	y, height := position(vertical, height, preferredHeight)
+	if vertical == KAlignStretch {
+		height = clampHeight(child, height)
+	}
+	layoutNode(child, collision.NewAABB(space.X+margin.Left+x, space.Y+margin.Top+y, width, height))
+}
@@ -0,0 +1,161 @@
+package layout
+
+import (
+	"math"
+)
+
+// preferred
+// en: Returns the preferred size of the node, padding included and margin
+// excluded, limited by its minimum and maximum. maxWidth and maxHeight are the
+// space available, or infinity, and are given to Measure
+//
+// pt_br: Retorna o tamanho preferido do nó, espaçamento interno incluído e margem
+// excluída, limitado pelo seu mínimo e máximo. maxWidth e maxHeight são o espaço
+// disponível, ou infinito, e são dados a Measure
+func preferred(node *Node, maxWidth, maxHeight float64) (width, height float64) {
+	width, height = node.Width, node.Height
+	if width > 0 {
+		maxWidth = width
+	}
+	if height > 0 {
+		maxHeight = height
+	}
+	if width <= 0 || height <= 0 {
+		contentWidth, contentHeight := content(node, maxWidth-node.Padding.horizontal(), maxHeight-node.Padding.vertical())
+		if width <= 0 {
+			width = contentWidth + node.Padding.horizontal()
+		}
+		if height <= 0 {
+			height = contentHeight + node.Padding.vertical()
+		}
+	}
+	return clampWidth(node, width), clampHeight(node, height)
+}
+
+// content
+// en: Returns the preferred size of the content of the node: the size given by
+// Measure, or the size of the children arranged without free space
+//
+// pt_br: Retorna o tamanho preferido do conteúdo do nó: o tamanho dado por
+// Measure, ou o tamanho dos filhos organizados sem espaço livre
+func content(node *Node, maxWidth, maxHeight float64) (width, height float64) {
+	maxWidth, maxHeight = max0(maxWidth), max0(maxHeight)
+	if node.Measure != nil {
+		return node.Measure(maxWidth, maxHeight)
+	}
+	children := node.visible()
+	if len(children) == 0 {
+		return 0, 0
+	}
+
+	switch node.Kind {
+	case KKindGrid:
+		cells, columnCount, rowCount := place(node, children)
+		columns := sizeTracks(expand(node.Columns, columnCount, Fraction(1)), math.Inf(1), node.ColumnGap, cells, true, func(item cell) float64 {
+			width, _ := preferred(item.node, maxWidth, maxHeight)
+			return width + item.node.Margin.horizontal()
+		})
+		rows := sizeTracks(expand(node.Rows, rowCount, node.AutoRows), math.Inf(1), node.RowGap, cells, false, func(item cell) float64 {
+			_, height := preferred(item.node, spanned(columns, item.column, item.columnSpan, node.ColumnGap)-item.node.Margin.horizontal(), maxHeight)
+			return height + item.node.Margin.vertical()
+		})
+		return spanned(columns, 0, len(columns), node.ColumnGap), spanned(rows, 0, len(rows), node.RowGap)
+
+	case KKindStack:
+		for _, child := range children {
+			childWidth, childHeight := preferred(child, maxWidth-child.Margin.horizontal(), maxHeight-child.Margin.vertical())
+			width = math.Max(width, childWidth+child.Margin.horizontal())
+			height = math.Max(height, childHeight+child.Margin.vertical())
+		}
+		return
+	}
+
+	// en: main and cross sizes of each line; without Wrap there is a single line
+	// pt_br: tamanhos principal e cruzado de cada linha; sem Wrap há uma única
+	// linha
+	row := node.Direction == KDirectionRow
+	limit, gap, lineGap := maxWidth, node.ColumnGap, node.RowGap
+	if !row {
+		limit, gap, lineGap = maxHeight, node.RowGap, node.ColumnGap
+	}
+	mainSize, crossSize := 0.0, 0.0
+	lineMain, lineCross, count := 0.0, 0.0, 0
+	for _, child := range children {
+		childWidth, childHeight := preferred(child, maxWidth-child.Margin.horizontal(), maxHeight-child.Margin.vertical())
+		childMain, childCross := childWidth+child.Margin.horizontal(), childHeight+child.Margin.vertical()
+		if !row {
+			childMain, childCross = childCross, childMain
+		}
+		if node.Wrap && count != 0 && lineMain+gap+childMain > limit {
+			mainSize = math.Max(mainSize, lineMain)
+			crossSize += lineCross + lineGap
+			lineMain, lineCross, count = 0, 0, 0
+		}
+		if count != 0 {
+			lineMain += gap
+		}
+		lineMain += childMain
+		lineCross = math.Max(lineCross, childCross)
+		count += 1
+	}
+	mainSize = math.Max(mainSize, lineMain)
+	crossSize += lineCross
+	if row {
+		return mainSize, crossSize
+	}
+	return crossSize, mainSize
+}
+
+// clampWidth
+// en: Limits a width to the minimum and maximum of the node
+//
+// pt_br: Limita uma largura ao mínimo e ao máximo do nó
+func clampWidth(node *Node, width float64) float64 {
+	if node.MaxWidth > 0 {
+		width = math.Min(width, node.MaxWidth)
+	}
+	return math.Max(max0(width), node.MinWidth)
+}
+
+// clampHeight
+// en: Limits a height to the minimum and maximum of the node
+//
+// pt_br: Limita uma altura ao mínimo e ao máximo do nó
+func clampHeight(node *Node, height float64) float64 {
+	if node.MaxHeight > 0 {
+		height = math.Min(height, node.MaxHeight)
+	}
+	return math.Max(max0(height), node.MinHeight)
+}
+
+// position
+// en: Returns the offset and the size of a box of the preferred size aligned in
+// a space; stretch takes the whole space
+//
+// pt_br: Retorna o deslocamento e o tamanho de uma caixa do tamanho preferido
+// alinhada em um espaço; stretch ocupa todo o espaço
+func position(align Align, space, size float64) (offset, length float64) {
+	switch align {
+	case KAlignCenter:
+		return (space - size) / 2, size
+	case KAlignEnd:
+		return space - size, size
+	case KAlignStart:
+		return 0, size
+	}
+	return 0, space
+}
+
+func max0(value float64) float64 {
+	if math.IsNaN(value) || value < 0 {
+		return 0
+	}
+	return value
+}
+
+func sum(values []float64) (total float64) {
+	for _, value := range values {
+		total += value
+	}
+	return
+}
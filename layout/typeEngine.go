@@ -0,0 +1,113 @@
+package layout
+
+import (
+	"math"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// Engine
+// en: Lays out a tree of nodes in a rectangle, usually the document, and delivers
+// the boxes to the targets. The engine does not listen to events by itself: the
+// application calls Resize() from the resize listener of its platform, and the
+// tree is laid out again only when the size of the document changed
+//
+//	Snap: Rounds the edges of the boxes to whole pixels, for crisp lines
+//	OnLayout: Optional; called after each layout, for example to redraw
+//
+// pt_br: Organiza uma árvore de nós em um retângulo, normalmente o documento, e
+// entrega as caixas aos alvos. O motor não escuta eventos sozinho: a aplicação
+// chama Resize() a partir do ouvinte de redimensionamento da sua plataforma, e a
+// árvore é organizada novamente apenas quando o tamanho do documento mudou
+//
+//	Snap: Arredonda as bordas das caixas para pixels inteiros, para linhas
+//	      nítidas
+//	OnLayout: Opcional; chamada após cada layout, por exemplo para redesenhar
+type Engine struct {
+	Root     *Node
+	Snap     bool
+	OnLayout func(root *Node)
+	bounds   collision.AABB
+	done     bool
+}
+
+// NewEngine
+// en: Returns an engine for the tree, with Snap enabled
+//
+// pt_br: Retorna um motor para a árvore, com Snap habilitado
+func NewEngine(root *Node, onLayout func(root *Node)) *Engine {
+	return &Engine{Root: root, Snap: true, OnLayout: onLayout}
+}
+
+// Layout
+// en: Lays out the tree in the rectangle, sends the boxes to the targets and
+// calls OnLayout
+//
+// pt_br: Organiza a árvore no retângulo, envia as caixas aos alvos e chama
+// OnLayout
+func (el *Engine) Layout(bounds collision.AABB) {
+	el.bounds, el.done = bounds, true
+	if el.Root == nil {
+		return
+	}
+	margin := el.Root.Margin
+	layoutNode(el.Root, collision.NewAABB(
+		bounds.X+margin.Left,
+		bounds.Y+margin.Top,
+		max0(bounds.Width-margin.horizontal()),
+		max0(bounds.Height-margin.vertical()),
+	))
+	el.deliver(el.Root)
+	if el.OnLayout != nil {
+		el.OnLayout(el.Root)
+	}
+}
+
+// Relayout
+// en: Lays out the tree again in the last rectangle, after changes to the nodes
+//
+// pt_br: Organiza a árvore novamente no último retângulo, após mudanças nos nós
+func (el *Engine) Relayout() {
+	el.Layout(el.bounds)
+}
+
+// Resize
+// en: Reads the size of the document and lays out the tree when it changed;
+// returns true when a layout happened
+//
+//	html: Platform of the document, like the webbrowser implementation of IHtml
+//	document: Document given to GetDocumentWidth() and GetDocumentHeight()
+//
+// pt_br: Lê o tamanho do documento e organiza a árvore quando ele mudou; retorna
+// true quando um layout aconteceu
+//
+//	html: Plataforma do documento, como a implementação webbrowser de IHtml
+//	document: Documento dado a GetDocumentWidth() e GetDocumentHeight()
+func (el *Engine) Resize(html iotmakerPlatformIDraw.IHtml, document interface{}) bool {
+	width := float64(html.GetDocumentWidth(document))
+	height := float64(html.GetDocumentHeight(document))
+	if el.done && width == el.bounds.Width && height == el.bounds.Height {
+		return false
+	}
+	el.Layout(collision.NewAABB(0, 0, width, height))
+	return true
+}
+
+// deliver
+// en: Snaps the boxes, when enabled, and sends them to the targets
+//
+// pt_br: Arredonda as caixas, quando habilitado, e as envia aos alvos
+func (el *Engine) deliver(node *Node) {
+	if el.Snap {
+		left, top := math.Round(node.bounds.X), math.Round(node.bounds.Y)
+		right, bottom := math.Round(node.bounds.MaxX()), math.Round(node.bounds.MaxY())
+		node.bounds = collision.NewAABB(left, top, right-left, bottom-top)
+	}
+	if node.Target != nil {
+		node.Target.SetBounds(node.bounds)
+	}
+	for _, child := range node.visible() {
+		el.deliver(child)
+	}
+}
@@ -0,0 +1,414 @@
+package layout
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// Kind
+// en: How a node arranges its children
+//
+// pt_br: Como um nó organiza os seus filhos
+type Kind int
+
+const (
+	// KKindFlex
+	// en: Children in a row or a column, like the CSS flexbox
+	//
+	// pt_br: Filhos em uma linha ou coluna, como o flexbox do CSS
+	KKindFlex Kind = iota
+
+	// KKindGrid
+	// en: Children in the cells of a grid of tracks, like the CSS grid
+	//
+	// pt_br: Filhos nas células de uma grade de trilhas, como o grid do CSS
+	KKindGrid
+
+	// KKindStack
+	// en: Children over each other, each aligned in the content box
+	//
+	// pt_br: Filhos uns sobre os outros, cada um alinhado na caixa de conteúdo
+	KKindStack
+)
+
+// Direction
+// en: Main axis of a flex node
+//
+// pt_br: Eixo principal de um nó flex
+type Direction int
+
+const (
+	KDirectionRow Direction = iota
+	KDirectionColumn
+)
+
+// Align
+// en: Alignment of a child on the cross axis of a flex line, or inside its grid
+// cell or stack
+//
+// pt_br: Alinhamento de um filho no eixo cruzado de uma linha flex, ou dentro da
+// sua célula de grade ou pilha
+type Align int
+
+const (
+	// KAlignAuto
+	// en: Uses the alignment of the parent, or KAlignStretch
+	//
+	// pt_br: Usa o alinhamento do pai, ou KAlignStretch
+	KAlignAuto Align = iota
+	KAlignStart
+	KAlignCenter
+	KAlignEnd
+	KAlignStretch
+)
+
+// Justify
+// en: Distribution of the free space on the main axis of a flex line
+//
+// pt_br: Distribuição do espaço livre no eixo principal de uma linha flex
+type Justify int
+
+const (
+	KJustifyStart Justify = iota
+	KJustifyCenter
+	KJustifyEnd
+	KJustifySpaceBetween
+	KJustifySpaceAround
+	KJustifySpaceEvenly
+)
+
+// Insets
+// en: Space around the four sides of a box, used by margins and paddings
+//
+// pt_br: Espaço em volta dos quatro lados de uma caixa, usado por margens e
+// espaçamentos internos
+type Insets struct {
+	Top    float64
+	Right  float64
+	Bottom float64
+	Left   float64
+}
+
+// Uniform
+// en: Returns insets with the same value on the four sides
+//
+// pt_br: Retorna recuos com o mesmo valor nos quatro lados
+func Uniform(value float64) Insets {
+	return Insets{Top: value, Right: value, Bottom: value, Left: value}
+}
+
+// horizontal
+// en: Returns the sum of the left and right sides
+//
+// pt_br: Retorna a soma dos lados esquerdo e direito
+func (el Insets) horizontal() float64 {
+	return el.Left + el.Right
+}
+
+// vertical
+// en: Returns the sum of the top and bottom sides
+//
+// pt_br: Retorna a soma dos lados de cima e de baixo
+func (el Insets) vertical() float64 {
+	return el.Top + el.Bottom
+}
+
+// Unit
+// en: Unit of the size of a grid track
+//
+// pt_br: Unidade do tamanho de uma trilha de grade
+type Unit int
+
+const (
+	// KUnitAuto
+	// en: The largest preferred size of the children of the track
+	//
+	// pt_br: O maior tamanho preferido dos filhos da trilha
+	KUnitAuto Unit = iota
+
+	// KUnitPixel
+	// en: Fixed size, in pixels
+	//
+	// pt_br: Tamanho fixo, em pixels
+	KUnitPixel
+
+	// KUnitFraction
+	// en: Share of the space left by the other tracks, like the CSS fr
+	//
+	// pt_br: Parte do espaço deixado pelas outras trilhas, como o fr do CSS
+	KUnitFraction
+)
+
+// Track
+// en: Size of a column or a row of a grid
+//
+// pt_br: Tamanho de uma coluna ou linha de uma grade
+type Track struct {
+	Unit  Unit
+	Value float64
+}
+
+// Pixels
+// en: Returns a track with a fixed size
+//
+// pt_br: Retorna uma trilha com tamanho fixo
+func Pixels(value float64) Track {
+	return Track{Unit: KUnitPixel, Value: value}
+}
+
+// Fraction
+// en: Returns a track that takes a share of the free space
+//
+// pt_br: Retorna uma trilha que ocupa uma parte do espaço livre
+func Fraction(value float64) Track {
+	return Track{Unit: KUnitFraction, Value: value}
+}
+
+// Auto
+// en: Returns a track sized by its children
+//
+// pt_br: Retorna uma trilha dimensionada pelos seus filhos
+func Auto() Track {
+	return Track{Unit: KUnitAuto}
+}
+
+// Target
+// en: Receives the box computed for a node. The Primitive interface and its
+// genericTypes.Dimensions are not available in this tree
+// (_typeIPrimitiveInterface.go is disabled), so the box is delivered as a
+// collision.AABB; the widgets of the toolkit and gauge packages implement Target
+// as they are
+//
+// pt_br: Recebe a caixa calculada para um nó. A interface Primitive e o seu
+// genericTypes.Dimensions não estão disponíveis nesta árvore
+// (_typeIPrimitiveInterface.go está desabilitado), então a caixa é entregue como
+// uma collision.AABB; os widgets dos pacotes toolkit e gauge implementam Target
+// como são
+type Target interface {
+	SetBounds(bounds collision.AABB)
+}
+
+// BoundsFunc
+// en: Adapts a function to Target, for elements positioned by fields, like
+// func(bounds collision.AABB) { sprite.X, sprite.Y = bounds.X, bounds.Y }
+//
+// pt_br: Adapta uma função para Target, para elementos posicionados por campos,
+// como func(bounds collision.AABB) { sprite.X, sprite.Y = bounds.X, bounds.Y }
+type BoundsFunc func(bounds collision.AABB)
+
+// SetBounds
+// en: Calls the function
+//
+// pt_br: Chama a função
+func (el BoundsFunc) SetBounds(bounds collision.AABB) {
+	el(bounds)
+}
+
+// Node
+// en: Box of the layout tree. Sizes include the padding and exclude the margin;
+// zero sizes are automatic
+//
+//	Target: Optional; receives the computed box
+//	Measure: Optional; returns the preferred size of the content of a leaf, like
+//	         a text, for the maximum size given
+//	Hidden: Removes the node from the layout, like display: none
+//	Width, Height: Preferred size; 0 uses the content
+//	MinWidth, MinHeight, MaxWidth, MaxHeight: Limits; a zero maximum is unlimited
+//	Grow, Shrink: Share of the free space received, and of the missing space
+//	              given, in a flex line; NewNode() sets Shrink to 1, as CSS does
+//	AlignSelf: Overrides AlignItems of the parent
+//	Column, Row: Position in a grid, from 1; 0 places the node automatically
+//	ColumnSpan, RowSpan: Cells taken in a grid; 0 is 1
+//
+//	Kind: How the children are arranged
+//	Direction, Wrap, Justify: Main axis, line breaks and free space of a flex
+//	AlignItems: Alignment of the children on the cross axis of a flex, and on
+//	            the vertical axis of a grid or stack
+//	JustifyItems: Alignment of the children on the horizontal axis of a grid or
+//	              stack
+//	RowGap, ColumnGap: Space between rows and between columns
+//	Columns, Rows: Tracks of a grid; no columns is one 1fr column
+//	AutoRows: Track of the rows created beyond Rows
+//
+// pt_br: Caixa da árvore de layout. Tamanhos incluem o espaçamento interno e
+// excluem a margem; tamanhos zero são automáticos
+//
+//	Target: Opcional; recebe a caixa calculada
+//	Measure: Opcional; retorna o tamanho preferido do conteúdo de uma folha,
+//	         como um texto, para o tamanho máximo dado
+//	Hidden: Remove o nó do layout, como display: none
+//	Width, Height: Tamanho preferido; 0 usa o conteúdo
+//	MinWidth, MinHeight, MaxWidth, MaxHeight: Limites; um máximo zero é ilimitado
+//	Grow, Shrink: Parte do espaço livre recebida, e do espaço faltante cedida,
+//	              em uma linha flex; NewNode() define Shrink como 1, como o CSS
+//	AlignSelf: Substitui AlignItems do pai
+//	Column, Row: Posição em uma grade, a partir de 1; 0 posiciona o nó
+//	             automaticamente
+//	ColumnSpan, RowSpan: Células ocupadas em uma grade; 0 é 1
+//
+//	Kind: Como os filhos são organizados
+//	Direction, Wrap, Justify: Eixo principal, quebras de linha e espaço livre de
+//	                          um flex
+//	AlignItems: Alinhamento dos filhos no eixo cruzado de um flex, e no eixo
+//	            vertical de uma grade ou pilha
+//	JustifyItems: Alinhamento dos filhos no eixo horizontal de uma grade ou pilha
+//	RowGap, ColumnGap: Espaço entre linhas e entre colunas
+//	Columns, Rows: Trilhas de uma grade; sem colunas é uma coluna 1fr
+//	AutoRows: Trilha das linhas criadas além de Rows
+type Node struct {
+	Id      string
+	Target  Target
+	Measure func(maxWidth, maxHeight float64) (width, height float64)
+	Hidden  bool
+
+	Width     float64
+	Height    float64
+	MinWidth  float64
+	MinHeight float64
+	MaxWidth  float64
+	MaxHeight float64
+	Grow      float64
+	Shrink    float64
+	AlignSelf Align
+	Margin    Insets
+	Padding   Insets
+
+	Column     int
+	Row        int
+	ColumnSpan int
+	RowSpan    int
+
+	Kind         Kind
+	Direction    Direction
+	Wrap         bool
+	Justify      Justify
+	AlignItems   Align
+	JustifyItems Align
+	RowGap       float64
+	ColumnGap    float64
+	Columns      []Track
+	Rows         []Track
+	AutoRows     Track
+
+	children []*Node
+	bounds   collision.AABB
+}
+
+// NewNode
+// en: Returns a flex node with Shrink 1
+//
+//	target: Optional; receives the computed box
+//
+// pt_br: Retorna um nó flex com Shrink 1
+//
+//	target: Opcional; recebe a caixa calculada
+func NewNode(id string, target Target) *Node {
+	return &Node{Id: id, Target: target, Shrink: 1, children: make([]*Node, 0)}
+}
+
+// Add
+// en: Appends children and returns the node, so trees can be written inline
+//
+// pt_br: Anexa filhos e retorna o nó, assim árvores podem ser escritas em linha
+func (el *Node) Add(children ...*Node) *Node {
+	el.children = append(el.children, children...)
+	return el
+}
+
+// Remove
+// en: Removes the child with the id; returns false when it does not exist
+//
+// pt_br: Remove o filho com o id; retorna false quando ele não existe
+func (el *Node) Remove(id string) bool {
+	for i, child := range el.children {
+		if child.Id == id {
+			el.children = append(el.children[:i], el.children[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// GetChildren
+// en: Returns the children in order
+//
+// pt_br: Retorna os filhos em ordem
+func (el *Node) GetChildren() []*Node {
+	return append(make([]*Node, 0, len(el.children)), el.children...)
+}
+
+// Find
+// en: Returns the node with the id in the tree, or nil
+//
+// pt_br: Retorna o nó com o id na árvore, ou nil
+func (el *Node) Find(id string) *Node {
+	if el.Id == id {
+		return el
+	}
+	for _, child := range el.children {
+		if found := child.Find(id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// GetBounds
+// en: Returns the box computed by the last layout, padding included
+//
+// pt_br: Retorna a caixa calculada pelo último layout, espaçamento interno
+// incluído
+func (el *Node) GetBounds() collision.AABB {
+	return el.bounds
+}
+
+// GetContentBounds
+// en: Returns the box computed by the last layout, without the padding
+//
+// pt_br: Retorna a caixa calculada pelo último layout, sem o espaçamento interno
+func (el *Node) GetContentBounds() collision.AABB {
+	return collision.NewAABB(
+		el.bounds.X+el.Padding.Left,
+		el.bounds.Y+el.Padding.Top,
+		max0(el.bounds.Width-el.Padding.horizontal()),
+		max0(el.bounds.Height-el.Padding.vertical()),
+	)
+}
+
+// visible
+// en: Returns the children that take part in the layout
+//
+// pt_br: Retorna os filhos que participam do layout
+func (el *Node) visible() []*Node {
+	children := make([]*Node, 0, len(el.children))
+	for _, child := range el.children {
+		if !child.Hidden {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// align
+// en: Returns the alignment of the child, resolving KAlignAuto
+//
+// pt_br: Retorna o alinhamento do filho, resolvendo KAlignAuto
+func (el *Node) align(child *Node) Align {
+	if child.AlignSelf != KAlignAuto {
+		return child.AlignSelf
+	}
+	if el.AlignItems != KAlignAuto {
+		return el.AlignItems
+	}
+	return KAlignStretch
+}
+
+// justify
+// en: Returns the horizontal alignment of children of grids and stacks
+//
+// pt_br: Retorna o alinhamento horizontal de filhos de grades e pilhas
+func (el *Node) justify() Align {
+	if el.JustifyItems != KAlignAuto {
+		return el.JustifyItems
+	}
+	return KAlignStretch
+}
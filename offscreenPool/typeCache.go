@@ -0,0 +1,135 @@
+package offscreenPool
+
+import (
+	"sync"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// Cache
+// en: Keeps pre-rendered surfaces by name, so expensive widgets and sprite effects
+// are drawn only once and copied with DrawImage() on the next frames. The
+// surfaces come from a Pool and go back to it when invalidated
+//
+//	Note: the cache does not count the users of a surface. A surface returned by
+//	Get() is valid only until the next Invalidate() or InvalidateAll(), or the
+//	next Get() of the same key with another size, as these calls give it back to
+//	the pool at once and the pool may hand it to another user. When other
+//	goroutines may invalidate the cache, draw the surface before that happens
+//	and do not keep it between frames
+//
+// pt_br: Guarda superfícies pré-renderizadas por nome, para que widgets caros e
+// efeitos de sprites sejam desenhados apenas uma vez e copiados com DrawImage() nos
+// próximos quadros. As superfícies vêm de um Pool e voltam para ele quando
+// invalidadas
+//
+//	Nota: o cache não conta os usuários de uma superfície. Uma superfície
+//	retornada por Get() é válida apenas até o próximo Invalidate() ou
+//	InvalidateAll(), ou o próximo Get() do mesmo nome com outro tamanho, pois
+//	estas chamadas a devolvem ao pool imediatamente e o pool pode entregá-la a
+//	outro usuário. Quando outras goroutines podem invalidar o cache, desenhe a
+//	superfície antes que isso aconteça e não a guarde entre quadros
+type Cache struct {
+	pool     *Pool
+	surfaces map[string]iotmakerPlatformIDraw.IOffscreenSurface
+	mutex    sync.Mutex
+}
+
+// NewCache
+// en: Returns a new cache that takes its surfaces from the pool
+//
+// pt_br: Retorna um novo cache que pega as suas superfícies do pool
+func NewCache(pool *Pool) *Cache {
+	return &Cache{
+		pool:     pool,
+		surfaces: make(map[string]iotmakerPlatformIDraw.IOffscreenSurface),
+	}
+}
+
+// Get
+// en: Returns the surface saved under key. When the key is unknown or the size
+// changed, a new surface is taken from the pool and render is called to draw its
+// content. The lock of the cache is not held while render runs, so it may use the
+// cache for other keys. The surface is valid only until the key is invalidated,
+// see Cache
+//
+//	key: Name of the cached content
+//	width: The width of the surface, in pixels
+//	height: The height of the surface, in pixels
+//	render: Function that draws the content on the new surface
+//
+// pt_br: Retorna a superfície guardada com o nome key. Quando o nome não existe ou
+// o tamanho mudou, uma nova superfície é pega do pool e render é chamada para
+// desenhar o seu conteúdo. A trava do cache não é mantida durante render, que pode
+// usar o cache para outros nomes. A superfície é válida apenas até o nome ser
+// invalidado, veja Cache
+//
+//	key: Nome do conteúdo guardado
+//	width: Comprimento da superfície em pixels
+//	height: Altura da superfície em pixels
+//	render: Função que desenha o conteúdo na nova superfície
+func (el *Cache) Get(key string, width, height int, render func(surface iotmakerPlatformIDraw.IDraw)) iotmakerPlatformIDraw.IOffscreenSurface {
+	el.mutex.Lock()
+	surface, found := el.surfaces[key]
+	if found && surface.GetWidth() == width && surface.GetHeight() == height {
+		el.mutex.Unlock()
+		return surface
+	}
+	el.mutex.Unlock()
+
+	// en: render runs without the lock, so it may use the cache too
+	// pt_br: render executa sem a trava, assim ela também pode usar o cache
+	rendered := el.pool.Get(width, height)
+	render(rendered)
+
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	surface, found = el.surfaces[key]
+	if found && surface.GetWidth() == width && surface.GetHeight() == height {
+		// en: another call rendered the same key meanwhile
+		// pt_br: outra chamada renderizou o mesmo nome enquanto isso
+		el.pool.Put(rendered)
+		return surface
+	}
+	if found {
+		el.pool.Put(surface)
+	}
+	el.surfaces[key] = rendered
+	return rendered
+}
+
+// Invalidate
+// en: Removes the surface saved under key and gives it back to the pool, so the
+// next Get() renders the content again. The surface returned before for key must
+// not be used after this call
+//
+// pt_br: Remove a superfície guardada com o nome key e a devolve ao pool, para que
+// o próximo Get() renderize o conteúdo novamente. A superfície retornada antes para
+// key não deve ser usada após esta chamada
+func (el *Cache) Invalidate(key string) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+
+	surface, found := el.surfaces[key]
+	if !found {
+		return
+	}
+	delete(el.surfaces, key)
+	el.pool.Put(surface)
+}
+
+// InvalidateAll
+// en: Gives every cached surface back to the pool. The surfaces returned before by
+// Get() must not be used after this call
+//
+// pt_br: Devolve todas as superfícies guardadas ao pool. As superfícies retornadas
+// antes por Get() não devem ser usadas após esta chamada
+func (el *Cache) InvalidateAll() {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+
+	for key, surface := range el.surfaces {
+		delete(el.surfaces, key)
+		el.pool.Put(surface)
+	}
+}
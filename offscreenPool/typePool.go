@@ -0,0 +1,162 @@
+package offscreenPool
+
+import (
+	"sync"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// KDefaultMaxPerSize
+// en: Default number of free surfaces kept by the pool for each size
+//
+// pt_br: Número padrão de superfícies livres guardadas pelo pool para cada tamanho
+const KDefaultMaxPerSize = 4
+
+// Platform
+// en: Part of IDraw used by the pool to create surfaces and to read the device
+// pixel ratio given to them
+//
+// pt_br: Parte de IDraw usada pelo pool para criar superfícies e para ler a razão
+// de pixels do dispositivo dada a elas
+type Platform interface {
+	CreateOffscreen(width, height int) iotmakerPlatformIDraw.IOffscreenSurface
+	GetDevicePixelRatio() float64
+}
+
+// Pool
+// en: Keeps released off-screen surfaces and hands them back when a surface of the
+// same size is requested, avoiding the cost of creating a new canvas every frame.
+// The pool is safe for concurrent use
+//
+// pt_br: Guarda as superfícies fora da tela liberadas e as devolve quando uma
+// superfície do mesmo tamanho é pedida, evitando o custo de criar um novo canvas a
+// cada quadro. O pool é seguro para uso concorrente
+type Pool struct {
+	platform   Platform
+	maxPerSize int
+	free       map[size][]iotmakerPlatformIDraw.IOffscreenSurface
+	mutex      sync.Mutex
+}
+
+type size struct {
+	width  int
+	height int
+}
+
+// NewPool
+// en: Returns a new pool
+//
+//	platform: Platform used to create new surfaces
+//	maxPerSize: Number of free surfaces kept for each size. Surfaces above this
+//	            limit are released. Use zero for KDefaultMaxPerSize
+//
+// pt_br: Retorna um novo pool
+//
+//	platform: Plataforma usada para criar novas superfícies
+//	maxPerSize: Número de superfícies livres guardadas para cada tamanho.
+//	            Superfícies acima deste limite são liberadas. Use zero para
+//	            KDefaultMaxPerSize
+func NewPool(platform Platform, maxPerSize int) *Pool {
+	if maxPerSize <= 0 {
+		maxPerSize = KDefaultMaxPerSize
+	}
+	return &Pool{
+		platform:   platform,
+		maxPerSize: maxPerSize,
+		free:       make(map[size][]iotmakerPlatformIDraw.IOffscreenSurface),
+	}
+}
+
+// Get
+// en: Returns a clear surface with the given size, reusing a free surface when
+// possible. Every surface returned has the device pixel ratio of the platform, an
+// empty current path and the drawing state of NewDrawState(), so the ratio,
+// transform, alpha, filter, styles and path left by the previous user are not
+// kept. As in SetTransform(), the identity transform of NewDrawState() is relative
+// to the ratio, so the surface keeps working with logical pixels
+//
+// pt_br: Retorna uma superfície limpa com o tamanho informado, reaproveitando uma
+// superfície livre quando possível. Toda superfície retornada tem a razão de pixels
+// do dispositivo da plataforma, um caminho atual vazio e o estado de desenho de
+// NewDrawState(), assim a razão, a transformação, o alfa, o filtro, os estilos e o
+// caminho deixados pelo usuário anterior não são mantidos. Como em SetTransform(),
+// a transformação identidade de NewDrawState() é relativa à razão, assim a
+// superfície continua trabalhando com pixels lógicos
+func (el *Pool) Get(width, height int) (surface iotmakerPlatformIDraw.IOffscreenSurface) {
+	key := size{width: width, height: height}
+	ratio := el.platform.GetDevicePixelRatio()
+
+	el.mutex.Lock()
+	list := el.free[key]
+	if len(list) == 0 {
+		el.mutex.Unlock()
+		surface = el.platform.CreateOffscreen(width, height)
+		if surface.GetDevicePixelRatio() != ratio {
+			surface.SetDevicePixelRatio(ratio)
+		}
+		return
+	}
+	surface = list[len(list)-1]
+	el.free[key] = list[:len(list)-1]
+	el.mutex.Unlock()
+
+	if surface.GetDevicePixelRatio() != ratio {
+		surface.SetDevicePixelRatio(ratio)
+	}
+	surface.SetState(iotmakerPlatformIDraw.NewDrawState())
+	surface.BeginPath()
+	surface.ClearRect(0, 0, width, height)
+	return
+}
+
+// Put
+// en: Gives the surface back to the pool. The surface must not be used after this
+// call
+//
+// pt_br: Devolve a superfície ao pool. A superfície não deve ser usada após esta
+// chamada
+func (el *Pool) Put(surface iotmakerPlatformIDraw.IOffscreenSurface) {
+	if surface == nil {
+		return
+	}
+	key := size{width: surface.GetWidth(), height: surface.GetHeight()}
+
+	el.mutex.Lock()
+	if len(el.free[key]) >= el.maxPerSize {
+		el.mutex.Unlock()
+		surface.Release()
+		return
+	}
+	el.free[key] = append(el.free[key], surface)
+	el.mutex.Unlock()
+}
+
+// Len
+// en: Returns the number of free surfaces kept by the pool
+//
+// pt_br: Retorna o número de superfícies livres guardadas pelo pool
+func (el *Pool) Len() (length int) {
+	el.mutex.Lock()
+	defer el.mutex.Unlock()
+	for _, list := range el.free {
+		length += len(list)
+	}
+	return
+}
+
+// Purge
+// en: Releases every free surface kept by the pool
+//
+// pt_br: Libera todas as superfícies livres guardadas pelo pool
+func (el *Pool) Purge() {
+	el.mutex.Lock()
+	free := el.free
+	el.free = make(map[size][]iotmakerPlatformIDraw.IOffscreenSurface)
+	el.mutex.Unlock()
+
+	for _, list := range free {
+		for _, surface := range list {
+			surface.Release()
+		}
+	}
+}
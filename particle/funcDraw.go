@@ -0,0 +1,151 @@
+package particle
+
+import (
+	"image/color"
+	"math"
+)
+
+// Platform
+// en: Part of IDraw used to draw the particles
+//
+// pt_br: Parte de IDraw usada para desenhar as partículas
+type Platform interface {
+	BeginPath()
+	MoveTo(x, y interface{})
+	LineTo(x, y interface{})
+	ClosePath(x, y interface{})
+	Fill()
+	SetFillStyle(value interface{})
+	SetGlobalAlpha(value float64)
+	GetGlobalAlpha() float64
+	DrawImage(image interface{}, value ...interface{})
+	GetTransform() [6]float64
+	SetTransform(a, b, c, d, e, f float64)
+	Save()
+	Restore()
+}
+
+// Draw
+// en: Draws the living particles, from the oldest to the newest, inside Save()
+// and Restore(). Circles are drawn as polygons, with more sides for the larger
+// ones
+//
+// pt_br: Desenha as partículas vivas, da mais velha para a mais nova, dentro de
+// Save() e Restore(). Círculos são desenhados como polígonos, com mais lados para
+// os maiores
+func (el *Emitter) Draw(platform Platform) {
+	if el.count == 0 {
+		return
+	}
+	config := el.config
+	platform.Save()
+	defer platform.Restore()
+	alpha := platform.GetGlobalAlpha()
+	base := platform.GetTransform()
+
+	for i := 0; i < el.count; i += 1 {
+		item := &el.particles[i]
+		life := item.age / item.lifetime
+		size := config.Size.At(life) * item.scale
+		if size <= 0 {
+			continue
+		}
+		fill := config.Color.At(life)
+		if item.color != -1 {
+			fill.R, fill.G, fill.B = config.Palette[item.color].R, config.Palette[item.color].G, config.Palette[item.color].B
+		}
+		opacity := math.Max(0, math.Min(1, config.Alpha.At(life))) * float64(fill.A) / 255
+		if opacity <= 0 {
+			continue
+		}
+
+		switch config.Shape {
+		case KShapeCircle:
+			fill.A = uint8(math.Round(opacity * 255))
+			circle(platform, item.x, item.y, size/2, fill)
+
+		case KShapeSquare:
+			fill.A = uint8(math.Round(opacity * 255))
+			square(platform, item.x, item.y, size/2, item.rotation, fill)
+
+		case KShapeTexture:
+			if config.Texture == nil {
+				continue
+			}
+			platform.SetGlobalAlpha(alpha * opacity)
+			el.texture(platform, base, item, size)
+		}
+	}
+}
+
+// circle
+// en: Fills a circle as a polygon with 6 to 24 sides
+//
+// pt_br: Preenche um círculo como um polígono de 6 a 24 lados
+func circle(platform Platform, x, y, radius float64, fill color.RGBA) {
+	sides := int(math.Max(6, math.Min(24, math.Ceil(radius*2))))
+	platform.SetFillStyle(fill)
+	platform.BeginPath()
+	platform.MoveTo(x+radius, y)
+	for i := 1; i < sides; i += 1 {
+		sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(sides))
+		platform.LineTo(x+radius*cos, y+radius*sin)
+	}
+	platform.ClosePath(x+radius, y)
+	platform.Fill()
+}
+
+// square
+// en: Fills a square centered at (x, y) turned by rotation, in radians
+//
+// pt_br: Preenche um quadrado centrado em (x, y) girado por rotation, em radianos
+func square(platform Platform, x, y, half, rotation float64, fill color.RGBA) {
+	sin, cos := math.Sincos(rotation)
+	platform.SetFillStyle(fill)
+	platform.BeginPath()
+	for i, corner := range [4][2]float64{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}} {
+		cornerX := x + half*(corner[0]*cos-corner[1]*sin)
+		cornerY := y + half*(corner[0]*sin+corner[1]*cos)
+		if i == 0 {
+			platform.MoveTo(cornerX, cornerY)
+		} else {
+			platform.LineTo(cornerX, cornerY)
+		}
+	}
+	platform.ClosePath(x+half*(-cos+sin), y+half*(-sin-cos))
+	platform.Fill()
+}
+
+// texture
+// en: Draws the texture centered on the particle; turned particles are drawn
+// through the transformation, over base, the transformation of the platform
+//
+// pt_br: Desenha a textura centrada na partícula; partículas giradas são
+// desenhadas pela transformação, sobre base, a transformação da plataforma
+func (el *Emitter) texture(platform Platform, base [6]float64, item *particle, size float64) {
+	config := el.config
+	side := int(math.Max(1, math.Round(size)))
+	draw := func(x, y int) {
+		if config.TextureWidth > 0 && config.TextureHeight > 0 {
+			platform.DrawImage(config.Texture, config.TextureX, config.TextureY, config.TextureWidth, config.TextureHeight, x, y, side, side)
+			return
+		}
+		platform.DrawImage(config.Texture, x, y, side, side)
+	}
+
+	if item.rotation == 0 && item.spin == 0 {
+		draw(int(math.Round(item.x-size/2)), int(math.Round(item.y-size/2)))
+		return
+	}
+	sin, cos := math.Sincos(item.rotation)
+	platform.SetTransform(
+		base[0]*cos+base[2]*sin,
+		base[1]*cos+base[3]*sin,
+		-base[0]*sin+base[2]*cos,
+		-base[1]*sin+base[3]*cos,
+		base[0]*item.x+base[2]*item.y+base[4],
+		base[1]*item.x+base[3]*item.y+base[5],
+	)
+	draw(-side/2, -side/2)
+	platform.SetTransform(base[0], base[1], base[2], base[3], base[4], base[5])
+}
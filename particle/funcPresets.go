@@ -0,0 +1,84 @@
+package particle
+
+import (
+	"image/color"
+)
+
+// Sparks
+// en: Returns the configuration of hot sparks thrown up and pulled down by
+// gravity, for alarms and welding effects
+//
+// pt_br: Retorna a configuração de faíscas quentes lançadas para cima e puxadas
+// para baixo pela gravidade, para alarmes e efeitos de solda
+func Sparks() Config {
+	return Config{
+		Rate:          80,
+		MaxParticles:  200,
+		Lifetime:      Range{Min: 0.3, Max: 0.8},
+		Speed:         Range{Min: 120, Max: 280},
+		Direction:     Range{Min: -135, Max: -45},
+		AccelerationY: 500,
+		Size:          Curve{{Time: 0, Value: 3}, {Time: 1, Value: 1}},
+		Color: ColorCurve{
+			{Time: 0, Color: color.RGBA{R: 0xff, G: 0xf5, B: 0xc0, A: 0xff}},
+			{Time: 0.4, Color: color.RGBA{R: 0xff, G: 0xa0, B: 0x20, A: 0xff}},
+			{Time: 1, Color: color.RGBA{R: 0xc0, G: 0x20, B: 0x00, A: 0xff}},
+		},
+		Alpha: Curve{{Time: 0.6, Value: 1}, {Time: 1, Value: 0}},
+	}
+}
+
+// Smoke
+// en: Returns the configuration of slow smoke that rises, grows and fades
+//
+// pt_br: Retorna a configuração de fumaça lenta que sobe, cresce e desaparece
+func Smoke() Config {
+	return Config{
+		Rate:          15,
+		MaxParticles:  80,
+		AreaWidth:     10,
+		Lifetime:      Range{Min: 2, Max: 3.5},
+		Speed:         Range{Min: 10, Max: 30},
+		Direction:     Range{Min: -100, Max: -80},
+		AccelerationY: -10,
+		Drag:          0.3,
+		Scale:         Range{Min: 0.8, Max: 1.2},
+		Size:          Curve{{Time: 0, Value: 8}, {Time: 1, Value: 36}},
+		Color: ColorCurve{
+			{Time: 0, Color: color.RGBA{R: 0x60, G: 0x60, B: 0x60, A: 0xff}},
+			{Time: 1, Color: color.RGBA{R: 0xa0, G: 0xa0, B: 0xa0, A: 0xff}},
+		},
+		Alpha: Curve{{Time: 0, Value: 0}, {Time: 0.2, Value: 0.45}, {Time: 1, Value: 0}},
+	}
+}
+
+// Confetti
+// en: Returns the configuration of a single burst of spinning colored squares
+//
+// pt_br: Retorna a configuração de uma única explosão de quadrados coloridos
+// girando
+func Confetti() Config {
+	return Config{
+		Burst:         150,
+		MaxParticles:  150,
+		Lifetime:      Range{Min: 2, Max: 4},
+		Speed:         Range{Min: 150, Max: 380},
+		Direction:     Range{Min: -135, Max: -45},
+		AccelerationY: 300,
+		Drag:          0.9,
+		Rotation:      Range{Min: 0, Max: 360},
+		Spin:          Range{Min: -540, Max: 540},
+		Scale:         Range{Min: 0.7, Max: 1.3},
+		Size:          Curve{{Value: 7}},
+		Palette: []color.RGBA{
+			{R: 0xe5, G: 0x39, B: 0x35, A: 0xff},
+			{R: 0xfd, G: 0xd8, B: 0x35, A: 0xff},
+			{R: 0x43, G: 0xa0, B: 0x47, A: 0xff},
+			{R: 0x1e, G: 0x88, B: 0xe5, A: 0xff},
+			{R: 0x8e, G: 0x24, B: 0xaa, A: 0xff},
+			{R: 0xff, G: 0x70, B: 0x43, A: 0xff},
+		},
+		Alpha: Curve{{Time: 0.8, Value: 1}, {Time: 1, Value: 0}},
+		Shape: KShapeSquare,
+	}
+}
@@ -0,0 +1,191 @@
+package particle
+
+import (
+	"image/color"
+	"time"
+)
+
+// Shape
+// en: How the particles are drawn
+//
+// pt_br: Como as partículas são desenhadas
+type Shape int
+
+const (
+	// KShapeCircle
+	// en: Filled circle with the diameter of the size curve
+	//
+	// pt_br: Círculo preenchido com o diâmetro da curva de tamanho
+	KShapeCircle Shape = iota
+
+	// KShapeSquare
+	// en: Filled square with the side of the size curve, turned by the rotation of
+	// the particle, like confetti
+	//
+	// pt_br: Quadrado preenchido com o lado da curva de tamanho, girado pela
+	// rotação da partícula, como confete
+	KShapeSquare
+
+	// KShapeTexture
+	// en: Config.Texture drawn with IDraw.DrawImage() in a square with the side of
+	// the size curve, turned by the rotation of the particle. The canvas can not
+	// tint images, so only the alpha of the colors is used
+	//
+	// pt_br: Config.Texture desenhada com IDraw.DrawImage() em um quadrado com o
+	// lado da curva de tamanho, girado pela rotação da partícula. O canvas não
+	// consegue tingir imagens, assim somente o alpha das cores é usado
+	KShapeTexture
+)
+
+const (
+	// KDefaultMaxParticles
+	// en: Size of the pool of particles when Config.MaxParticles is 0
+	//
+	// pt_br: Tamanho do reservatório de partículas quando Config.MaxParticles é 0
+	KDefaultMaxParticles = 500
+
+	// KDefaultStep
+	// en: Fixed timestep of the simulation when Config.Step is 0
+	//
+	// pt_br: Passo de tempo fixo da simulação quando Config.Step é 0
+	KDefaultStep = time.Second / 60
+
+	// KMaxStepsPerUpdate
+	// en: Steps simulated by one Update() at most; the rest of a long pause, like a
+	// hidden browser tab, is dropped instead of stalling the frame
+	//
+	// pt_br: Passos simulados por um Update() no máximo; o resto de uma pausa
+	// longa, como uma aba escondida do navegador, é descartado em vez de travar o
+	// quadro
+	KMaxStepsPerUpdate = 8
+)
+
+// Config
+// en: Behaviour of the particles of an emitter. Zero values take the defaults
+// noted
+//
+//	Rate: Particles born per second while the emitter runs
+//	Burst: Particles born at once by Start()
+//	Duration: Time the emitter runs after Start(); 0 runs until Stop()
+//	MaxParticles: Size of the pool, allocated once; particles born with the pool
+//	              full are dropped. Default KDefaultMaxParticles
+//	Step: Fixed timestep of the simulation. Default KDefaultStep
+//	Seed: Seed of the random values; 0 uses the clock
+//	AreaWidth, AreaHeight: Size of the rectangle, centered on the emitter, where
+//	                       the particles are born
+//	Lifetime: Life of each particle, in seconds. Default 1 second
+//	Speed: Initial speed, in pixels per second
+//	Direction: Initial direction, in degrees clockwise from the 3 o'clock
+//	           direction, like the canvas; -90 points up
+//	AccelerationX, AccelerationY: Acceleration, in pixels per second squared,
+//	                              like gravity or wind
+//	Drag: Fraction of the speed lost per second
+//	Rotation: Initial rotation of squares and textures, in degrees
+//	Spin: Speed of rotation, in degrees per second
+//	Scale: Factor of the size of each particle. Default 1
+//	Size: Size, in pixels, over the life. Default 4 pixels
+//	Color: Color over the life. Default white
+//	Palette: Colors picked at random, one per particle, in place of Color
+//	Alpha: Opacity over the life, multiplied by the alpha of the color. Default 1
+//	Shape: How the particles are drawn
+//	Texture: Image, canvas or video of KShapeTexture
+//	TextureX, TextureY, TextureWidth, TextureHeight: Part of the texture drawn,
+//	                                                like a sprite sheet; a width
+//	                                                of 0 draws the whole image
+//
+// pt_br: Comportamento das partículas de um emissor. Valores zero assumem os
+// padrões indicados
+//
+//	Rate: Partículas nascidas por segundo enquanto o emissor funciona
+//	Burst: Partículas nascidas de uma vez por Start()
+//	Duration: Tempo que o emissor funciona depois de Start(); 0 funciona até
+//	          Stop()
+//	MaxParticles: Tamanho do reservatório, alocado uma vez; partículas nascidas
+//	              com o reservatório cheio são descartadas. Padrão
+//	              KDefaultMaxParticles
+//	Step: Passo de tempo fixo da simulação. Padrão KDefaultStep
+//	Seed: Semente dos valores aleatórios; 0 usa o relógio
+//	AreaWidth, AreaHeight: Tamanho do retângulo, centrado no emissor, onde as
+//	                       partículas nascem
+//	Lifetime: Vida de cada partícula, em segundos. Padrão 1 segundo
+//	Speed: Velocidade inicial, em pixels por segundo
+//	Direction: Direção inicial, em graus no sentido horário a partir da direção
+//	           das 3 horas, como o canvas; -90 aponta para cima
+//	AccelerationX, AccelerationY: Aceleração, em pixels por segundo ao quadrado,
+//	                              como a gravidade ou o vento
+//	Drag: Fração da velocidade perdida por segundo
+//	Rotation: Rotação inicial de quadrados e texturas, em graus
+//	Spin: Velocidade de rotação, em graus por segundo
+//	Scale: Fator do tamanho de cada partícula. Padrão 1
+//	Size: Tamanho, em pixels, ao longo da vida. Padrão 4 pixels
+//	Color: Cor ao longo da vida. Padrão branco
+//	Palette: Cores sorteadas, uma por partícula, no lugar de Color
+//	Alpha: Opacidade ao longo da vida, multiplicada pelo alpha da cor. Padrão 1
+//	Shape: Como as partículas são desenhadas
+//	Texture: Imagem, canvas ou vídeo de KShapeTexture
+//	TextureX, TextureY, TextureWidth, TextureHeight: Parte da textura desenhada,
+//	                                                como uma folha de sprites;
+//	                                                uma largura 0 desenha a
+//	                                                imagem inteira
+type Config struct {
+	Rate         float64
+	Burst        int
+	Duration     time.Duration
+	MaxParticles int
+	Step         time.Duration
+	Seed         int64
+
+	AreaWidth  float64
+	AreaHeight float64
+
+	Lifetime      Range
+	Speed         Range
+	Direction     Range
+	AccelerationX float64
+	AccelerationY float64
+	Drag          float64
+	Rotation      Range
+	Spin          Range
+	Scale         Range
+
+	Size    Curve
+	Color   ColorCurve
+	Palette []color.RGBA
+	Alpha   Curve
+
+	Shape         Shape
+	Texture       interface{}
+	TextureX      int
+	TextureY      int
+	TextureWidth  int
+	TextureHeight int
+}
+
+// defaults
+// en: Returns the configuration with the zero values replaced by the defaults
+//
+// pt_br: Retorna a configuração com os valores zero substituídos pelos padrões
+func (el Config) defaults() Config {
+	if el.MaxParticles <= 0 {
+		el.MaxParticles = KDefaultMaxParticles
+	}
+	if el.Step <= 0 {
+		el.Step = KDefaultStep
+	}
+	if el.Lifetime.Max <= 0 {
+		el.Lifetime = Range{Min: 1, Max: 1}
+	}
+	if el.Scale == (Range{}) {
+		el.Scale = Range{Min: 1, Max: 1}
+	}
+	if len(el.Size) == 0 {
+		el.Size = Curve{{Value: 4}}
+	}
+	if len(el.Color) == 0 {
+		el.Color = ColorCurve{{Color: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}}}
+	}
+	if len(el.Alpha) == 0 {
+		el.Alpha = Curve{{Value: 1}}
+	}
+	return el
+}
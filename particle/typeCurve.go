@@ -0,0 +1,109 @@
+package particle
+
+import (
+	"image/color"
+	"math/rand"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+)
+
+// Range
+// en: Interval from which each particle draws a random value when it is born
+//
+// pt_br: Intervalo do qual cada partícula sorteia um valor aleatório quando nasce
+type Range struct {
+	Min float64
+	Max float64
+}
+
+// pick
+// en: Returns a random value between Min and Max
+//
+// pt_br: Retorna um valor aleatório entre Min e Max
+func (el Range) pick(random *rand.Rand) float64 {
+	if el.Max == el.Min {
+		return el.Min
+	}
+	return el.Min + (el.Max-el.Min)*random.Float64()
+}
+
+// Key
+// en: Value of a curve at a point of the life of the particle
+//
+//	Time: Fraction of the life, from 0.0, at birth, to 1.0, at death
+//
+// pt_br: Valor de uma curva em um ponto da vida da partícula
+//
+//	Time: Fração da vida, de 0.0, no nascimento, a 1.0, na morte
+type Key struct {
+	Time  float64
+	Value float64
+}
+
+// Curve
+// en: Value that changes over the life of the particle, interpolated linearly
+// between keys sorted by Time; the first and the last keys hold before and after
+// them
+//
+// pt_br: Valor que muda ao longo da vida da partícula, interpolado linearmente
+// entre chaves ordenadas por Time; a primeira e a última chaves valem antes e
+// depois delas
+type Curve []Key
+
+// At
+// en: Returns the value at the fraction of the life
+//
+// pt_br: Retorna o valor na fração da vida
+func (el Curve) At(time float64) float64 {
+	if len(el) == 0 {
+		return 0
+	}
+	if time <= el[0].Time {
+		return el[0].Value
+	}
+	for i := 1; i < len(el); i += 1 {
+		if time < el[i].Time {
+			previous := el[i-1]
+			fraction := (time - previous.Time) / (el[i].Time - previous.Time)
+			return previous.Value + (el[i].Value-previous.Value)*fraction
+		}
+	}
+	return el[len(el)-1].Value
+}
+
+// ColorKey
+// en: Color of a color curve at a point of the life of the particle
+//
+// pt_br: Cor de uma curva de cores em um ponto da vida da partícula
+type ColorKey struct {
+	Time  float64
+	Color color.RGBA
+}
+
+// ColorCurve
+// en: Color that changes over the life of the particle, mixed in OKLab between
+// keys sorted by Time, like colorUtils.Mix()
+//
+// pt_br: Cor que muda ao longo da vida da partícula, misturada em OKLab entre
+// chaves ordenadas por Time, como colorUtils.Mix()
+type ColorCurve []ColorKey
+
+// At
+// en: Returns the color at the fraction of the life
+//
+// pt_br: Retorna a cor na fração da vida
+func (el ColorCurve) At(time float64) color.RGBA {
+	if len(el) == 0 {
+		return color.RGBA{}
+	}
+	if time <= el[0].Time {
+		return el[0].Color
+	}
+	for i := 1; i < len(el); i += 1 {
+		if time < el[i].Time {
+			previous := el[i-1]
+			return colorUtils.Mix(previous.Color, el[i].Color, (time-previous.Time)/(el[i].Time-previous.Time))
+		}
+	}
+	return el[len(el)-1].Color
+}
@@ -0,0 +1,276 @@
+package particle
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// particle
+// en: State of one particle of the pool
+//
+// pt_br: Estado de uma partícula do reservatório
+type particle struct {
+	x, y     float64
+	vx, vy   float64
+	rotation float64
+	spin     float64
+	scale    float64
+	age      float64
+	lifetime float64
+	color    int
+}
+
+// Emitter
+// en: Source of particles with a pool allocated once, so emitting and updating do
+// not allocate memory. The simulation advances with a fixed timestep, which keeps
+// the motion the same at any frame rate.
+//
+// This module has no frame scheduler: call Update() from the loop that draws the
+// frames, like a requestAnimationFrame callback, and Draw() when it returns true,
+// as with the gauges
+//
+// pt_br: Fonte de partículas com um reservatório alocado uma vez, assim emitir e
+// atualizar não alocam memória. A simulação avança com um passo de tempo fixo, o
+// que mantém o movimento igual em qualquer taxa de quadros.
+//
+// Este módulo não tem um agendador de quadros: chame Update() a partir do laço que
+// desenha os quadros, como um callback de requestAnimationFrame, e Draw() quando
+// ele retornar true, como nos mostradores
+type Emitter struct {
+	X float64
+	Y float64
+
+	config    Config
+	particles []particle
+	count     int
+	random    *rand.Rand
+
+	running     bool
+	elapsed     time.Duration
+	debt        float64
+	accumulator time.Duration
+	last        time.Time
+}
+
+// NewEmitter
+// en: Returns a stopped emitter at (x, y) with the pool of the configuration
+//
+// pt_br: Retorna um emissor parado em (x, y) com o reservatório da configuração
+func NewEmitter(x, y float64, config Config) *Emitter {
+	config = config.defaults()
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Emitter{
+		X:         x,
+		Y:         y,
+		config:    config,
+		particles: make([]particle, config.MaxParticles),
+		random:    rand.New(rand.NewSource(seed)),
+	}
+}
+
+// GetConfig
+// en: Returns the configuration, with the defaults applied
+//
+// pt_br: Retorna a configuração, com os padrões aplicados
+func (el *Emitter) GetConfig() Config {
+	return el.config
+}
+
+// SetConfig
+// en: Changes the behaviour of the new particles; the pool is reallocated, and
+// the living particles dropped, only when MaxParticles changes. When the length
+// of Palette changes, the living particles pick a new color of the palette
+//
+// pt_br: Muda o comportamento das novas partículas; o reservatório é realocado, e
+// as partículas vivas descartadas, somente quando MaxParticles muda. Quando o
+// comprimento de Palette muda, as partículas vivas escolhem uma nova cor da paleta
+func (el *Emitter) SetConfig(config Config) {
+	config = config.defaults()
+	if config.MaxParticles != len(el.particles) {
+		el.particles = make([]particle, config.MaxParticles)
+		el.count = 0
+	}
+	if len(config.Palette) != len(el.config.Palette) {
+		for i := 0; i < el.count; i += 1 {
+			el.particles[i].color = -1
+			if len(config.Palette) != 0 {
+				el.particles[i].color = el.random.Intn(len(config.Palette))
+			}
+		}
+	}
+	el.config = config
+}
+
+// SetPosition
+// en: Moves the emitter; the living particles keep their places
+//
+// pt_br: Move o emissor; as partículas vivas mantêm os seus lugares
+func (el *Emitter) SetPosition(x, y float64) {
+	el.X, el.Y = x, y
+}
+
+// Start
+// en: Emits Config.Burst particles and starts emitting Config.Rate per second
+//
+// pt_br: Emite Config.Burst partículas e começa a emitir Config.Rate por segundo
+func (el *Emitter) Start() {
+	el.running = true
+	el.elapsed = 0
+	el.debt = 0
+	el.Burst(el.config.Burst)
+}
+
+// Stop
+// en: Stops emitting; the living particles finish their lives
+//
+// pt_br: Para de emitir; as partículas vivas terminam as suas vidas
+func (el *Emitter) Stop() {
+	el.running = false
+}
+
+// Clear
+// en: Removes every living particle
+//
+// pt_br: Remove todas as partículas vivas
+func (el *Emitter) Clear() {
+	el.count = 0
+}
+
+// Burst
+// en: Emits particles at once, limited by the free space of the pool
+//
+// pt_br: Emite partículas de uma vez, limitadas pelo espaço livre do reservatório
+func (el *Emitter) Burst(count int) {
+	for i := 0; i < count && el.count < len(el.particles); i += 1 {
+		el.spawn()
+	}
+}
+
+// GetCount
+// en: Returns the number of living particles
+//
+// pt_br: Retorna o número de partículas vivas
+func (el *Emitter) GetCount() int {
+	return el.count
+}
+
+// IsActive
+// en: Returns true while the emitter runs or has living particles; an alarm
+// effect may be removed from the scene when it returns false
+//
+// pt_br: Retorna true enquanto o emissor funciona ou tem partículas vivas; um
+// efeito de alarme pode ser removido da cena quando retorna false
+func (el *Emitter) IsActive() bool {
+	return el.running || el.count > 0
+}
+
+// Update
+// en: Advances the simulation by whole fixed steps up to now, at most
+// KMaxStepsPerUpdate; returns true when the particles must be redrawn. The first
+// call only takes the time
+//
+// pt_br: Avança a simulação em passos fixos inteiros até now, no máximo
+// KMaxStepsPerUpdate; retorna true quando as partículas precisam ser redesenhadas.
+// A primeira chamada apenas registra o instante
+func (el *Emitter) Update(now time.Time) bool {
+	if el.last.IsZero() || now.Before(el.last) {
+		el.last = now
+		return false
+	}
+	el.accumulator += now.Sub(el.last)
+	el.last = now
+
+	before := el.count
+	steps := 0
+	for el.accumulator >= el.config.Step {
+		if steps == KMaxStepsPerUpdate {
+			el.accumulator = 0
+			break
+		}
+		el.Step()
+		el.accumulator -= el.config.Step
+		steps += 1
+	}
+	return steps > 0 && (before > 0 || el.count > 0)
+}
+
+// Step
+// en: Advances the simulation by one fixed step, for loops that keep their own
+// time
+//
+// pt_br: Avança a simulação em um passo fixo, para laços que controlam o seu
+// próprio tempo
+func (el *Emitter) Step() {
+	config := el.config
+	delta := config.Step.Seconds()
+	drag := math.Max(0, 1-config.Drag*delta)
+
+	// en: the survivors are packed in order, so the drawing order does not change
+	// pt_br: as sobreviventes são compactadas em ordem, assim a ordem de desenho
+	// não muda
+	alive := 0
+	for i := 0; i < el.count; i += 1 {
+		item := el.particles[i]
+		item.age += delta
+		if item.age >= item.lifetime {
+			continue
+		}
+		item.vx = (item.vx + config.AccelerationX*delta) * drag
+		item.vy = (item.vy + config.AccelerationY*delta) * drag
+		item.x += item.vx * delta
+		item.y += item.vy * delta
+		item.rotation += item.spin * delta
+		el.particles[alive] = item
+		alive += 1
+	}
+	el.count = alive
+
+	if !el.running {
+		return
+	}
+	el.elapsed += config.Step
+	if config.Duration > 0 && el.elapsed > config.Duration {
+		el.running = false
+		return
+	}
+	el.debt += config.Rate * delta
+	// en: particles that do not fit in the pool are dropped, not owed
+	// pt_br: partículas que não cabem no reservatório são descartadas, não devidas
+	if free := float64(len(el.particles) - el.count); el.debt > free {
+		el.debt = free
+	}
+	for ; el.debt >= 1; el.debt -= 1 {
+		el.spawn()
+	}
+}
+
+// spawn
+// en: Starts a particle in the first free place of the pool
+//
+// pt_br: Inicia uma partícula no primeiro lugar livre do reservatório
+func (el *Emitter) spawn() {
+	config := el.config
+	speed := config.Speed.pick(el.random)
+	direction := config.Direction.pick(el.random) * math.Pi / 180
+	item := &el.particles[el.count]
+	el.count += 1
+
+	*item = particle{
+		x:        el.X + (el.random.Float64()-0.5)*config.AreaWidth,
+		y:        el.Y + (el.random.Float64()-0.5)*config.AreaHeight,
+		vx:       speed * math.Cos(direction),
+		vy:       speed * math.Sin(direction),
+		rotation: config.Rotation.pick(el.random) * math.Pi / 180,
+		spin:     config.Spin.pick(el.random) * math.Pi / 180,
+		scale:    config.Scale.pick(el.random),
+		lifetime: math.Max(config.Lifetime.pick(el.random), config.Step.Seconds()),
+		color:    -1,
+	}
+	if len(config.Palette) != 0 {
+		item.color = el.random.Intn(len(config.Palette))
+	}
+}
@@ -0,0 +1,71 @@
+package pixelRatio
+
+import (
+	"image/color"
+	"math"
+)
+
+// PhysicalToLogicalImageData
+// en: Converts image data read from a backing store of physical pixels into image
+// data of logical pixels, in the format returned by IDraw.GetImageData(). Each
+// logical pixel is the average of the physical pixels it covers, weighted by the
+// covered area and by the alpha channel
+//
+//	data: map[x][y]color.RGBA in physical coordinates, relative to the canvas
+//	x, y, width, height: logical rectangle to convert, relative to the canvas
+//	ratio: Device pixel ratio
+//	return: map[x][y]color.RGBA in logical coordinates, relative to the canvas
+//
+// pt_br: Converte os dados de imagem lidos de uma memória de imagem de pixels
+// físicos em dados de imagem de pixels lógicos, no formato retornado por
+// IDraw.GetImageData(). Cada pixel lógico é a média dos pixels físicos que ele
+// cobre, ponderada pela área coberta e pelo canal alpha
+//
+//	data: map[x][y]color.RGBA em coordenadas físicas, relativas ao canvas
+//	x, y, width, height: retângulo lógico a ser convertido, relativo ao canvas
+//	ratio: Razão de pixels do dispositivo
+//	return: map[x][y]color.RGBA em coordenadas lógicas, relativas ao canvas
+func PhysicalToLogicalImageData(data map[int]map[int]color.RGBA, x, y, width, height int, ratio Ratio) map[int]map[int]color.RGBA {
+	ratio = ratio.valid()
+	scale := float64(ratio)
+	result := make(map[int]map[int]color.RGBA, width)
+
+	for logicalX := x; logicalX < x+width; logicalX += 1 {
+		result[logicalX] = make(map[int]color.RGBA, height)
+		left := float64(logicalX) * scale
+		right := float64(logicalX+1) * scale
+
+		for logicalY := y; logicalY < y+height; logicalY += 1 {
+			top := float64(logicalY) * scale
+			bottom := float64(logicalY+1) * scale
+
+			var red, green, blue, alpha, area float64
+			for physicalX := int(math.Floor(left)); float64(physicalX) < right; physicalX += 1 {
+				coverX := math.Min(right, float64(physicalX+1)) - math.Max(left, float64(physicalX))
+				for physicalY := int(math.Floor(top)); float64(physicalY) < bottom; physicalY += 1 {
+					coverY := math.Min(bottom, float64(physicalY+1)) - math.Max(top, float64(physicalY))
+					weight := coverX * coverY
+					pixel := data[physicalX][physicalY]
+					pixelAlpha := float64(pixel.A) * weight
+					red += float64(pixel.R) * pixelAlpha
+					green += float64(pixel.G) * pixelAlpha
+					blue += float64(pixel.B) * pixelAlpha
+					alpha += pixelAlpha
+					area += weight
+				}
+			}
+
+			if alpha == 0 || area == 0 {
+				result[logicalX][logicalY] = color.RGBA{}
+				continue
+			}
+			result[logicalX][logicalY] = color.RGBA{
+				R: uint8(math.Round(red / alpha)),
+				G: uint8(math.Round(green / alpha)),
+				B: uint8(math.Round(blue / alpha)),
+				A: uint8(math.Round(alpha / area)),
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+package pixelRatio
+
+import (
+	"math"
+)
+
+// Ratio
+// en: Number of physical pixels (device pixels) for each logical pixel (CSS pixel).
+// Drawing methods of IDraw receive logical pixels, the backing store of the canvas
+// has physical pixels
+//
+//	Example: A display with window.devicePixelRatio = 2 uses Ratio(2)
+//
+// pt_br: Número de pixels físicos (pixels do dispositivo) para cada pixel lógico
+// (pixel CSS). Os métodos de desenho de IDraw recebem pixels lógicos, a memória de
+// imagem do canvas tem pixels físicos
+//
+//	Exemplo: Uma tela com window.devicePixelRatio = 2 usa Ratio(2)
+type Ratio float64
+
+// New
+// en: Returns a valid ratio. Values not greater than zero, NaN and infinite values
+// return 1
+//
+// pt_br: Retorna uma razão válida. Valores não maiores do que zero, NaN e valores
+// infinitos retornam 1
+func New(value float64) Ratio {
+	if value <= 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 1
+	}
+	return Ratio(value)
+}
+
+// ToPhysical
+// en: Converts a logical length to physical pixels
+//
+// pt_br: Converte um comprimento lógico para pixels físicos
+func (el Ratio) ToPhysical(value float64) float64 {
+	return value * float64(el.valid())
+}
+
+// ToLogical
+// en: Converts a physical length to logical pixels
+//
+// pt_br: Converte um comprimento físico para pixels lógicos
+func (el Ratio) ToLogical(value float64) float64 {
+	return value / float64(el.valid())
+}
+
+// PointToPhysical
+// en: Converts a logical point to physical pixels
+//
+// pt_br: Converte um ponto lógico para pixels físicos
+func (el Ratio) PointToPhysical(x, y float64) (physicalX, physicalY float64) {
+	return el.ToPhysical(x), el.ToPhysical(y)
+}
+
+// PointToLogical
+// en: Converts a physical point to logical pixels. Use it to convert the position
+// of mouse events read from the backing store
+//
+// pt_br: Converte um ponto físico para pixels lógicos. Use para converter a posição
+// de eventos do mouse lida da memória de imagem
+func (el Ratio) PointToLogical(x, y float64) (logicalX, logicalY float64) {
+	return el.ToLogical(x), el.ToLogical(y)
+}
+
+// RectToPhysical
+// en: Converts a logical rectangle to the smallest rectangle of whole physical
+// pixels that covers it
+//
+// pt_br: Converte um retângulo lógico para o menor retângulo de pixels físicos
+// inteiros que o cobre
+func (el Ratio) RectToPhysical(x, y, width, height float64) (physicalX, physicalY, physicalWidth, physicalHeight int) {
+	left := math.Floor(el.ToPhysical(x))
+	top := math.Floor(el.ToPhysical(y))
+	right := math.Ceil(el.ToPhysical(x + width))
+	bottom := math.Ceil(el.ToPhysical(y + height))
+	return int(left), int(top), int(right - left), int(bottom - top)
+}
+
+// RectToLogical
+// en: Converts a physical rectangle to logical pixels
+//
+// pt_br: Converte um retângulo físico para pixels lógicos
+func (el Ratio) RectToLogical(x, y, width, height int) (logicalX, logicalY, logicalWidth, logicalHeight float64) {
+	return el.ToLogical(float64(x)), el.ToLogical(float64(y)), el.ToLogical(float64(width)), el.ToLogical(float64(height))
+}
+
+// BackingStoreSize
+// en: Returns the size of the canvas backing store, in physical pixels, for a canvas
+// with the given logical size. The logical size is the CSS width and height of the
+// element
+//
+// pt_br: Retorna o tamanho da memória de imagem do canvas, em pixels físicos, para
+// um canvas com o tamanho lógico informado. O tamanho lógico é o comprimento e a
+// altura CSS do elemento
+func (el Ratio) BackingStoreSize(logicalWidth, logicalHeight int) (physicalWidth, physicalHeight int) {
+	return int(math.Round(el.ToPhysical(float64(logicalWidth)))), int(math.Round(el.ToPhysical(float64(logicalHeight))))
+}
+
+// Transform
+// en: Returns the a, b, c, d, e, f values of the transformation that makes the
+// drawing methods work with logical pixels on a backing store of physical pixels.
+// Platforms apply it as the base scale under the matrix of IDraw.SetTransform(),
+// which stays relative to it
+//
+//	JavaScript syntax: context.setTransform(a, b, c, d, e, f);
+//
+// pt_br: Retorna os valores a, b, c, d, e, f da transformação que faz os métodos de
+// desenho trabalharem com pixels lógicos em uma memória de imagem de pixels físicos.
+// As plataformas a aplicam como escala base sob a matriz de IDraw.SetTransform(),
+// que continua relativa a ela
+//
+//	Sintaxe JavaScript: context.setTransform(a, b, c, d, e, f);
+func (el Ratio) Transform() [6]float64 {
+	ratio := float64(el.valid())
+	return [6]float64{ratio, 0, 0, ratio, 0, 0}
+}
+
+func (el Ratio) valid() Ratio {
+	return New(float64(el))
+}
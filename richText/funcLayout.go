@@ -0,0 +1,299 @@
+package richText
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/textLayout"
+)
+
+// piece
+// en: Word of a span with its trailing spaces, or an image, the unit of wrapping
+//
+// pt_br: Palavra de um span com os seus espaços finais, ou uma imagem, a unidade
+// da quebra de linhas
+type piece struct {
+	span    int
+	text    string
+	width   float64
+	trimmed float64
+	newline bool
+	x       float64
+}
+
+type verticalMetrics struct {
+	ascent  float64
+	descent float64
+}
+
+// Layout
+// en: Measures the spans with their fonts and places them in lines, breaking at
+// spaces when Options.MaxWidth is set. The platform state is restored at the end
+//
+// pt_br: Mede os spans com as suas fontes e os coloca em linhas, quebrando nos
+// espaços quando Options.MaxWidth está definido. O estado da plataforma é
+// restaurado no final
+func Layout(platform Platform, spans []Span, options Options) *Block {
+	platform.Save()
+	defer platform.Restore()
+
+	metrics := make([]verticalMetrics, len(spans))
+	pieces := make([]piece, 0)
+	for index, span := range spans {
+		if span.Image != nil {
+			metrics[index] = verticalMetrics{ascent: span.ImageHeight + span.BaselineShift, descent: -span.BaselineShift}
+			pieces = append(pieces, piece{span: index, width: span.ImageWidth, trimmed: span.ImageWidth})
+			continue
+		}
+
+		var spanPieces []piece
+		metrics[index], spanPieces = measureSpan(platform, span, index, options)
+		pieces = append(pieces, spanPieces...)
+	}
+
+	lines := wrap(pieces, spans, options.MaxWidth)
+	return place(lines, spans, metrics, options)
+}
+
+// measureSpan
+// en: Splits a text span in pieces and measures them with the font of the span.
+// The font is set between Save() and Restore(), as Block.Draw() does, so a span
+// without font is measured with the font of the platform and not with the font of
+// the previous span
+//
+// pt_br: Divide um span de texto em pedaços e os mede com a fonte do span. A fonte
+// é definida entre Save() e Restore(), como Block.Draw() faz, assim um span sem
+// fonte é medido com a fonte da plataforma e não com a fonte do span anterior
+func measureSpan(platform Platform, span Span, index int, options Options) (metrics verticalMetrics, pieces []piece) {
+	platform.Save()
+	defer platform.Restore()
+
+	if span.Font != nil {
+		platform.Font(*span.Font)
+	} else if options.Font != nil {
+		platform.Font(*options.Font)
+	}
+	ascent, descent := fontMetrics(platform)
+	metrics = verticalMetrics{ascent: ascent + span.BaselineShift, descent: descent - span.BaselineShift}
+
+	paragraphs := strings.Split(span.Text, "\n")
+	for number, paragraph := range paragraphs {
+		words := splitWords(paragraph)
+		if len(words) == 0 {
+			words = []string{""}
+		}
+		for _, word := range words {
+			trimmed := strings.TrimRightFunc(word, unicode.IsSpace)
+			pieces = append(pieces, piece{
+				span:    index,
+				text:    word,
+				width:   measure(platform, word),
+				trimmed: measure(platform, trimmed),
+			})
+		}
+		if number < len(paragraphs)-1 {
+			pieces[len(pieces)-1].newline = true
+		}
+	}
+	return
+}
+
+// wrap
+// en: Distributes the pieces in lines with the greedy algorithm. A line only breaks
+// after a piece that ends in a space or next to an image, so a span that starts in
+// the middle of a word, like "23" followed by "°C", moves to the next line with the
+// start of the word
+//
+// pt_br: Distribui os pedaços em linhas com o algoritmo guloso. Uma linha só quebra
+// após um pedaço que termina em espaço ou ao lado de uma imagem, assim um span que
+// começa no meio de uma palavra, como "23" seguido de "°C", vai para a próxima
+// linha com o início da palavra
+func wrap(pieces []piece, spans []Span, maxWidth float64) [][]piece {
+	breakable := func(before, after piece) bool {
+		if spans[before.span].Image != nil || spans[after.span].Image != nil {
+			return true
+		}
+		return strings.TrimRightFunc(before.text, unicode.IsSpace) != before.text
+	}
+
+	lines := make([][]piece, 0)
+	current := make([]piece, 0)
+	x := 0.0
+	for _, word := range pieces {
+		isImage := spans[word.span].Image != nil
+		blank := !isImage && word.text != "" && strings.TrimSpace(word.text) == ""
+
+		if maxWidth > 0 && len(current) != 0 && x+word.trimmed > maxWidth && (isImage || word.trimmed > 0) {
+			split, next := len(current), word
+			for split > 0 && !breakable(current[split-1], next) {
+				split, next = split-1, current[split-1]
+			}
+			if split > 0 {
+				rest := current[split:]
+				lines = append(lines, current[:split])
+				current, x = make([]piece, 0, len(rest)), 0
+				for _, moved := range rest {
+					moved.x = x
+					current = append(current, moved)
+					x += moved.width
+				}
+			}
+		}
+		if blank && len(current) == 0 && len(lines) != 0 {
+			continue
+		}
+
+		word.x = x
+		current = append(current, word)
+		x += word.width
+		if word.newline {
+			lines = append(lines, current)
+			current, x = make([]piece, 0), 0
+		}
+	}
+	if len(current) != 0 || len(lines) == 0 {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// place
+// en: Joins the pieces of the same span in runs and computes the vertical
+// position of each line
+//
+// pt_br: Junta os pedaços do mesmo span em runs e calcula a posição vertical de
+// cada linha
+func place(lines [][]piece, spans []Span, metrics []verticalMetrics, options Options) *Block {
+	block := &Block{Spans: spans, Lines: len(lines), options: options}
+
+	widths := make([]float64, len(lines))
+	for index, line := range lines {
+		if len(line) != 0 {
+			last := line[len(line)-1]
+			widths[index] = last.x + last.trimmed
+		}
+		block.Width = math.Max(block.Width, widths[index])
+	}
+	box := block.Width
+	if options.MaxWidth > 0 {
+		box = options.MaxWidth
+	}
+
+	top := 0.0
+	for index, line := range lines {
+		ascent, descent := 0.0, 0.0
+		for _, word := range line {
+			ascent = math.Max(ascent, metrics[word.span].ascent)
+			descent = math.Max(descent, metrics[word.span].descent)
+		}
+		height := (ascent + descent) * textLayout.KDefaultLineHeightFactor
+		if options.LineHeight > 0 {
+			height = math.Max(options.LineHeight, ascent+descent)
+		}
+		baseline := top + (height-ascent-descent)/2 + ascent
+
+		offset := 0.0
+		switch options.Align {
+		case textLayout.KAlignCenter:
+			offset = (box - widths[index]) / 2
+		case textLayout.KAlignRight:
+			offset = box - widths[index]
+		}
+
+		for start := 0; start < len(line); {
+			end := start + 1
+			for end < len(line) && line[end].span == line[start].span && spans[line[start].span].Image == nil {
+				end += 1
+			}
+			span := spans[line[start].span]
+			run := Run{Span: line[start].span, Line: index, X: offset + line[start].x, Baseline: baseline}
+
+			last := line[end-1]
+			if end == len(line) {
+				run.Width = last.x + last.trimmed - line[start].x
+			} else {
+				run.Width = last.x + last.width - line[start].x
+			}
+			if span.Image != nil {
+				run.Top = baseline - span.BaselineShift - span.ImageHeight
+				run.Height = span.ImageHeight
+			} else {
+				for _, word := range line[start:end] {
+					run.Text += word.text
+				}
+				if end == len(line) {
+					run.Text = strings.TrimRightFunc(run.Text, unicode.IsSpace)
+				}
+				spanMetrics := metrics[run.Span]
+				run.Top = baseline - spanMetrics.ascent
+				run.Height = spanMetrics.ascent + spanMetrics.descent
+			}
+			if run.Text != "" || span.Image != nil {
+				block.Runs = append(block.Runs, run)
+			}
+			start = end
+		}
+		top += height
+	}
+
+	if options.MaxWidth > 0 && options.Align != textLayout.KAlignLeft {
+		block.Width = box
+	}
+	block.Height = top
+	return block
+}
+
+// splitWords
+// en: Splits the text in words, each one with its trailing spaces; leading spaces
+// form a word of their own
+//
+// pt_br: Divide o texto em palavras, cada uma com os seus espaços finais; espaços
+// iniciais formam uma palavra própria
+func splitWords(text string) []string {
+	words := make([]string, 0)
+	start := 0
+	inSpace := false
+	for position, character := range text {
+		space := unicode.IsSpace(character)
+		if !space && inSpace && position > start {
+			words = append(words, text[start:position])
+			start = position
+		}
+		inSpace = space
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
+
+// measure
+// en: Returns the width of the text with the current font
+//
+// pt_br: Retorna a largura do texto com a fonte atual
+func measure(platform Platform, text string) float64 {
+	if text == "" {
+		return 0
+	}
+	return platform.MeasureText(text).Width
+}
+
+// fontMetrics
+// en: Returns ascent and descent of the current font, with the same fallbacks of
+// textLayout
+//
+// pt_br: Retorna ascendente e descendente da fonte atual, com as mesmas
+// alternativas de textLayout
+func fontMetrics(platform Platform) (ascent, descent float64) {
+	reference := platform.MeasureText("Mg")
+	ascent, descent = reference.FontBoundingBoxAscent, reference.FontBoundingBoxDescent
+	if ascent+descent <= 0 {
+		ascent, descent = reference.ActualBoundingBoxAscent, reference.ActualBoundingBoxDescent
+	}
+	if ascent+descent <= 0 {
+		em := reference.Width / 1.4
+		ascent, descent = 0.8*em, 0.2*em
+	}
+	return
+}
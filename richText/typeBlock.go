@@ -0,0 +1,153 @@
+package richText
+
+import (
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// Run
+// en: Part of a span placed on a line; a span broken by the wrapping has one run
+// per line
+//
+//	Span: Index of the span in the list given to Layout()
+//	Text: Text of the run; empty for images
+//	Line: Index of the line
+//	X: Start of the run, relative to the left of the block
+//	Baseline: Baseline of the line, relative to the top of the block, without
+//	          the BaselineShift of the span
+//	Width: Width of the run
+//	Top, Height: Vertical box of the run, with the BaselineShift applied
+//
+// pt_br: Parte de um span colocada em uma linha; um span quebrado pela diagramação
+// tem um run por linha
+//
+//	Span: Índice do span na lista passada para Layout()
+//	Text: Texto do run; vazio para imagens
+//	Line: Índice da linha
+//	X: Início do run, relativo à esquerda do bloco
+//	Baseline: Linha de base da linha, relativa ao topo do bloco, sem o
+//	          BaselineShift do span
+//	Width: Largura do run
+//	Top, Height: Caixa vertical do run, com o BaselineShift aplicado
+type Run struct {
+	Span     int
+	Text     string
+	Line     int
+	X        float64
+	Baseline float64
+	Width    float64
+	Top      float64
+	Height   float64
+}
+
+// Block
+// en: Result of Layout(): runs ready to draw and to test clicks
+//
+// pt_br: Resultado de Layout(): runs prontos para desenhar e testar cliques
+type Block struct {
+	Spans  []Span
+	Runs   []Run
+	Lines  int
+	Width  float64
+	Height float64
+
+	options Options
+}
+
+// Draw
+// en: Draws the block with its top left corner at x, y. The state of the platform
+// is saved and restored for each run, so the styles of the spans do not leak
+//
+// pt_br: Desenha o bloco com o seu canto superior esquerdo em x, y. O estado da
+// plataforma é salvo e restaurado para cada run, assim os estilos dos spans não
+// vazam
+func (el *Block) Draw(platform Platform, x, y float64) {
+	for _, run := range el.Runs {
+		span := el.Spans[run.Span]
+		if span.Image != nil {
+			platform.DrawImage(
+				span.Image,
+				int(math.Round(x+run.X)), int(math.Round(y+run.Top)),
+				int(math.Round(span.ImageWidth)), int(math.Round(span.ImageHeight)),
+			)
+			continue
+		}
+
+		platform.Save()
+		if span.Font != nil {
+			platform.Font(*span.Font)
+		} else if el.options.Font != nil {
+			platform.Font(*el.options.Font)
+		}
+
+		textX := int(math.Round(x + run.X))
+		textY := int(math.Round(y + run.Baseline - span.BaselineShift))
+		if !span.NoFill {
+			if span.FillStyle != nil {
+				platform.SetFillStyle(span.FillStyle)
+			} else if el.options.FillStyle != nil {
+				platform.SetFillStyle(el.options.FillStyle)
+			}
+			platform.FillText(run.Text, textX, textY)
+		}
+		if span.StrokeStyle != nil {
+			platform.SetStrokeStyle(span.StrokeStyle)
+			platform.SetLineWidth(span.LineWidth)
+			platform.StrokeText(run.Text, textX, textY)
+		}
+		platform.Restore()
+	}
+}
+
+// SpanBounds
+// en: Returns the boxes of the span, one per line it occupies, relative to the top
+// left corner of the block
+//
+// pt_br: Retorna as caixas do span, uma por linha que ocupa, relativas ao canto
+// superior esquerdo do bloco
+func (el *Block) SpanBounds(span int) []collision.AABB {
+	boxes := make([]collision.AABB, 0)
+	line := -1
+	for _, run := range el.Runs {
+		if run.Span != span {
+			continue
+		}
+		box := collision.NewAABB(run.X, run.Top, run.Width, run.Height)
+		if run.Line == line {
+			boxes[len(boxes)-1] = boxes[len(boxes)-1].Union(box)
+			continue
+		}
+		boxes = append(boxes, box)
+		line = run.Line
+	}
+	return boxes
+}
+
+// HitTest
+// en: Returns the index of the span under the point, relative to the top left
+// corner of the block; found is false between spans
+//
+// pt_br: Retorna o índice do span sob o ponto, relativo ao canto superior esquerdo
+// do bloco; found é false entre spans
+func (el *Block) HitTest(x, y float64) (span int, found bool) {
+	for i := len(el.Runs) - 1; i >= 0; i -= 1 {
+		run := el.Runs[i]
+		if collision.NewAABB(run.X, run.Top, run.Width, run.Height).ContainsPoint(x, y) {
+			return run.Span, true
+		}
+	}
+	return -1, false
+}
+
+// HitTestId
+// en: Same as HitTest(), but returns the Id of the span
+//
+// pt_br: O mesmo que HitTest(), mas retorna o Id do span
+func (el *Block) HitTestId(x, y float64) (id interface{}, found bool) {
+	span, found := el.HitTest(x, y)
+	if !found {
+		return nil, false
+	}
+	return el.Spans[span].Id, true
+}
@@ -0,0 +1,52 @@
+package richText
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/textLayout"
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// Platform
+// en: Methods of IDraw used to measure and draw rich text
+//
+// pt_br: Métodos de IDraw usados para medir e desenhar texto rico
+type Platform interface {
+	Font(font font.Font)
+	MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics
+	FillText(text string, x, y int, maxWidth ...int)
+	StrokeText(text string, x, y int, maxWidth ...int)
+	SetFillStyle(value interface{})
+	SetStrokeStyle(value interface{})
+	SetLineWidth(value interface{})
+	DrawImage(image interface{}, value ...interface{})
+	Save()
+	Restore()
+}
+
+// Options
+// en: Configuration of Layout()
+//
+//	MaxWidth: Width of the block, in pixels; 0 disables the wrapping
+//	LineHeight: Minimum distance between two lines, in pixels; 0 uses
+//	            textLayout.KDefaultLineHeightFactor over the tallest span
+//	Align: textLayout.KAlignLeft, KAlignCenter or KAlignRight
+//	Font: Optional; font of the spans without font. When nil, the current font
+//	      of the platform is used
+//	FillStyle: Optional; fill of the spans without fill
+//
+// pt_br: Configuração de Layout()
+//
+//	MaxWidth: Largura do bloco, em pixels; 0 desabilita a quebra de linhas
+//	LineHeight: Distância mínima entre duas linhas, em pixels; 0 usa
+//	            textLayout.KDefaultLineHeightFactor sobre o span mais alto
+//	Align: textLayout.KAlignLeft, KAlignCenter ou KAlignRight
+//	Font: Opcional; fonte dos spans sem fonte. Quando nil, a fonte atual da
+//	      plataforma é usada
+//	FillStyle: Opcional; preenchimento dos spans sem preenchimento
+type Options struct {
+	MaxWidth   float64
+	LineHeight float64
+	Align      textLayout.Align
+	Font       *font.Font
+	FillStyle  interface{}
+}
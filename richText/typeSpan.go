@@ -0,0 +1,79 @@
+package richText
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// Span
+// en: Piece of rich text with its own style, or an inline image
+//
+//	Text: Text of the span; new lines ("\n") break the line
+//	Font: Optional; nil uses Options.Font
+//	FillStyle: Optional; any value accepted by SetFillStyle(); nil uses
+//	           Options.FillStyle
+//	NoFill: Draws only the stroke
+//	StrokeStyle: Optional; any value accepted by SetStrokeStyle(); when set the
+//	             text is also stroked with LineWidth
+//	LineWidth: Width of the stroke
+//	BaselineShift: Vertical displacement in pixels, positive up, as in
+//	               superscripts
+//	Image: Optional; any value accepted by DrawImage(). When set, Text is
+//	       ignored and the image is drawn with ImageWidth and ImageHeight, its
+//	       bottom on the baseline
+//	Id: Free value returned by the hit test, to identify the span in click
+//	    handlers
+//
+// pt_br: Pedaço de texto rico com o seu próprio estilo, ou uma imagem em linha
+//
+//	Text: Texto do span; novas linhas ("\n") quebram a linha
+//	Font: Opcional; nil usa Options.Font
+//	FillStyle: Opcional; qualquer valor aceito por SetFillStyle(); nil usa
+//	           Options.FillStyle
+//	NoFill: Desenha apenas o contorno
+//	StrokeStyle: Opcional; qualquer valor aceito por SetStrokeStyle(); quando
+//	             definido o texto também é contornado com LineWidth
+//	LineWidth: Largura do contorno
+//	BaselineShift: Deslocamento vertical em pixels, positivo para cima, como em
+//	               sobrescritos
+//	Image: Opcional; qualquer valor aceito por DrawImage(). Quando definido,
+//	       Text é ignorado e a imagem é desenhada com ImageWidth e ImageHeight,
+//	       com a sua base na linha de base
+//	Id: Valor livre retornado pelo teste de posição, para identificar o span em
+//	    tratadores de clique
+type Span struct {
+	Text          string
+	Font          *font.Font
+	FillStyle     interface{}
+	NoFill        bool
+	StrokeStyle   interface{}
+	LineWidth     int
+	BaselineShift float64
+	Image         interface{}
+	ImageWidth    float64
+	ImageHeight   float64
+	Id            interface{}
+}
+
+// NewText
+// en: Returns a text span with the default style
+//
+// pt_br: Retorna um span de texto com o estilo padrão
+func NewText(text string) Span {
+	return Span{Text: text}
+}
+
+// NewStyledText
+// en: Returns a text span with font and fill style
+//
+// pt_br: Retorna um span de texto com fonte e estilo de preenchimento
+func NewStyledText(text string, textFont font.Font, fillStyle interface{}) Span {
+	return Span{Text: text, Font: &textFont, FillStyle: fillStyle}
+}
+
+// NewImage
+// en: Returns an inline image span
+//
+// pt_br: Retorna um span de imagem em linha
+func NewImage(image interface{}, width, height float64) Span {
+	return Span{Image: image, ImageWidth: width, ImageHeight: height}
+}
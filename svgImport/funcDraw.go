@@ -0,0 +1,327 @@
+package svgImport
+
+import (
+	"image/color"
+	"math"
+
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// KTolerance
+// en: Maximum distance, in pixels of the canvas, between a curve and the lines
+// that replace it
+//
+// pt_br: Distância máxima, em pixels do canvas, entre uma curva e as linhas que a
+// substituem
+const KTolerance = 0.25
+
+// Platform
+// en: Methods of IDraw used to replay the display list
+//
+// pt_br: Métodos de IDraw usados para reproduzir a lista de exibição
+type Platform interface {
+	BeginPath()
+	MoveTo(x, y interface{})
+	LineTo(x, y interface{})
+	ClosePath(x, y interface{})
+	Fill()
+	Stroke()
+	SetLineWidth(value interface{})
+	SetFillStyle(value interface{})
+	SetStrokeStyle(value interface{})
+	CreateLinearGradient(x0, y0, x1, y1 interface{}) interface{}
+	CreateRadialGradient(x0, y0, r0, x1, y1, r1 interface{}) interface{}
+	AddColorStopPosition(gradient interface{}, stop float64, color color.RGBA)
+	SetGlobalAlpha(value float64)
+	GetGlobalAlpha() float64
+	Font(font font.Font)
+	MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics
+	FillText(text string, x, y int, maxWidth ...int)
+	StrokeText(text string, x, y int, maxWidth ...int)
+	GetTransform() [6]float64
+	SetTransform(a, b, c, d, e, f float64)
+	Save()
+	Restore()
+}
+
+// Draw
+// en: Replays the display list on the platform, inside Save() and Restore(), over
+// the current transformation
+//
+//	x, y: Top left corner of the document
+//	width, height: Size of the document on the canvas; 0 keeps Document.Width or
+//	               Document.Height
+//
+// Curves are drawn as lines, with KTolerance. Radial gradients of strokes and
+// texts are drawn as circles when the bounding box, or gradientTransform,
+// distorts them
+//
+// pt_br: Reproduz a lista de exibição na plataforma, dentro de Save() e Restore(),
+// sobre a transformação atual
+//
+//	x, y: Canto superior esquerdo do documento
+//	width, height: Tamanho do documento no canvas; 0 mantém Document.Width ou
+//	               Document.Height
+//
+// Curvas são desenhadas como linhas, com KTolerance. Gradientes radiais de
+// contornos e textos são desenhados como círculos quando a caixa delimitadora,
+// ou o gradientTransform, os distorce
+func (el *Document) Draw(platform Platform, x, y, width, height float64) {
+	scaleX, scaleY := 1.0, 1.0
+	if width > 0 && el.Width > 0 {
+		scaleX = width / el.Width
+	}
+	if height > 0 && el.Height > 0 {
+		scaleY = height / el.Height
+	}
+
+	platform.Save()
+	defer platform.Restore()
+	base := Matrix(platform.GetTransform()).Multiply(Matrix{scaleX, 0, 0, scaleY, x, y})
+	alpha := platform.GetGlobalAlpha()
+
+	for i := 0; i < len(el.Items); i += 1 {
+		item := &el.Items[i]
+		platform.SetGlobalAlpha(alpha * item.Opacity)
+		switch item.Kind {
+		case KItemPath:
+			drawPath(platform, item, base.Multiply(item.Transform))
+		case KItemText:
+			// en: a text chunk is an item with position and the items that continue it
+			// pt_br: um bloco de texto é um item com posição e os itens que o continuam
+			end := i + 1
+			for end < len(el.Items) && el.Items[end].Kind == KItemText && el.Items[end].Continue {
+				end += 1
+			}
+			drawText(platform, el.Items[i:end], base, alpha)
+			i = end - 1
+		}
+	}
+}
+
+// drawPath
+// en: Draws the fill and the stroke of a path item
+//
+// pt_br: Desenha o preenchimento e o contorno de um item de caminho
+func drawPath(platform Platform, item *Item, matrix Matrix) {
+	points, operations := flatten(item.Segments, KTolerance/math.Max(matrix.Scale(), 1e-9))
+	box := bounds(points)
+
+	platform.SetTransform(matrix[0], matrix[1], matrix[2], matrix[3], matrix[4], matrix[5])
+	platform.BeginPath()
+	for i, point := range points {
+		switch operations[i] {
+		case KMoveTo:
+			platform.MoveTo(point.X, point.Y)
+		case KLineTo:
+			platform.LineTo(point.X, point.Y)
+		case KClose:
+			platform.ClosePath(point.X, point.Y)
+		}
+	}
+
+	if style, extra, found := paintStyle(platform, item.Fill, box, true); found {
+		platform.SetFillStyle(style)
+		if extra != KIdentity {
+			full := matrix.Multiply(extra)
+			platform.SetTransform(full[0], full[1], full[2], full[3], full[4], full[5])
+		}
+		platform.Fill()
+		platform.SetTransform(matrix[0], matrix[1], matrix[2], matrix[3], matrix[4], matrix[5])
+	}
+	if style, _, found := paintStyle(platform, item.Stroke, box, false); found {
+		platform.SetLineWidth(item.StrokeWidth)
+		platform.SetStrokeStyle(style)
+		platform.Stroke()
+	}
+}
+
+// drawText
+// en: Draws a text chunk: the items are measured, placed one after the other and
+// aligned by the text-anchor of the first item
+//
+// pt_br: Desenha um bloco de texto: os itens são medidos, colocados um depois do
+// outro e alinhados pelo text-anchor do primeiro item
+func drawText(platform Platform, items []Item, base Matrix, alpha float64) {
+	metrics := make([]iotmakerPlatformTextMetrics.TextMetrics, len(items))
+	total := 0.0
+	for i, item := range items {
+		platform.Font(item.Font.ToFont())
+		metrics[i] = platform.MeasureText(item.Text)
+		total += metrics[i].Width
+		if i != 0 {
+			total += item.Position.X
+		}
+	}
+
+	x := items[0].Position.X
+	switch items[0].Anchor {
+	case KAnchorMiddle:
+		x -= total / 2
+	case KAnchorEnd:
+		x -= total
+	}
+
+	for i := range items {
+		item := &items[i]
+		if i != 0 {
+			x += item.Position.X
+		}
+		matrix := base.Multiply(item.Transform).Multiply(Matrix{1, 0, 0, 1, x, item.Position.Y})
+		platform.SetTransform(matrix[0], matrix[1], matrix[2], matrix[3], matrix[4], matrix[5])
+		platform.SetGlobalAlpha(alpha * item.Opacity)
+		platform.Font(item.Font.ToFont())
+
+		ascent, descent := metrics[i].FontBoundingBoxAscent, metrics[i].FontBoundingBoxDescent
+		if ascent == 0 && descent == 0 {
+			ascent, descent = item.Font.Size*0.8, item.Font.Size*0.2
+		}
+		box := [4]float64{0, -ascent, metrics[i].Width, ascent + descent}
+
+		if style, _, found := paintStyle(platform, item.Fill, box, false); found {
+			platform.SetFillStyle(style)
+			platform.FillText(item.Text, 0, 0)
+		}
+		if style, _, found := paintStyle(platform, item.Stroke, box, false); found {
+			platform.SetLineWidth(item.StrokeWidth)
+			platform.SetStrokeStyle(style)
+			platform.StrokeText(item.Text, 0, 0)
+		}
+		x += metrics[i].Width
+	}
+}
+
+// paintStyle
+// en: Returns the value for SetFillStyle() or SetStrokeStyle(). Gradients are
+// converted to the user space of the item. A radial gradient distorted by the
+// bounding box can not be; when transform is true, the gradient is returned in
+// its own space with the transformation to apply before Fill(), otherwise it is
+// approximated by circles
+//
+// pt_br: Retorna o valor para SetFillStyle() ou SetStrokeStyle(). Gradientes são
+// convertidos para o espaço do usuário do item. Um gradiente radial distorcido
+// pela caixa delimitadora não pode ser; quando transform é true, o gradiente é
+// retornado no seu próprio espaço com a transformação a aplicar antes de Fill(),
+// caso contrário é aproximado por círculos
+func paintStyle(platform Platform, paint Paint, box [4]float64, transform bool) (style interface{}, extra Matrix, found bool) {
+	extra = KIdentity
+	switch paint.Kind {
+	case KPaintColor:
+		return paint.Color, extra, true
+	case KPaintGradient:
+	default:
+		return nil, extra, false
+	}
+
+	gradient := paint.Gradient
+	space := gradient.Transform
+	if gradient.BoundingBox {
+		// en: a bounding box without area can not be used as coordinate system
+		// pt_br: uma caixa delimitadora sem área não pode ser usada como sistema de
+		// coordenadas
+		if box[2] <= 0 || box[3] <= 0 {
+			return nil, extra, false
+		}
+		space = Matrix{box[2], 0, 0, box[3], box[0], box[1]}.Multiply(gradient.Transform)
+	}
+	determinant := space[0]*space[3] - space[1]*space[2]
+	if determinant == 0 {
+		return nil, extra, false
+	}
+
+	var canvasGradient interface{}
+	if !gradient.Radial {
+		vectorX, vectorY := gradient.X2-gradient.X1, gradient.Y2-gradient.Y1
+		squared := vectorX*vectorX + vectorY*vectorY
+		if squared == 0 {
+			// en: a gradient without length paints the last stop
+			// pt_br: um gradiente sem comprimento pinta a última parada
+			return gradient.Stops[len(gradient.Stops)-1].Color, extra, true
+		}
+		// en: the direction of the gradient in user space is the inverse transpose
+		// of the transformation applied to the vector
+		// pt_br: a direção do gradiente no espaço do usuário é a transposta inversa
+		// da transformação aplicada ao vetor
+		directionX := (space[3]*vectorX - space[1]*vectorY) / determinant / squared
+		directionY := (-space[2]*vectorX + space[0]*vectorY) / determinant / squared
+		length := directionX*directionX + directionY*directionY
+		start := space.Apply(Point{X: gradient.X1, Y: gradient.Y1})
+		canvasGradient = platform.CreateLinearGradient(start.X, start.Y, start.X+directionX/length, start.Y+directionY/length)
+	} else {
+		scale := math.Sqrt(math.Abs(determinant))
+		similar := math.Abs(space[0]-space[3]) <= 1e-9*scale && math.Abs(space[1]+space[2]) <= 1e-9*scale
+		if similar || !transform {
+			focal := space.Apply(Point{X: gradient.FX, Y: gradient.FY})
+			center := space.Apply(Point{X: gradient.CX, Y: gradient.CY})
+			canvasGradient = platform.CreateRadialGradient(focal.X, focal.Y, 0, center.X, center.Y, gradient.R*scale)
+		} else {
+			canvasGradient = platform.CreateRadialGradient(gradient.FX, gradient.FY, 0, gradient.CX, gradient.CY, gradient.R)
+			extra = space
+		}
+	}
+	for _, stop := range gradient.Stops {
+		platform.AddColorStopPosition(canvasGradient, stop.Offset, stop.Color)
+	}
+	return canvasGradient, extra, true
+}
+
+// flatten
+// en: Converts the segments into points and operations, replacing each cubic
+// curve by lines with the tolerance given in user units
+//
+// pt_br: Converte os segmentos em pontos e operações, substituindo cada curva
+// cúbica por linhas com a tolerância dada em unidades do usuário
+func flatten(segments []Segment, tolerance float64) (points []Point, operations []Operation) {
+	points = make([]Point, 0, len(segments))
+	operations = make([]Operation, 0, len(segments))
+	var current Point
+	for _, segment := range segments {
+		if segment.Operation != KCubicTo {
+			points = append(points, segment.Points[0])
+			operations = append(operations, segment.Operation)
+			current = segment.Points[0]
+			continue
+		}
+
+		first, second, end := segment.Points[0], segment.Points[1], segment.Points[2]
+		// en: the number of lines grows with the square root of the largest second
+		// difference of the control points
+		// pt_br: o número de linhas cresce com a raiz quadrada da maior segunda
+		// diferença dos pontos de controle
+		difference := math.Max(
+			math.Hypot(current.X-2*first.X+second.X, current.Y-2*first.Y+second.Y),
+			math.Hypot(first.X-2*second.X+end.X, first.Y-2*second.Y+end.Y),
+		)
+		steps := int(math.Ceil(math.Sqrt(0.75 * difference / tolerance)))
+		steps = int(math.Max(1, math.Min(float64(steps), 256)))
+		for step := 1; step <= steps; step += 1 {
+			t := float64(step) / float64(steps)
+			u := 1 - t
+			points = append(points, Point{
+				X: u*u*u*current.X + 3*u*u*t*first.X + 3*u*t*t*second.X + t*t*t*end.X,
+				Y: u*u*u*current.Y + 3*u*u*t*first.Y + 3*u*t*t*second.Y + t*t*t*end.Y,
+			})
+			operations = append(operations, KLineTo)
+		}
+		current = end
+	}
+	return
+}
+
+// bounds
+// en: Returns x, y, width and height of the box around the points
+//
+// pt_br: Retorna x, y, largura e altura da caixa em volta dos pontos
+func bounds(points []Point) [4]float64 {
+	if len(points) == 0 {
+		return [4]float64{}
+	}
+	left, top := points[0].X, points[0].Y
+	right, bottom := left, top
+	for _, point := range points[1:] {
+		left, right = math.Min(left, point.X), math.Max(right, point.X)
+		top, bottom = math.Min(top, point.Y), math.Max(bottom, point.Y)
+	}
+	return [4]float64{left, top, right - left, bottom - top}
+}
@@ -0,0 +1,171 @@
+package svgImport
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+)
+
+// KMaxHrefDepth
+// en: Maximum length of a chain of gradients that reference each other with href
+//
+// pt_br: Comprimento máximo de uma cadeia de gradientes que se referenciam com
+// href
+const KMaxHrefDepth = 16
+
+// gradient
+// en: Resolves a gradient by id. Attributes and stops missing in the gradient are
+// taken from the gradients referenced by href, as the specification defines
+//
+// pt_br: Resolve um gradiente pelo id. Atributos e paradas ausentes no gradiente
+// são obtidos dos gradientes referenciados por href, como a especificação define
+func (el *parser) gradient(user *node, id string, current state) (gradient *Gradient, found bool) {
+	if gradient, found = el.gradients[id]; found {
+		return
+	}
+	target, exists := el.ids[id]
+	if !exists {
+		el.report(user, fmt.Sprintf("paint server %q not found", "#"+id))
+		return nil, false
+	}
+	if target.name != "linearGradient" && target.name != "radialGradient" {
+		el.report(user, fmt.Sprintf("<%v> paint server is not supported", target.name))
+		return nil, false
+	}
+
+	chain := []*node{target}
+	for len(chain) < KMaxHrefDepth {
+		href := strings.TrimSpace(chain[len(chain)-1].attributes["href"])
+		next, exists := el.ids[strings.TrimPrefix(href, "#")]
+		if !strings.HasPrefix(href, "#") || !exists {
+			break
+		}
+		if next.name != "linearGradient" && next.name != "radialGradient" {
+			break
+		}
+		chain = append(chain, next)
+	}
+	attribute := func(name string, sameKind bool) (value string, found bool) {
+		for _, link := range chain {
+			if sameKind && link.name != target.name {
+				continue
+			}
+			if value, found = link.attributes[name]; found {
+				return
+			}
+		}
+		return
+	}
+
+	gradient = &Gradient{Id: id, Radial: target.name == "radialGradient", Transform: KIdentity}
+	units, _ := attribute("gradientUnits", false)
+	gradient.BoundingBox = units != "userSpaceOnUse"
+	if text, found := attribute("gradientTransform", false); found {
+		matrix, err := parseTransform(text)
+		if err != nil {
+			el.report(target, err.Error())
+		}
+		gradient.Transform = matrix
+	}
+	if text, found := attribute("spreadMethod", false); found && text != "pad" {
+		el.report(target, fmt.Sprintf("spreadMethod %v is not supported, pad is used", text))
+	}
+
+	coordinate := func(name string, axis axis, fallback string) float64 {
+		text, found := attribute(name, true)
+		if !found {
+			text = fallback
+		}
+		// en: in bounding box units, percentages are fractions of the box
+		// pt_br: em unidades da caixa delimitadora, porcentagens são frações da
+		// caixa
+		reference := 1.0
+		if !gradient.BoundingBox {
+			switch axis {
+			case kAxisX:
+				reference = current.viewport[0]
+			case kAxisY:
+				reference = current.viewport[1]
+			default:
+				reference = math.Hypot(current.viewport[0], current.viewport[1]) / math.Sqrt2
+			}
+		}
+		value, err := parseLength(text, reference, current.font.Size)
+		if err != nil {
+			el.report(target, err.Error())
+			value, _ = parseLength(fallback, reference, current.font.Size)
+		}
+		return value
+	}
+	if gradient.Radial {
+		gradient.CX = coordinate("cx", kAxisX, "50%")
+		gradient.CY = coordinate("cy", kAxisY, "50%")
+		gradient.R = coordinate("r", kAxisDiagonal, "50%")
+		gradient.FX = coordinate("fx", kAxisX, formatNumber(gradient.CX))
+		gradient.FY = coordinate("fy", kAxisY, formatNumber(gradient.CY))
+		if _, found := attribute("fr", true); found {
+			el.report(target, "fr is not supported")
+		}
+	} else {
+		gradient.X1 = coordinate("x1", kAxisX, "0%")
+		gradient.Y1 = coordinate("y1", kAxisY, "0%")
+		gradient.X2 = coordinate("x2", kAxisX, "100%")
+		gradient.Y2 = coordinate("y2", kAxisY, "0%")
+	}
+
+	for _, link := range chain {
+		for _, child := range link.children {
+			if child.name == "stop" {
+				gradient.Stops = append(gradient.Stops, el.stop(child, current, gradient.Stops))
+			}
+		}
+		if len(gradient.Stops) != 0 {
+			break
+		}
+	}
+
+	el.gradients[id] = gradient
+	return gradient, true
+}
+
+// stop
+// en: Parses a stop element. Offsets are limited to 0 to 1 and can not be smaller
+// than the offset of the previous stop
+//
+// pt_br: Interpreta um elemento stop. Deslocamentos são limitados a 0 a 1 e não
+// podem ser menores do que o deslocamento da parada anterior
+func (el *parser) stop(element *node, current state, previous []Stop) (stop Stop) {
+	if text, found := element.attributes["offset"]; found {
+		stop.Offset = el.fraction(element, text)
+	}
+	if len(previous) != 0 {
+		stop.Offset = math.Max(stop.Offset, previous[len(previous)-1].Offset)
+	}
+
+	stop.Color = color.RGBA{A: 255}
+	text := strings.TrimSpace(element.attributes["stop-color"])
+	switch {
+	case text == "" || text == "inherit":
+	case strings.EqualFold(text, "currentColor"):
+		stop.Color = current.color
+		if value, found := element.attributes["color"]; found {
+			if parsed, err := colorUtils.Parse(value); err == nil {
+				stop.Color = parsed
+			}
+		}
+	default:
+		parsed, err := colorUtils.Parse(text)
+		if err != nil {
+			el.report(element, err.Error())
+		} else {
+			stop.Color = parsed
+		}
+	}
+	if text, found := element.attributes["stop-opacity"]; found {
+		stop.Color.A = uint8(math.Round(float64(stop.Color.A) * el.fraction(element, text)))
+	}
+	return
+}
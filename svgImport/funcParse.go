@@ -0,0 +1,222 @@
+package svgImport
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// KMaxUseDepth
+// en: Maximum number of nested use elements; deeper references are reported as
+// issues, protecting against recursive files
+//
+// pt_br: Número máximo de elementos use aninhados; referências mais profundas são
+// reportadas como problemas, protegendo contra arquivos recursivos
+const KMaxUseDepth = 32
+
+// KMaxUses
+// en: Maximum number of use elements instantiated in the whole file. Each use may
+// reference groups that use other elements several times, so a small file can
+// expand exponentially; the use elements after the limit are ignored and reported
+// once as an issue
+//
+// pt_br: Número máximo de elementos use instanciados no arquivo inteiro. Cada use
+// pode referenciar grupos que usam outros elementos várias vezes, assim um arquivo
+// pequeno pode expandir exponencialmente; os elementos use após o limite são
+// ignorados e reportados uma vez como problema
+const KMaxUses = 10000
+
+// KMaxItems
+// en: Maximum number of items of Document.Items; the shapes and texts after the
+// limit are ignored and reported once as an issue
+//
+// pt_br: Número máximo de itens de Document.Items; as formas e textos após o
+// limite são ignorados e reportados uma vez como problema
+const KMaxItems = 100000
+
+// node
+// en: Element of the XML tree. Text content is kept as children named "#text".
+// The declarations of the style attribute are merged into the attributes, as they
+// have precedence over the presentation attributes
+//
+// pt_br: Elemento da árvore XML. O conteúdo de texto é mantido como filhos com o
+// nome "#text". As declarações do atributo style são mescladas nos atributos,
+// pois têm precedência sobre os atributos de apresentação
+type node struct {
+	name       string
+	attributes map[string]string
+	children   []*node
+	text       string
+}
+
+// ParseFile
+// en: Reads and parses an SVG file on disk
+//
+// pt_br: Lê e interpreta um arquivo SVG no disco
+func ParseFile(name string) (document *Document, err error) {
+	var data []byte
+	if data, err = os.ReadFile(name); err != nil {
+		return
+	}
+	if document, err = Parse(data); err != nil {
+		err = fmt.Errorf("%v: %w", name, err)
+	}
+	return
+}
+
+// Parse
+// en: Converts an SVG file into a display list. Only malformed XML and a root
+// element other than svg are errors; every feature that is ignored or
+// approximated is listed in Document.Issues and the rest of the file is imported
+//
+//	Supported: svg, g, a, defs, symbol and use; path with the full path data
+//	syntax; rect, circle, ellipse, line, polyline and polygon; text and tspan;
+//	the transform attribute; fill, stroke, stroke-width, opacity, fill-opacity,
+//	stroke-opacity, color and currentColor, in attributes or in the style
+//	attribute; linearGradient and radialGradient with href, gradientUnits and
+//	gradientTransform; viewBox and preserveAspectRatio; display and visibility;
+//	font-family, font-size, font-weight, font-style, font and text-anchor
+//
+// pt_br: Converte um arquivo SVG em uma lista de exibição. Apenas XML mal formado
+// e um elemento raiz diferente de svg são erros; todo recurso ignorado ou
+// aproximado é listado em Document.Issues e o resto do arquivo é importado
+//
+//	Suportado: svg, g, a, defs, symbol e use; path com a sintaxe completa de
+//	dados de caminho; rect, circle, ellipse, line, polyline e polygon; text e
+//	tspan; o atributo transform; fill, stroke, stroke-width, opacity,
+//	fill-opacity, stroke-opacity, color e currentColor, em atributos ou no
+//	atributo style; linearGradient e radialGradient com href, gradientUnits e
+//	gradientTransform; viewBox e preserveAspectRatio; display e visibility;
+//	font-family, font-size, font-weight, font-style, font e text-anchor
+func Parse(data []byte) (document *Document, err error) {
+	var root *node
+	if root, err = parseTree(data); err != nil {
+		return
+	}
+	if root.name != "svg" {
+		return nil, fmt.Errorf("svgImport: root element is <%v>, not <svg>", root.name)
+	}
+
+	parser := &parser{
+		document:  &Document{Items: make([]Item, 0), Issues: make([]Issue, 0)},
+		ids:       make(map[string]*node),
+		gradients: make(map[string]*Gradient),
+		reported:  make(map[Issue]bool),
+		limited:   make(map[string]bool),
+	}
+	parser.index(root)
+	parser.root(root)
+	return parser.document, nil
+}
+
+// parseTree
+// en: Builds the XML tree. Elements of other namespaces, like the metadata of
+// editors, are dropped
+//
+// pt_br: Monta a árvore XML. Elementos de outros namespaces, como os metadados de
+// editores, são descartados
+func parseTree(data []byte) (root *node, err error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+
+	stack := make([]*node, 0)
+	skip := 0
+	for {
+		var token xml.Token
+		token, err = decoder.Token()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("svgImport: offset %v: %w", decoder.InputOffset(), err)
+		}
+
+		switch value := token.(type) {
+		case xml.StartElement:
+			if skip != 0 || (value.Name.Space != "" && value.Name.Space != KNamespace) {
+				skip += 1
+				continue
+			}
+			element := &node{name: value.Name.Local, attributes: make(map[string]string)}
+			for _, attribute := range value.Attr {
+				switch {
+				case attribute.Name.Space == "" || attribute.Name.Space == KNamespace:
+					element.attributes[attribute.Name.Local] = attribute.Value
+				case attribute.Name.Local == "href" && element.attributes["href"] == "":
+					// en: xlink:href, of SVG 1.1
+					// pt_br: xlink:href, do SVG 1.1
+					element.attributes["href"] = attribute.Value
+				case attribute.Name.Local == "space":
+					element.attributes["xml:space"] = attribute.Value
+				}
+			}
+			for name, value := range parseStyle(element.attributes["style"]) {
+				element.attributes[name] = value
+			}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, fmt.Errorf("svgImport: more than one root element")
+				}
+				root = element
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			}
+			stack = append(stack, element)
+
+		case xml.EndElement:
+			if skip != 0 {
+				skip -= 1
+				continue
+			}
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			if skip != 0 || len(stack) == 0 {
+				continue
+			}
+			parent := stack[len(stack)-1]
+			switch parent.name {
+			case "text", "tspan", "style":
+				parent.children = append(parent.children, &node{name: "#text", text: string(value)})
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("svgImport: empty document")
+	}
+	return
+}
+
+// parseStyle
+// en: Parses the declarations of a style attribute, like "fill: red; stroke: none"
+//
+// pt_br: Interpreta as declarações de um atributo style, como "fill: red; stroke:
+// none"
+func parseStyle(style string) map[string]string {
+	declarations := make(map[string]string)
+	for _, declaration := range strings.Split(style, ";") {
+		colon := strings.IndexByte(declaration, ':')
+		if colon == -1 {
+			continue
+		}
+		name := strings.ToLower(strings.TrimSpace(declaration[:colon]))
+		value := strings.TrimSpace(declaration[colon+1:])
+		value = strings.TrimSpace(strings.TrimSuffix(value, "!important"))
+		if name != "" && value != "" {
+			declarations[name] = value
+		}
+	}
+	return declarations
+}
+
+// KNamespace
+// en: Namespace of the SVG elements
+//
+// pt_br: Namespace dos elementos SVG
+const KNamespace = "http://www.w3.org/2000/svg"
@@ -0,0 +1,392 @@
+package svgImport
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Operation
+// en: Drawing operation of a path segment
+//
+// pt_br: Operação de desenho de um segmento de caminho
+type Operation int
+
+const (
+	KMoveTo Operation = iota
+	KLineTo
+	KCubicTo
+	KClose
+)
+
+// Segment
+// en: Segment of a path in absolute coordinates. KLineTo and KMoveTo use Points[0];
+// KCubicTo uses the two control points and the end point; KClose has the start of
+// the subpath in Points[0]. Quadratic curves and arcs are converted to cubic
+// curves
+//
+// pt_br: Segmento de um caminho em coordenadas absolutas. KLineTo e KMoveTo usam
+// Points[0]; KCubicTo usa os dois pontos de controle e o ponto final; KClose tem
+// o início do subcaminho em Points[0]. Curvas quadráticas e arcos são convertidos
+// em curvas cúbicas
+type Segment struct {
+	Operation Operation
+	Points    [3]Point
+}
+
+// Point
+// en: Point in user units
+//
+// pt_br: Ponto em unidades do usuário
+type Point struct {
+	X float64
+	Y float64
+}
+
+// pathScanner
+// en: Reads the numbers and commands of the path data, where separators are
+// optional ("M10-5.5.5" is valid)
+//
+// pt_br: Lê os números e comandos dos dados de caminho, onde separadores são
+// opcionais ("M10-5.5.5" é válido)
+type pathScanner struct {
+	data string
+	pos  int
+}
+
+func (el *pathScanner) skip() {
+	for el.pos < len(el.data) {
+		switch el.data[el.pos] {
+		case ' ', '\t', '\r', '\n', '\f', ',':
+			el.pos += 1
+		default:
+			return
+		}
+	}
+}
+
+func (el *pathScanner) command() (command byte, found bool) {
+	el.skip()
+	if el.pos >= len(el.data) {
+		return 0, false
+	}
+	character := el.data[el.pos]
+	if (character >= 'a' && character <= 'z') || (character >= 'A' && character <= 'Z') {
+		if character == 'e' || character == 'E' {
+			return 0, false
+		}
+		el.pos += 1
+		return character, true
+	}
+	return 0, false
+}
+
+func (el *pathScanner) hasNumber() bool {
+	el.skip()
+	if el.pos >= len(el.data) {
+		return false
+	}
+	character := el.data[el.pos]
+	return character == '-' || character == '+' || character == '.' || (character >= '0' && character <= '9')
+}
+
+func (el *pathScanner) number() (value float64, err error) {
+	el.skip()
+	start := el.pos
+	if el.pos < len(el.data) && (el.data[el.pos] == '-' || el.data[el.pos] == '+') {
+		el.pos += 1
+	}
+	dot := false
+	for el.pos < len(el.data) {
+		character := el.data[el.pos]
+		if character >= '0' && character <= '9' {
+			el.pos += 1
+		} else if character == '.' && !dot {
+			dot = true
+			el.pos += 1
+		} else {
+			break
+		}
+	}
+	if el.pos < len(el.data) && (el.data[el.pos] == 'e' || el.data[el.pos] == 'E') {
+		exponent := el.pos + 1
+		if exponent < len(el.data) && (el.data[exponent] == '-' || el.data[exponent] == '+') {
+			exponent += 1
+		}
+		if exponent < len(el.data) && el.data[exponent] >= '0' && el.data[exponent] <= '9' {
+			el.pos = exponent
+			for el.pos < len(el.data) && el.data[el.pos] >= '0' && el.data[el.pos] <= '9' {
+				el.pos += 1
+			}
+		}
+	}
+	value, err = strconv.ParseFloat(el.data[start:el.pos], 64)
+	if err != nil {
+		return 0, fmt.Errorf("svgImport: invalid number at %q", el.data[start:])
+	}
+	return
+}
+
+// flag
+// en: Reads an arc flag, a single "0" or "1" that may be glued to the next number
+//
+// pt_br: Lê uma flag de arco, um único "0" ou "1" que pode estar colado ao próximo
+// número
+func (el *pathScanner) flag() (bool, error) {
+	el.skip()
+	if el.pos < len(el.data) && (el.data[el.pos] == '0' || el.data[el.pos] == '1') {
+		el.pos += 1
+		return el.data[el.pos-1] == '1', nil
+	}
+	return false, fmt.Errorf("svgImport: invalid arc flag at %q", el.data[el.pos:])
+}
+
+func (el *pathScanner) numbers(count int) (values []float64, err error) {
+	values = make([]float64, count)
+	for i := 0; i != count; i += 1 {
+		values[i], err = el.number()
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// ParsePathData
+// en: Parses the "d" attribute of a path with the full syntax: M, L, H, V, C, S,
+// Q, T, A and Z, absolute and relative, with implicit repetition of commands. On
+// error, the segments read before the error are returned, like browsers render
+// them
+//
+// pt_br: Interpreta o atributo "d" de um caminho com a sintaxe completa: M, L, H,
+// V, C, S, Q, T, A e Z, absolutos e relativos, com repetição implícita de
+// comandos. Em caso de erro, os segmentos lidos antes do erro são retornados, como
+// os navegadores os desenham
+func ParsePathData(data string) (segments []Segment, err error) {
+	scanner := &pathScanner{data: data}
+	segments = make([]Segment, 0)
+
+	var current, start, lastControl Point
+	var previous byte
+	var command byte
+	for {
+		next, found := scanner.command()
+		if found {
+			command = next
+		} else if !scanner.hasNumber() {
+			scanner.skip()
+			if scanner.pos < len(scanner.data) {
+				err = fmt.Errorf("svgImport: unexpected %q in path data", scanner.data[scanner.pos:])
+			}
+			return
+		} else if command == 0 {
+			return segments, fmt.Errorf("svgImport: path data must start with a command")
+		} else if command == 'Z' || command == 'z' {
+			return segments, fmt.Errorf("svgImport: unexpected number after closepath")
+		} else if command == 'M' {
+			// en: coordinates after a moveto are implicit lineto commands
+			// pt_br: coordenadas depois de um moveto são comandos lineto implícitos
+			command = 'L'
+		} else if command == 'm' {
+			command = 'l'
+		}
+
+		relative := command >= 'a'
+		offset := Point{}
+		if relative {
+			offset = current
+		}
+
+		var values []float64
+		switch command {
+		case 'M', 'm':
+			if values, err = scanner.numbers(2); err != nil {
+				return
+			}
+			current = Point{X: offset.X + values[0], Y: offset.Y + values[1]}
+			start = current
+			segments = append(segments, Segment{Operation: KMoveTo, Points: [3]Point{current}})
+
+		case 'L', 'l':
+			if values, err = scanner.numbers(2); err != nil {
+				return
+			}
+			current = Point{X: offset.X + values[0], Y: offset.Y + values[1]}
+			segments = append(segments, Segment{Operation: KLineTo, Points: [3]Point{current}})
+
+		case 'H', 'h':
+			if values, err = scanner.numbers(1); err != nil {
+				return
+			}
+			current = Point{X: offset.X + values[0], Y: current.Y}
+			segments = append(segments, Segment{Operation: KLineTo, Points: [3]Point{current}})
+
+		case 'V', 'v':
+			if values, err = scanner.numbers(1); err != nil {
+				return
+			}
+			current = Point{X: current.X, Y: offset.Y + values[0]}
+			segments = append(segments, Segment{Operation: KLineTo, Points: [3]Point{current}})
+
+		case 'C', 'c', 'S', 's':
+			smooth := command == 'S' || command == 's'
+			count := 6
+			if smooth {
+				count = 4
+			}
+			if values, err = scanner.numbers(count); err != nil {
+				return
+			}
+			var first Point
+			if smooth {
+				first = current
+				if previous == 'C' || previous == 'c' || previous == 'S' || previous == 's' {
+					first = Point{X: 2*current.X - lastControl.X, Y: 2*current.Y - lastControl.Y}
+				}
+				values = append([]float64{first.X - offset.X, first.Y - offset.Y}, values...)
+			}
+			first = Point{X: offset.X + values[0], Y: offset.Y + values[1]}
+			second := Point{X: offset.X + values[2], Y: offset.Y + values[3]}
+			end := Point{X: offset.X + values[4], Y: offset.Y + values[5]}
+			segments = append(segments, Segment{Operation: KCubicTo, Points: [3]Point{first, second, end}})
+			lastControl, current = second, end
+
+		case 'Q', 'q', 'T', 't':
+			var control Point
+			if command == 'T' || command == 't' {
+				if values, err = scanner.numbers(2); err != nil {
+					return
+				}
+				control = current
+				if previous == 'Q' || previous == 'q' || previous == 'T' || previous == 't' {
+					control = Point{X: 2*current.X - lastControl.X, Y: 2*current.Y - lastControl.Y}
+				}
+				values = []float64{control.X - offset.X, control.Y - offset.Y, values[0], values[1]}
+			} else if values, err = scanner.numbers(4); err != nil {
+				return
+			}
+			control = Point{X: offset.X + values[0], Y: offset.Y + values[1]}
+			end := Point{X: offset.X + values[2], Y: offset.Y + values[3]}
+			segments = append(segments, quadToCubic(current, control, end))
+			lastControl, current = control, end
+
+		case 'A', 'a':
+			if values, err = scanner.numbers(3); err != nil {
+				return
+			}
+			var large, sweep bool
+			if large, err = scanner.flag(); err != nil {
+				return
+			}
+			if sweep, err = scanner.flag(); err != nil {
+				return
+			}
+			var end []float64
+			if end, err = scanner.numbers(2); err != nil {
+				return
+			}
+			target := Point{X: offset.X + end[0], Y: offset.Y + end[1]}
+			segments = append(segments, arcToCubics(current, values[0], values[1], values[2], large, sweep, target)...)
+			current = target
+
+		case 'Z', 'z':
+			segments = append(segments, Segment{Operation: KClose, Points: [3]Point{start}})
+			current = start
+			previous = command
+			continue
+
+		default:
+			return segments, fmt.Errorf("svgImport: unknown path command %q", string(command))
+		}
+		previous = command
+	}
+}
+
+// quadToCubic
+// en: Returns the cubic curve equal to the quadratic curve
+//
+// pt_br: Retorna a curva cúbica igual à curva quadrática
+func quadToCubic(start, control, end Point) Segment {
+	return Segment{Operation: KCubicTo, Points: [3]Point{
+		{X: start.X + 2.0/3.0*(control.X-start.X), Y: start.Y + 2.0/3.0*(control.Y-start.Y)},
+		{X: end.X + 2.0/3.0*(control.X-end.X), Y: end.Y + 2.0/3.0*(control.Y-end.Y)},
+		end,
+	}}
+}
+
+// arcToCubics
+// en: Converts an elliptical arc in the endpoint notation of SVG to cubic curves,
+// one per quarter of turn, following the implementation notes of the SVG
+// specification (F.6)
+//
+// pt_br: Converte um arco elíptico na notação de pontos finais do SVG em curvas
+// cúbicas, uma por quarto de volta, seguindo as notas de implementação da
+// especificação SVG (F.6)
+func arcToCubics(start Point, rx, ry, rotation float64, large, sweep bool, end Point) []Segment {
+	if start == end {
+		return nil
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		return []Segment{{Operation: KLineTo, Points: [3]Point{end}}}
+	}
+
+	phi := rotation * math.Pi / 180
+	cos, sin := math.Cos(phi), math.Sin(phi)
+	dx, dy := (start.X-end.X)/2, (start.Y-end.Y)/2
+	x1 := cos*dx + sin*dy
+	y1 := -sin*dx + cos*dy
+
+	// en: radii too small are scaled up
+	// pt_br: raios pequenos demais são aumentados
+	if lambda := x1*x1/(rx*rx) + y1*y1/(ry*ry); lambda > 1 {
+		rx, ry = rx*math.Sqrt(lambda), ry*math.Sqrt(lambda)
+	}
+
+	numerator := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	denominator := rx*rx*y1*y1 + ry*ry*x1*x1
+	factor := math.Sqrt(math.Max(0, numerator/denominator))
+	if large == sweep {
+		factor = -factor
+	}
+	cx1, cy1 := factor*rx*y1/ry, -factor*ry*x1/rx
+	cx := cos*cx1 - sin*cy1 + (start.X+end.X)/2
+	cy := sin*cx1 + cos*cy1 + (start.Y+end.Y)/2
+
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+	theta := angle(1, 0, (x1-cx1)/rx, (y1-cy1)/ry)
+	delta := angle((x1-cx1)/rx, (y1-cy1)/ry, (-x1-cx1)/rx, (-y1-cy1)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	count := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	step := delta / float64(count)
+	kappa := 4.0 / 3.0 * math.Tan(step/4)
+	point := func(t float64) (x, y, tx, ty float64) {
+		ex, ey := rx*math.Cos(t), ry*math.Sin(t)
+		dex, dey := -rx*math.Sin(t), ry*math.Cos(t)
+		return cx + cos*ex - sin*ey, cy + sin*ex + cos*ey, cos*dex - sin*dey, sin*dex + cos*dey
+	}
+
+	segments := make([]Segment, 0, count)
+	for i := 0; i != count; i += 1 {
+		a, b := theta+step*float64(i), theta+step*float64(i+1)
+		ax, ay, atx, aty := point(a)
+		bx, by, btx, bty := point(b)
+		segment := Segment{Operation: KCubicTo, Points: [3]Point{
+			{X: ax + kappa*atx, Y: ay + kappa*aty},
+			{X: bx - kappa*btx, Y: by - kappa*bty},
+			{X: bx, Y: by},
+		}}
+		if i == count-1 {
+			segment.Points[2] = end
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
@@ -0,0 +1,271 @@
+package svgImport
+
+import (
+	"math"
+	"strings"
+)
+
+// kKappa
+// en: Distance of the control points of a cubic curve that approximates a quarter
+// of circle of radius 1
+//
+// pt_br: Distância dos pontos de controle de uma curva cúbica que aproxima um
+// quarto de círculo de raio 1
+const kKappa = 0.5522847498307936
+
+// shape
+// en: Imports path, rect, circle, ellipse, line, polyline and polygon
+//
+// pt_br: Importa path, rect, circle, ellipse, line, polyline e polygon
+func (el *parser) shape(element *node, current state) {
+	var segments []Segment
+	length := func(name string, axis axis) float64 {
+		return el.lengthOr(element, name, axis, current, "0")
+	}
+
+	switch element.name {
+	case "path":
+		var err error
+		if segments, err = ParsePathData(element.attributes["d"]); err != nil {
+			el.report(element, err.Error())
+		}
+	case "rect":
+		segments = rectangle(element, length("x", kAxisX), length("y", kAxisY), length("width", kAxisX), length("height", kAxisY),
+			length("rx", kAxisX), length("ry", kAxisY))
+	case "circle":
+		radius := length("r", kAxisDiagonal)
+		segments = ellipse(length("cx", kAxisX), length("cy", kAxisY), radius, radius)
+	case "ellipse":
+		rx, ry := length("rx", kAxisX), length("ry", kAxisY)
+		// en: auto radius of SVG 2: the other radius
+		// pt_br: raio auto do SVG 2: o outro raio
+		if _, found := element.attributes["rx"]; !found {
+			rx = ry
+		}
+		if _, found := element.attributes["ry"]; !found {
+			ry = rx
+		}
+		segments = ellipse(length("cx", kAxisX), length("cy", kAxisY), rx, ry)
+	case "line":
+		segments = []Segment{
+			{Operation: KMoveTo, Points: [3]Point{{X: length("x1", kAxisX), Y: length("y1", kAxisY)}}},
+			{Operation: KLineTo, Points: [3]Point{{X: length("x2", kAxisX), Y: length("y2", kAxisY)}}},
+		}
+	case "polyline", "polygon":
+		values, err := parseNumbers(element.attributes["points"])
+		if err != nil {
+			el.report(element, err.Error())
+		}
+		segments = make([]Segment, 0, len(values)/2+1)
+		for i := 0; i+1 < len(values); i += 2 {
+			operation := KLineTo
+			if i == 0 {
+				operation = KMoveTo
+			}
+			segments = append(segments, Segment{Operation: operation, Points: [3]Point{{X: values[i], Y: values[i+1]}}})
+		}
+		if element.name == "polygon" && len(segments) != 0 {
+			segments = append(segments, Segment{Operation: KClose, Points: [3]Point{segments[0].Points[0]}})
+		}
+	}
+	if len(segments) == 0 {
+		return
+	}
+
+	item, visible := el.item(element, KItemPath, current)
+	if !visible {
+		return
+	}
+	item.Segments = segments
+	el.add(element, item)
+}
+
+// rectangle
+// en: Returns the outline of a rect, with the rounded corners of rx and ry
+//
+// pt_br: Retorna o contorno de um rect, com os cantos arredondados de rx e ry
+func rectangle(element *node, x, y, width, height, rx, ry float64) []Segment {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	_, hasRx := element.attributes["rx"]
+	_, hasRy := element.attributes["ry"]
+	if !hasRx {
+		rx = ry
+	}
+	if !hasRy {
+		ry = rx
+	}
+	rx = math.Max(0, math.Min(rx, width/2))
+	ry = math.Max(0, math.Min(ry, height/2))
+
+	if rx == 0 || ry == 0 {
+		return []Segment{
+			{Operation: KMoveTo, Points: [3]Point{{X: x, Y: y}}},
+			{Operation: KLineTo, Points: [3]Point{{X: x + width, Y: y}}},
+			{Operation: KLineTo, Points: [3]Point{{X: x + width, Y: y + height}}},
+			{Operation: KLineTo, Points: [3]Point{{X: x, Y: y + height}}},
+			{Operation: KClose, Points: [3]Point{{X: x, Y: y}}},
+		}
+	}
+
+	kx, ky := rx*kKappa, ry*kKappa
+	right, bottom := x+width, y+height
+	return []Segment{
+		{Operation: KMoveTo, Points: [3]Point{{X: x + rx, Y: y}}},
+		{Operation: KLineTo, Points: [3]Point{{X: right - rx, Y: y}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: right - rx + kx, Y: y}, {X: right, Y: y + ry - ky}, {X: right, Y: y + ry}}},
+		{Operation: KLineTo, Points: [3]Point{{X: right, Y: bottom - ry}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: right, Y: bottom - ry + ky}, {X: right - rx + kx, Y: bottom}, {X: right - rx, Y: bottom}}},
+		{Operation: KLineTo, Points: [3]Point{{X: x + rx, Y: bottom}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: x + rx - kx, Y: bottom}, {X: x, Y: bottom - ry + ky}, {X: x, Y: bottom - ry}}},
+		{Operation: KLineTo, Points: [3]Point{{X: x, Y: y + ry}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: x, Y: y + ry - ky}, {X: x + rx - kx, Y: y}, {X: x + rx, Y: y}}},
+		{Operation: KClose, Points: [3]Point{{X: x + rx, Y: y}}},
+	}
+}
+
+// ellipse
+// en: Returns the outline of an ellipse, four cubic curves from the rightmost
+// point, clockwise on the screen
+//
+// pt_br: Retorna o contorno de uma elipse, quatro curvas cúbicas a partir do ponto
+// mais à direita, no sentido horário na tela
+func ellipse(cx, cy, rx, ry float64) []Segment {
+	if rx <= 0 || ry <= 0 {
+		return nil
+	}
+	kx, ky := rx*kKappa, ry*kKappa
+	return []Segment{
+		{Operation: KMoveTo, Points: [3]Point{{X: cx + rx, Y: cy}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: cx + rx, Y: cy + ky}, {X: cx + kx, Y: cy + ry}, {X: cx, Y: cy + ry}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: cx - kx, Y: cy + ry}, {X: cx - rx, Y: cy + ky}, {X: cx - rx, Y: cy}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: cx - rx, Y: cy - ky}, {X: cx - kx, Y: cy - ry}, {X: cx, Y: cy - ry}}},
+		{Operation: KCubicTo, Points: [3]Point{{X: cx + kx, Y: cy - ry}, {X: cx + rx, Y: cy - ky}, {X: cx + rx, Y: cy}}},
+		{Operation: KClose, Points: [3]Point{{X: cx + rx, Y: cy}}},
+	}
+}
+
+// textCursor
+// en: Current text position while the text and tspan elements are imported
+//
+// pt_br: Posição atual do texto enquanto os elementos text e tspan são importados
+type textCursor struct {
+	x        float64
+	y        float64
+	dx       float64
+	absolute bool
+	space    bool
+	last     int
+}
+
+// text
+// en: Imports a text element. Each run of text with its own style, or position,
+// becomes an item; runs without x continue after the previous one when drawn
+//
+// pt_br: Importa um elemento text. Cada trecho de texto com estilo, ou posição,
+// próprio vira um item; trechos sem x continuam depois do anterior quando
+// desenhados
+func (el *parser) text(element *node, current state) {
+	cursor := &textCursor{absolute: true, space: true, last: -1}
+	el.textContent(element, current, cursor)
+
+	// en: trailing spaces of the text are removed, unless preserved
+	// pt_br: espaços finais do texto são removidos, a não ser que preservados
+	if cursor.last != -1 && !current.preserve {
+		item := &el.document.Items[cursor.last]
+		item.Text = strings.TrimRight(item.Text, " ")
+	}
+}
+
+// textContent
+// en: Imports the text of an element and its tspan children
+//
+// pt_br: Importa o texto de um elemento e dos seus filhos tspan
+func (el *parser) textContent(element *node, current state, cursor *textCursor) {
+	length := func(name string, axis axis) (value float64, found bool) {
+		if _, found = element.attributes[name]; found {
+			value = el.lengthOr(element, name, axis, current, "0")
+		}
+		return
+	}
+	if value, found := length("x", kAxisX); found {
+		cursor.x, cursor.dx, cursor.absolute = value, 0, true
+	}
+	if value, found := length("y", kAxisY); found {
+		cursor.y = value
+	}
+	if value, found := length("dx", kAxisX); found {
+		cursor.dx += value
+	}
+	if value, found := length("dy", kAxisY); found {
+		cursor.y += value
+	}
+	if _, found := element.attributes["rotate"]; found {
+		el.report(element, "rotate is not supported")
+	}
+	if _, found := element.attributes["textLength"]; found {
+		el.report(element, "textLength is not supported")
+	}
+
+	for _, child := range element.children {
+		switch child.name {
+		case "#text":
+			el.textRun(element, child.text, current, cursor)
+		case "tspan", "a":
+			if child.attributes["display"] == "none" {
+				continue
+			}
+			el.textContent(child, el.cascade(child, current), cursor)
+		default:
+			el.report(child, "element is not supported inside text")
+		}
+	}
+}
+
+// textRun
+// en: Adds the item of a run of text, with the white space handling of
+// xml:space="default": new lines removed, tabs converted to spaces and
+// consecutive spaces collapsed, also across runs
+//
+// pt_br: Adiciona o item de um trecho de texto, com o tratamento de espaços de
+// xml:space="default": novas linhas removidas, tabulações convertidas em espaços
+// e espaços consecutivos colapsados, também entre trechos
+func (el *parser) textRun(element *node, text string, current state, cursor *textCursor) {
+	if current.preserve {
+		text = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\t", " ").Replace(text)
+	} else {
+		text = strings.NewReplacer("\r", "", "\n", "", "\t", " ").Replace(text)
+		collapsed := make([]rune, 0, len(text))
+		for _, character := range text {
+			if character == ' ' {
+				if cursor.space {
+					continue
+				}
+				cursor.space = true
+			} else {
+				cursor.space = false
+			}
+			collapsed = append(collapsed, character)
+		}
+		text = string(collapsed)
+	}
+	if text == "" {
+		return
+	}
+
+	item, visible := el.item(element, KItemText, current)
+	if !visible {
+		return
+	}
+	item.Text = text
+	item.Position = Point{X: cursor.dx, Y: cursor.y}
+	item.Continue = !cursor.absolute
+	if cursor.absolute {
+		item.Position.X += cursor.x
+	}
+	cursor.dx, cursor.absolute = 0, false
+	if el.add(element, item) {
+		cursor.last = len(el.document.Items) - 1
+	}
+}
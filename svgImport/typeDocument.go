@@ -0,0 +1,221 @@
+package svgImport
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/fontRegistry"
+)
+
+// PaintKind
+// en: Kind of value of fill and stroke
+//
+// pt_br: Tipo de valor de fill e stroke
+type PaintKind int
+
+const (
+	KPaintNone PaintKind = iota
+	KPaintColor
+	KPaintGradient
+)
+
+// Paint
+// en: Value of fill or stroke
+//
+// pt_br: Valor de fill ou stroke
+type Paint struct {
+	Kind     PaintKind
+	Color    color.RGBA
+	Gradient *Gradient
+}
+
+// Stop
+// en: Colour stop of a gradient, with the stop-opacity already in the alpha
+//
+// pt_br: Parada de cor de um gradiente, com o stop-opacity já no alfa
+type Stop struct {
+	Offset float64
+	Color  color.RGBA
+}
+
+// Gradient
+// en: Linear or radial gradient, with the references (href) already resolved
+//
+//	BoundingBox: The coordinates are fractions of the bounding box of the shape,
+//	             gradientUnits="objectBoundingBox", the default of SVG
+//	X1, Y1, X2, Y2: Gradient vector of linear gradients
+//	CX, CY, R: End circle of radial gradients
+//	FX, FY: Focal point of radial gradients
+//	Transform: gradientTransform
+//
+// pt_br: Gradiente linear ou radial, com as referências (href) já resolvidas
+//
+//	BoundingBox: As coordenadas são frações da caixa delimitadora da forma,
+//	             gradientUnits="objectBoundingBox", o padrão do SVG
+//	X1, Y1, X2, Y2: Vetor do gradiente dos gradientes lineares
+//	CX, CY, R: Círculo final dos gradientes radiais
+//	FX, FY: Ponto focal dos gradientes radiais
+//	Transform: gradientTransform
+type Gradient struct {
+	Id          string
+	Radial      bool
+	BoundingBox bool
+	X1          float64
+	Y1          float64
+	X2          float64
+	Y2          float64
+	CX          float64
+	CY          float64
+	R           float64
+	FX          float64
+	FY          float64
+	Transform   Matrix
+	Stops       []Stop
+}
+
+// ItemKind
+// en: Kind of item of the display list
+//
+// pt_br: Tipo de item da lista de exibição
+type ItemKind int
+
+const (
+	KItemPath ItemKind = iota
+	KItemText
+)
+
+// Anchor
+// en: Value of text-anchor
+//
+// pt_br: Valor de text-anchor
+type Anchor int
+
+const (
+	KAnchorStart Anchor = iota
+	KAnchorMiddle
+	KAnchorEnd
+)
+
+// Item
+// en: Entry of the display list: a path or a text with its final style and
+// transformation, ready to be replayed on IDraw
+//
+//	Id: Id of the element that produced the item
+//	Groups: Ids of the groups, and use elements, around the element, from the
+//	        outermost
+//	Element: Name of the SVG element that produced the item, like "rect"
+//	Transform: Transformation from the user units of the item to the units of
+//	           the document
+//	Segments: Outline of KItemPath items
+//	Text, Font, Anchor: Content of KItemText items
+//	Position: Baseline of KItemText items. When Continue is true, X is an extra
+//	          space after the end of the previous text item, as a tspan without
+//	          x
+//	Opacity: Product of the opacity of the element and of its groups
+//
+// pt_br: Entrada da lista de exibição: um caminho ou um texto com o seu estilo e a
+// sua transformação finais, prontos para serem reproduzidos no IDraw
+//
+//	Id: Id do elemento que produziu o item
+//	Groups: Ids dos grupos, e elementos use, em volta do elemento, a partir do
+//	        mais externo
+//	Element: Nome do elemento SVG que produziu o item, como "rect"
+//	Transform: Transformação das unidades do usuário do item para as unidades do
+//	           documento
+//	Segments: Contorno dos itens KItemPath
+//	Text, Font, Anchor: Conteúdo dos itens KItemText
+//	Position: Linha de base dos itens KItemText. Quando Continue é true, X é um
+//	          espaço extra depois do fim do item de texto anterior, como um tspan
+//	          sem x
+//	Opacity: Produto da opacidade do elemento e dos seus grupos
+type Item struct {
+	Kind        ItemKind
+	Id          string
+	Groups      []string
+	Element     string
+	Transform   Matrix
+	Segments    []Segment
+	Fill        Paint
+	Stroke      Paint
+	StrokeWidth float64
+	Opacity     float64
+	Text        string
+	Position    Point
+	Continue    bool
+	Anchor      Anchor
+	Font        fontRegistry.Description
+}
+
+// Issue
+// en: Feature of the file that is not supported, or is only approximated, by the
+// import
+//
+// pt_br: Recurso do arquivo que não é suportado, ou é apenas aproximado, pela
+// importação
+type Issue struct {
+	Element string
+	Id      string
+	Feature string
+}
+
+// String
+// en: Returns the issue formatted as "<element id>: feature"
+//
+// pt_br: Retorna o problema formatado como "<element id>: feature"
+func (el Issue) String() string {
+	if el.Id != "" {
+		return fmt.Sprintf("<%v id=%q>: %v", el.Element, el.Id, el.Feature)
+	}
+	return fmt.Sprintf("<%v>: %v", el.Element, el.Feature)
+}
+
+// Document
+// en: SVG file converted into a display list. Parse the file once and draw it many
+// times with Draw()
+//
+//	Width, Height: Size of the document, in pixels
+//	ViewBox: x, y, width and height of the viewBox; zero width and height when
+//	         the file has no viewBox
+//	Items: Display list, in painting order
+//	Issues: Features not supported or approximated, one entry per element and
+//	        feature
+//
+// pt_br: Arquivo SVG convertido em uma lista de exibição. Interprete o arquivo uma
+// vez e o desenhe muitas vezes com Draw()
+//
+//	Width, Height: Tamanho do documento, em pixels
+//	ViewBox: x, y, largura e altura do viewBox; largura e altura zero quando o
+//	         arquivo não tem viewBox
+//	Items: Lista de exibição, em ordem de pintura
+//	Issues: Recursos não suportados ou aproximados, uma entrada por elemento e
+//	        recurso
+type Document struct {
+	Width   float64
+	Height  float64
+	ViewBox [4]float64
+	Items   []Item
+	Issues  []Issue
+}
+
+// GetItemsById
+// en: Returns the items produced by the element with the id, or by its children
+// when the element is a group
+//
+// pt_br: Retorna os itens produzidos pelo elemento com o id, ou pelos seus filhos
+// quando o elemento é um grupo
+func (el *Document) GetItemsById(id string) []Item {
+	list := make([]Item, 0)
+	for _, item := range el.Items {
+		if item.Id == id {
+			list = append(list, item)
+			continue
+		}
+		for _, group := range item.Groups {
+			if group == id {
+				list = append(list, item)
+				break
+			}
+		}
+	}
+	return list
+}
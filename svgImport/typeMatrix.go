@@ -0,0 +1,143 @@
+package svgImport
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Matrix
+// en: Affine transformation with the a, b, c, d, e, f values of IDraw.SetTransform()
+//
+// pt_br: Transformação afim com os valores a, b, c, d, e, f de IDraw.SetTransform()
+type Matrix [6]float64
+
+// KIdentity
+// en: Transformation that keeps the points unchanged
+//
+// pt_br: Transformação que mantém os pontos inalterados
+var KIdentity = Matrix{1, 0, 0, 1, 0, 0}
+
+// Multiply
+// en: Returns the transformation that applies other first and then el, the order
+// of the transform attribute of SVG
+//
+// pt_br: Retorna a transformação que aplica other primeiro e depois el, a ordem do
+// atributo transform do SVG
+func (el Matrix) Multiply(other Matrix) Matrix {
+	return Matrix{
+		el[0]*other[0] + el[2]*other[1],
+		el[1]*other[0] + el[3]*other[1],
+		el[0]*other[2] + el[2]*other[3],
+		el[1]*other[2] + el[3]*other[3],
+		el[0]*other[4] + el[2]*other[5] + el[4],
+		el[1]*other[4] + el[3]*other[5] + el[5],
+	}
+}
+
+// Apply
+// en: Returns the transformed point
+//
+// pt_br: Retorna o ponto transformado
+func (el Matrix) Apply(point Point) Point {
+	return Point{
+		X: el[0]*point.X + el[2]*point.Y + el[4],
+		Y: el[1]*point.X + el[3]*point.Y + el[5],
+	}
+}
+
+// Scale
+// en: Returns the mean scale of the transformation, used to choose the precision
+// of curves and to scale lengths
+//
+// pt_br: Retorna a escala média da transformação, usada para escolher a precisão
+// das curvas e para escalar comprimentos
+func (el Matrix) Scale() float64 {
+	return math.Sqrt(math.Abs(el[0]*el[3] - el[1]*el[2]))
+}
+
+// parseTransform
+// en: Parses the transform attribute: matrix(), translate(), scale(), rotate(),
+// skewX() and skewY(), applied from left to right
+//
+// pt_br: Interpreta o atributo transform: matrix(), translate(), scale(),
+// rotate(), skewX() e skewY(), aplicados da esquerda para a direita
+func parseTransform(text string) (matrix Matrix, err error) {
+	matrix = KIdentity
+	text = strings.TrimSpace(text)
+	for text != "" {
+		open := strings.IndexByte(text, '(')
+		closing := strings.IndexByte(text, ')')
+		if open == -1 || closing < open {
+			return KIdentity, fmt.Errorf("svgImport: invalid transform %q", text)
+		}
+		name := strings.TrimSpace(text[:open])
+		scanner := &pathScanner{data: text[open+1 : closing]}
+		values := make([]float64, 0, 6)
+		for scanner.hasNumber() {
+			var value float64
+			if value, err = scanner.number(); err != nil {
+				return KIdentity, err
+			}
+			values = append(values, value)
+		}
+		text = strings.TrimLeft(text[closing+1:], " \t\r\n,")
+
+		var step Matrix
+		step, err = transformFunction(name, values)
+		if err != nil {
+			return KIdentity, err
+		}
+		matrix = matrix.Multiply(step)
+	}
+	return
+}
+
+func transformFunction(name string, values []float64) (Matrix, error) {
+	count := len(values)
+	invalid := fmt.Errorf("svgImport: invalid %v() with %v values", name, count)
+	switch name {
+	case "matrix":
+		if count != 6 {
+			return KIdentity, invalid
+		}
+		return Matrix{values[0], values[1], values[2], values[3], values[4], values[5]}, nil
+	case "translate":
+		if count == 1 {
+			return Matrix{1, 0, 0, 1, values[0], 0}, nil
+		}
+		if count == 2 {
+			return Matrix{1, 0, 0, 1, values[0], values[1]}, nil
+		}
+	case "scale":
+		if count == 1 {
+			return Matrix{values[0], 0, 0, values[0], 0, 0}, nil
+		}
+		if count == 2 {
+			return Matrix{values[0], 0, 0, values[1], 0, 0}, nil
+		}
+	case "rotate":
+		if count != 1 && count != 3 {
+			return KIdentity, invalid
+		}
+		angle := values[0] * math.Pi / 180
+		rotation := Matrix{math.Cos(angle), math.Sin(angle), -math.Sin(angle), math.Cos(angle), 0, 0}
+		if count == 1 {
+			return rotation, nil
+		}
+		to := Matrix{1, 0, 0, 1, values[1], values[2]}
+		back := Matrix{1, 0, 0, 1, -values[1], -values[2]}
+		return to.Multiply(rotation).Multiply(back), nil
+	case "skewX":
+		if count == 1 {
+			return Matrix{1, 0, math.Tan(values[0] * math.Pi / 180), 1, 0, 0}, nil
+		}
+	case "skewY":
+		if count == 1 {
+			return Matrix{1, math.Tan(values[0] * math.Pi / 180), 0, 1, 0, 0}, nil
+		}
+	default:
+		return KIdentity, fmt.Errorf("svgImport: unknown transform %v()", name)
+	}
+	return KIdentity, invalid
+}
@@ -0,0 +1,731 @@
+package svgImport
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/fontRegistry"
+)
+
+// kUnsupportedProperties
+// en: Properties that are not imported and the value that needs no report, in the
+// order of the report
+//
+// pt_br: Propriedades que não são importadas e o valor que não precisa de
+// relatório, na ordem do relatório
+var kUnsupportedProperties = [][2]string{
+	{"fill-rule", "nonzero"},
+	{"stroke-dasharray", "none"},
+	{"stroke-linecap", "butt"},
+	{"stroke-linejoin", "miter"},
+	{"clip-path", "none"},
+	{"mask", "none"},
+	{"filter", "none"},
+	{"marker", "none"},
+	{"marker-start", "none"},
+	{"marker-mid", "none"},
+	{"marker-end", "none"},
+	{"mix-blend-mode", "normal"},
+	{"paint-order", "normal"},
+	{"vector-effect", "none"},
+	{"letter-spacing", "normal"},
+	{"word-spacing", "normal"},
+	{"text-decoration", "none"},
+	{"dominant-baseline", "auto"},
+	{"alignment-baseline", "auto"},
+	{"baseline-shift", "baseline"},
+	{"writing-mode", "horizontal-tb"},
+}
+
+// axis
+// en: Reference of percentages: the width, the height or the normalized diagonal
+// of the viewport
+//
+// pt_br: Referência das porcentagens: a largura, a altura ou a diagonal
+// normalizada da viewport
+type axis int
+
+const (
+	kAxisX axis = iota
+	kAxisY
+	kAxisDiagonal
+)
+
+// state
+// en: Inherited style and coordinate system of an element
+//
+// pt_br: Estilo herdado e sistema de coordenadas de um elemento
+type state struct {
+	transform     Matrix
+	viewport      [2]float64
+	opacity       float64
+	fill          string
+	stroke        string
+	fillOpacity   float64
+	strokeOpacity float64
+	strokeWidth   float64
+	color         color.RGBA
+	font          fontRegistry.Description
+	anchor        Anchor
+	visible       bool
+	preserve      bool
+	groups        []string
+}
+
+// parser
+// en: Converts the XML tree into the display list
+//
+// pt_br: Converte a árvore XML na lista de exibição
+type parser struct {
+	document  *Document
+	ids       map[string]*node
+	gradients map[string]*Gradient
+	reported  map[Issue]bool
+	useDepth  int
+	uses      int
+	limited   map[string]bool
+}
+
+// report
+// en: Adds an issue, once per element and feature
+//
+// pt_br: Adiciona um problema, uma vez por elemento e recurso
+func (el *parser) report(element *node, feature string) {
+	feature = strings.TrimPrefix(feature, "svgImport: ")
+	issue := Issue{Element: element.name, Id: element.attributes["id"], Feature: feature}
+	if el.reported[issue] {
+		return
+	}
+	el.reported[issue] = true
+	el.document.Issues = append(el.document.Issues, issue)
+}
+
+// limit
+// en: Reports, once per document, that a limit of the import was reached
+//
+// pt_br: Reporta, uma vez por documento, que um limite da importação foi atingido
+func (el *parser) limit(element *node, feature string) {
+	if el.limited[feature] {
+		return
+	}
+	el.limited[feature] = true
+	el.report(element, feature)
+}
+
+// add
+// en: Adds the item to the display list, up to KMaxItems
+//
+// pt_br: Adiciona o item à lista de exibição, até KMaxItems
+func (el *parser) add(element *node, item Item) bool {
+	if len(el.document.Items) >= KMaxItems {
+		el.limit(element, fmt.Sprintf("more than %v items; the rest of the file was ignored", KMaxItems))
+		return false
+	}
+	el.document.Items = append(el.document.Items, item)
+	return true
+}
+
+// index
+// en: Indexes the elements by id; the first element wins, as in browsers
+//
+// pt_br: Indexa os elementos pelo id; o primeiro elemento vence, como nos
+// navegadores
+func (el *parser) index(element *node) {
+	if id := element.attributes["id"]; id != "" {
+		if _, found := el.ids[id]; !found {
+			el.ids[id] = element
+		}
+	}
+	for _, child := range element.children {
+		el.index(child)
+	}
+}
+
+// root
+// en: Defines the size of the document and imports the root svg element
+//
+// pt_br: Define o tamanho do documento e importa o elemento svg raiz
+func (el *parser) root(element *node) {
+	viewBox, hasViewBox := el.viewBox(element)
+	natural := [2]float64{300, 150}
+	if hasViewBox {
+		natural = [2]float64{viewBox[2], viewBox[3]}
+	}
+
+	initial := state{
+		transform:     KIdentity,
+		viewport:      natural,
+		opacity:       1,
+		fill:          "black",
+		stroke:        "none",
+		fillOpacity:   1,
+		strokeOpacity: 1,
+		strokeWidth:   1,
+		color:         color.RGBA{A: 255},
+		font:          fontRegistry.Description{Families: []string{"serif"}, Weight: 400, Size: fontRegistry.KDefaultSize},
+		visible:       true,
+	}
+
+	// en: percentages of the root refer to the natural size, as there is no container
+	// pt_br: porcentagens da raiz referem-se ao tamanho natural, pois não há
+	// contêiner
+	el.document.Width = el.lengthOr(element, "width", kAxisX, initial, "100%")
+	el.document.Height = el.lengthOr(element, "height", kAxisY, initial, "100%")
+	if hasViewBox {
+		el.document.ViewBox = viewBox
+	}
+
+	current := el.cascade(element, initial)
+	el.viewport(element, current, el.document.Width, el.document.Height, false)
+}
+
+// viewport
+// en: Establishes the coordinate system of an svg or symbol element with the size
+// given and imports its children
+//
+// pt_br: Estabelece o sistema de coordenadas de um elemento svg ou symbol com o
+// tamanho dado e importa os seus filhos
+func (el *parser) viewport(element *node, current state, width, height float64, nested bool) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	current.viewport = [2]float64{width, height}
+	if viewBox, found := el.viewBox(element); found {
+		if viewBox[2] <= 0 || viewBox[3] <= 0 {
+			return
+		}
+		current.transform = current.transform.Multiply(viewBoxTransform(viewBox, width, height, element.attributes["preserveAspectRatio"]))
+		current.viewport = [2]float64{viewBox[2], viewBox[3]}
+	}
+	if nested && element.attributes["overflow"] != "visible" && element.attributes["overflow"] != "auto" {
+		el.report(element, "clipping of the viewport is not applied")
+	}
+	el.children(element, current)
+}
+
+// viewBox
+// en: Parses the viewBox attribute
+//
+// pt_br: Interpreta o atributo viewBox
+func (el *parser) viewBox(element *node) (viewBox [4]float64, found bool) {
+	text, found := element.attributes["viewBox"]
+	if !found {
+		return
+	}
+	values, err := parseNumbers(text)
+	if err != nil || len(values) != 4 {
+		el.report(element, fmt.Sprintf("invalid viewBox %q", text))
+		return viewBox, false
+	}
+	copy(viewBox[:], values)
+	return viewBox, true
+}
+
+// children
+// en: Imports the children of a container, adding its id to the groups
+//
+// pt_br: Importa os filhos de um contêiner, adicionando o seu id aos grupos
+func (el *parser) children(element *node, current state) {
+	if id := element.attributes["id"]; id != "" {
+		groups := make([]string, len(current.groups), len(current.groups)+1)
+		copy(groups, current.groups)
+		current.groups = append(groups, id)
+	}
+	if current.opacity < 1 && len(element.children) > 1 {
+		el.report(element, "group opacity is applied to each child")
+	}
+	for _, child := range element.children {
+		el.element(child, current)
+	}
+}
+
+// element
+// en: Imports an element and its children
+//
+// pt_br: Importa um elemento e os seus filhos
+func (el *parser) element(element *node, parent state) {
+	switch element.name {
+	case "#text", "title", "desc", "metadata", "defs", "symbol", "linearGradient", "radialGradient", "stop",
+		"clipPath", "mask", "filter", "marker", "pattern", "script":
+		// en: not rendered directly; the references are handled, or reported, where
+		// they are used
+		// pt_br: não são desenhados diretamente; as referências são tratadas, ou
+		// reportadas, onde são usadas
+		return
+	}
+	if element.attributes["display"] == "none" {
+		return
+	}
+
+	current := el.cascade(element, parent)
+	switch element.name {
+	case "g", "a":
+		el.children(element, current)
+	case "svg":
+		x := el.lengthOr(element, "x", kAxisX, current, "0")
+		y := el.lengthOr(element, "y", kAxisY, current, "0")
+		current.transform = current.transform.Multiply(Matrix{1, 0, 0, 1, x, y})
+		width := el.lengthOr(element, "width", kAxisX, current, "100%")
+		height := el.lengthOr(element, "height", kAxisY, current, "100%")
+		el.viewport(element, current, width, height, true)
+	case "use":
+		el.use(element, current)
+	case "path", "rect", "circle", "ellipse", "line", "polyline", "polygon":
+		el.shape(element, current)
+	case "text":
+		el.text(element, current)
+	case "style":
+		el.report(element, "style sheets are not supported; use presentation attributes or the style attribute")
+	case "animate", "animateTransform", "animateMotion", "animateColor", "set":
+		el.report(element, "animation is not supported")
+	default:
+		el.report(element, "element is not supported")
+	}
+}
+
+// use
+// en: Imports the element referenced by a use element, which inherits the style of
+// the use element
+//
+// pt_br: Importa o elemento referenciado por um elemento use, que herda o estilo
+// do elemento use
+func (el *parser) use(element *node, current state) {
+	href := strings.TrimSpace(element.attributes["href"])
+	if !strings.HasPrefix(href, "#") {
+		el.report(element, fmt.Sprintf("external reference %q is not supported", href))
+		return
+	}
+	target, found := el.ids[href[1:]]
+	if !found {
+		el.report(element, fmt.Sprintf("reference %q not found", href))
+		return
+	}
+	if el.useDepth >= KMaxUseDepth {
+		el.report(element, "use elements nested too deep, or recursive")
+		return
+	}
+	if el.uses >= KMaxUses {
+		el.limit(element, fmt.Sprintf("more than %v use elements instantiated; the rest were ignored", KMaxUses))
+		return
+	}
+	el.uses += 1
+	el.useDepth += 1
+	defer func() { el.useDepth -= 1 }()
+
+	x := el.lengthOr(element, "x", kAxisX, current, "0")
+	y := el.lengthOr(element, "y", kAxisY, current, "0")
+	current.transform = current.transform.Multiply(Matrix{1, 0, 0, 1, x, y})
+	if id := element.attributes["id"]; id != "" {
+		current.groups = append(append([]string{}, current.groups...), id)
+	}
+
+	switch target.name {
+	case "symbol", "svg":
+		if target.attributes["display"] == "none" {
+			return
+		}
+		inner := el.cascade(target, current)
+		size := func(name string, axis axis) float64 {
+			if _, found := element.attributes[name]; found {
+				return el.lengthOr(element, name, axis, current, "100%")
+			}
+			return el.lengthOr(target, name, axis, current, "100%")
+		}
+		el.viewport(target, inner, size("width", kAxisX), size("height", kAxisY), target.name == "svg")
+	default:
+		el.element(target, current)
+	}
+}
+
+// cascade
+// en: Returns the state of the element: the inherited state with the
+// transformation, opacity and properties of the element. Properties that are not
+// supported are reported
+//
+// pt_br: Retorna o estado do elemento: o estado herdado com a transformação,
+// opacidade e propriedades do elemento. Propriedades não suportadas são
+// reportadas
+func (el *parser) cascade(element *node, parent state) (current state) {
+	current = parent
+	attributes := element.attributes
+	value := func(name string) (text string, found bool) {
+		text, found = attributes[name]
+		text = strings.TrimSpace(text)
+		return text, found && text != "inherit"
+	}
+
+	if text, found := value("transform"); found && element.name != "svg" {
+		matrix, err := parseTransform(text)
+		if err != nil {
+			el.report(element, err.Error())
+		}
+		current.transform = current.transform.Multiply(matrix)
+	}
+	if text, found := value("opacity"); found {
+		current.opacity *= el.fraction(element, text)
+	}
+
+	if text, found := value("color"); found && !strings.EqualFold(text, "currentColor") {
+		if parsed, err := colorUtils.Parse(text); err == nil {
+			current.color = parsed
+		} else {
+			el.report(element, err.Error())
+		}
+	}
+	if text, found := value("fill"); found {
+		current.fill = text
+	}
+	if text, found := value("stroke"); found {
+		current.stroke = text
+	}
+	if text, found := value("fill-opacity"); found {
+		current.fillOpacity = el.fraction(element, text)
+	}
+	if text, found := value("stroke-opacity"); found {
+		current.strokeOpacity = el.fraction(element, text)
+	}
+	if _, found := value("stroke-width"); found {
+		current.strokeWidth = math.Max(0, el.lengthOr(element, "stroke-width", kAxisDiagonal, parent, "1"))
+	}
+
+	if text, found := value("font"); found {
+		if description, err := fontRegistry.ParseCSS(text); err == nil {
+			current.font = description
+		} else {
+			el.report(element, err.Error())
+		}
+	}
+	if text, found := value("font-family"); found {
+		families := make([]string, 0)
+		for _, family := range strings.Split(text, ",") {
+			if family = strings.Trim(strings.TrimSpace(family), `"'`); family != "" {
+				families = append(families, family)
+			}
+		}
+		current.font.Families = families
+	}
+	if text, found := value("font-size"); found {
+		current.font.Size = el.fontSize(element, text, parent)
+	}
+	if text, found := value("font-weight"); found {
+		switch text {
+		case "bolder":
+			current.font.Weight = int(math.Min(900, float64(parent.font.Weight+300)))
+		case "lighter":
+			current.font.Weight = int(math.Max(100, float64(parent.font.Weight-300)))
+		default:
+			if description, err := fontRegistry.ParseCSS(text + " 1px x"); err == nil {
+				current.font.Weight = description.Weight
+			}
+		}
+	}
+	if text, found := value("font-style"); found {
+		if description, err := fontRegistry.ParseCSS(text + " 1px x"); err == nil {
+			current.font.Style = description.Style
+		}
+	}
+	if text, found := value("text-anchor"); found {
+		switch text {
+		case "middle":
+			current.anchor = KAnchorMiddle
+		case "end":
+			current.anchor = KAnchorEnd
+		default:
+			current.anchor = KAnchorStart
+		}
+	}
+	if text, found := value("visibility"); found {
+		current.visible = text == "visible"
+	}
+	if text, found := value("xml:space"); found {
+		current.preserve = text == "preserve"
+	}
+
+	for _, property := range kUnsupportedProperties {
+		if text, found := value(property[0]); found && text != property[1] {
+			el.report(element, fmt.Sprintf("%v: %v is not supported", property[0], text))
+		}
+	}
+	return
+}
+
+// fontSize
+// en: Parses font-size, where em and percentages refer to the font of the parent
+//
+// pt_br: Interpreta font-size, onde em e porcentagens referem-se à fonte do pai
+func (el *parser) fontSize(element *node, text string, parent state) float64 {
+	if description, err := fontRegistry.ParseCSS(text + " x"); err == nil && !strings.HasSuffix(text, "%") &&
+		!strings.HasSuffix(text, "em") {
+		return description.Size
+	}
+	size, err := parseLength(text, parent.font.Size, parent.font.Size)
+	if err != nil {
+		el.report(element, err.Error())
+		return parent.font.Size
+	}
+	return size
+}
+
+// fraction
+// en: Parses an opacity, a number or a percentage, limited to 0 to 1
+//
+// pt_br: Interpreta uma opacidade, um número ou uma porcentagem, limitada a 0 a 1
+func (el *parser) fraction(element *node, text string) float64 {
+	value, err := parseLength(text, 1, 0)
+	if err != nil {
+		el.report(element, err.Error())
+		return 1
+	}
+	return math.Max(0, math.Min(1, value))
+}
+
+// lengthOr
+// en: Returns the length of the attribute, or of fallback when the attribute is
+// missing or invalid
+//
+// pt_br: Retorna o comprimento do atributo, ou de fallback quando o atributo está
+// ausente ou é inválido
+func (el *parser) lengthOr(element *node, name string, axis axis, current state, fallback string) float64 {
+	reference := current.viewport[0]
+	switch axis {
+	case kAxisY:
+		reference = current.viewport[1]
+	case kAxisDiagonal:
+		reference = math.Hypot(current.viewport[0], current.viewport[1]) / math.Sqrt2
+	}
+
+	text, found := element.attributes[name]
+	if found {
+		if values := strings.FieldsFunc(text, isListSeparator); len(values) > 1 {
+			el.report(element, fmt.Sprintf("%v: only the first value of the list is used", name))
+			text = values[0]
+		}
+		value, err := parseLength(text, reference, current.font.Size)
+		if err == nil {
+			return value
+		}
+		el.report(element, err.Error())
+	}
+	value, _ := parseLength(fallback, reference, current.font.Size)
+	return value
+}
+
+// paint
+// en: Resolves the value of fill or stroke: none, a color, currentColor or a
+// reference to a gradient, with an optional fallback color
+//
+// pt_br: Resolve o valor de fill ou stroke: none, uma cor, currentColor ou uma
+// referência a um gradiente, com uma cor alternativa opcional
+func (el *parser) paint(element *node, text string, opacity float64, current state) Paint {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "url(") {
+		closing := strings.IndexByte(text, ')')
+		if closing == -1 {
+			el.report(element, fmt.Sprintf("invalid paint %q", text))
+			return Paint{}
+		}
+		reference := strings.Trim(strings.TrimSpace(text[4:closing]), `"'`)
+		fallback := strings.TrimSpace(text[closing+1:])
+		if gradient, found := el.gradient(element, strings.TrimPrefix(reference, "#"), current); found {
+			return gradientPaint(gradient, opacity)
+		}
+		if fallback == "" {
+			return Paint{}
+		}
+		text = fallback
+	}
+
+	var value color.RGBA
+	switch {
+	case text == "none" || text == "":
+		return Paint{}
+	case strings.EqualFold(text, "currentColor"):
+		value = current.color
+	default:
+		parsed, err := colorUtils.Parse(text)
+		if err != nil {
+			el.report(element, err.Error())
+			return Paint{}
+		}
+		value = parsed
+	}
+	value.A = uint8(math.Round(float64(value.A) * opacity))
+	if value.A == 0 {
+		return Paint{}
+	}
+	return Paint{Kind: KPaintColor, Color: value}
+}
+
+// gradientPaint
+// en: Returns the paint of a gradient with the opacity applied to the stops. A
+// gradient with one stop is a color; without stops, nothing is painted
+//
+// pt_br: Retorna a pintura de um gradiente com a opacidade aplicada às paradas. Um
+// gradiente com uma parada é uma cor; sem paradas, nada é pintado
+func gradientPaint(gradient *Gradient, opacity float64) Paint {
+	switch len(gradient.Stops) {
+	case 0:
+		return Paint{}
+	case 1:
+		value := gradient.Stops[0].Color
+		value.A = uint8(math.Round(float64(value.A) * opacity))
+		return Paint{Kind: KPaintColor, Color: value}
+	}
+	if opacity < 1 {
+		copied := *gradient
+		copied.Stops = make([]Stop, len(gradient.Stops))
+		for i, stop := range gradient.Stops {
+			stop.Color.A = uint8(math.Round(float64(stop.Color.A) * opacity))
+			copied.Stops[i] = stop
+		}
+		gradient = &copied
+	}
+	return Paint{Kind: KPaintGradient, Gradient: gradient}
+}
+
+// item
+// en: Returns an item of the element with the style of the state; visible is
+// false when nothing would be painted
+//
+// pt_br: Retorna um item do elemento com o estilo do estado; visible é false
+// quando nada seria pintado
+func (el *parser) item(element *node, kind ItemKind, current state) (item Item, visible bool) {
+	item = Item{
+		Kind:        kind,
+		Id:          element.attributes["id"],
+		Groups:      current.groups,
+		Element:     element.name,
+		Transform:   current.transform,
+		Fill:        el.paint(element, current.fill, current.fillOpacity, current),
+		StrokeWidth: current.strokeWidth,
+		Opacity:     current.opacity,
+		Font:        current.font,
+		Anchor:      current.anchor,
+	}
+	if current.strokeWidth > 0 {
+		item.Stroke = el.paint(element, current.stroke, current.strokeOpacity, current)
+	}
+	visible = current.visible && current.opacity > 0 && (item.Fill.Kind != KPaintNone || item.Stroke.Kind != KPaintNone)
+	return
+}
+
+// parseLength
+// en: Parses a length with the units of CSS; percentages refer to reference and
+// em to fontSize
+//
+// pt_br: Interpreta um comprimento com as unidades do CSS; porcentagens referem-se
+// a reference e em a fontSize
+func parseLength(text string, reference, fontSize float64) (value float64, err error) {
+	scanner := &pathScanner{data: strings.TrimSpace(text)}
+	if !scanner.hasNumber() {
+		return 0, fmt.Errorf("svgImport: invalid length %q", text)
+	}
+	if value, err = scanner.number(); err != nil {
+		return
+	}
+	unit := strings.ToLower(strings.TrimSpace(scanner.data[scanner.pos:]))
+	scale, found := map[string]float64{
+		"":   1,
+		"px": 1,
+		"pt": 4.0 / 3.0,
+		"pc": 16,
+		"in": 96,
+		"cm": 96 / 2.54,
+		"mm": 96 / 25.4,
+		"q":  96 / 101.6,
+		"em": fontSize,
+		"ex": fontSize / 2,
+		"%":  reference / 100,
+	}[unit]
+	if !found {
+		return 0, fmt.Errorf("svgImport: unknown unit in length %q", text)
+	}
+	return value * scale, nil
+}
+
+// parseNumbers
+// en: Parses a list of numbers separated by spaces or commas
+//
+// pt_br: Interpreta uma lista de números separados por espaços ou vírgulas
+func parseNumbers(text string) (values []float64, err error) {
+	scanner := &pathScanner{data: text}
+	values = make([]float64, 0)
+	for scanner.hasNumber() {
+		var value float64
+		if value, err = scanner.number(); err != nil {
+			return
+		}
+		values = append(values, value)
+	}
+	scanner.skip()
+	if scanner.pos < len(scanner.data) {
+		return values, fmt.Errorf("svgImport: invalid number list %q", text)
+	}
+	return
+}
+
+// isListSeparator
+// en: Separator of the values of x, y, dx, dy and rotate lists
+//
+// pt_br: Separador dos valores das listas x, y, dx, dy e rotate
+func isListSeparator(character rune) bool {
+	return character == ',' || character == ' ' || character == '\t' || character == '\n' || character == '\r'
+}
+
+// viewBoxTransform
+// en: Returns the transformation from the viewBox to a viewport of the size given,
+// following preserveAspectRatio; the default is "xMidYMid meet"
+//
+// pt_br: Retorna a transformação do viewBox para uma viewport do tamanho dado,
+// seguindo preserveAspectRatio; o padrão é "xMidYMid meet"
+func viewBoxTransform(viewBox [4]float64, width, height float64, aspect string) Matrix {
+	fields := strings.Fields(aspect)
+	if len(fields) != 0 && fields[0] == "defer" {
+		fields = fields[1:]
+	}
+	align := "xMidYMid"
+	slice := false
+	if len(fields) != 0 {
+		align = fields[0]
+	}
+	if len(fields) > 1 {
+		slice = fields[1] == "slice"
+	}
+
+	scaleX := width / viewBox[2]
+	scaleY := height / viewBox[3]
+	if align != "none" {
+		if slice {
+			scaleX = math.Max(scaleX, scaleY)
+		} else {
+			scaleX = math.Min(scaleX, scaleY)
+		}
+		scaleY = scaleX
+	}
+
+	translateX := -viewBox[0] * scaleX
+	translateY := -viewBox[1] * scaleY
+	if strings.Contains(align, "xMid") {
+		translateX += (width - viewBox[2]*scaleX) / 2
+	} else if strings.Contains(align, "xMax") {
+		translateX += width - viewBox[2]*scaleX
+	}
+	if strings.Contains(align, "YMid") {
+		translateY += (height - viewBox[3]*scaleY) / 2
+	} else if strings.Contains(align, "YMax") {
+		translateY += height - viewBox[3]*scaleY
+	}
+	return Matrix{scaleX, 0, 0, scaleY, translateX, translateY}
+}
+
+// formatNumber
+// en: Formats a number without trailing zeros, for the messages of issues
+//
+// pt_br: Formata um número sem zeros finais, para as mensagens dos problemas
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
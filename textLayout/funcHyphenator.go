@@ -0,0 +1,74 @@
+package textLayout
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Hyphenator
+// en: Returns the byte offsets, inside the word, where it can be broken with a
+// hyphen, in increasing order. The word never contains spaces
+//
+// pt_br: Retorna os deslocamentos em bytes, dentro da palavra, onde ela pode ser
+// quebrada com um hífen, em ordem crescente. A palavra nunca contém espaços
+type Hyphenator func(word string) []int
+
+// NewSyllableHyphenator
+// en: Returns an approximate hyphenator for latin languages: it breaks before a
+// consonant followed by a vowel, keeping at least minPrefix letters on the first
+// line and minSuffix letters on the second. For exact results supply a
+// Hyphenator based on a dictionary or on TeX patterns
+//
+// pt_br: Retorna um hifenizador aproximado para línguas latinas: ele quebra antes
+// de uma consoante seguida de vogal, mantendo pelo menos minPrefix letras na
+// primeira linha e minSuffix letras na segunda. Para resultados exatos forneça um
+// Hyphenator baseado em um dicionário ou em padrões TeX
+func NewSyllableHyphenator(minPrefix, minSuffix int) Hyphenator {
+	isVowel := func(character rune) bool {
+		return strings.ContainsRune("aeiouyáàâãäéèêëíìîïóòôõöúùûü", unicode.ToLower(character))
+	}
+	digraphs := []string{"ch", "sh", "th", "ph", "wh", "gh", "ck", "lh", "nh", "qu"}
+	isDigraph := func(first, second rune) bool {
+		pair := strings.ToLower(string([]rune{first, second}))
+		for _, digraph := range digraphs {
+			if pair == digraph {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(word string) []int {
+		runes := []rune(word)
+		offsets := make([]int, 0)
+		positions := make([]int, len(runes)+1)
+		for i, character := range runes {
+			positions[i+1] = positions[i] + utf8.RuneLen(character)
+			if !unicode.IsLetter(character) {
+				return offsets
+			}
+		}
+
+		last := -1
+		for i := 1; i+1 < len(runes); i += 1 {
+			if isVowel(runes[i]) || !isVowel(runes[i+1]) {
+				continue
+			}
+			// en: a consonant followed by a vowel starts a syllable, together with the
+			// previous consonant when both form a digraph, like "ch"
+			// pt_br: uma consoante seguida de vogal começa uma sílaba, junto com a
+			// consoante anterior quando as duas formam um dígrafo, como "ch"
+			start := i
+			if !isVowel(runes[i-1]) && isDigraph(runes[i-1], runes[i]) {
+				start = i - 1
+			}
+			if start < minPrefix || len(runes)-start < minSuffix || start == last || start == 0 {
+				continue
+			}
+			offsets = append(offsets, positions[start])
+			last = start
+		}
+		return offsets
+	}
+}
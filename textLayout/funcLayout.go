@@ -0,0 +1,531 @@
+package textLayout
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/bidi"
+)
+
+type breakKind int
+
+const (
+	kBreakSpace breakKind = iota
+	kBreakHyphen
+	kBreakEnd
+)
+
+// breakPoint
+// en: Position where a line can end; the next line starts at pos
+//
+// pt_br: Posição onde uma linha pode terminar; a próxima linha começa em pos
+type breakPoint struct {
+	pos  int
+	kind breakKind
+}
+
+// rawLine
+// en: Line found by the wrapping, before the positioning
+//
+// pt_br: Linha encontrada pela quebra, antes do posicionamento
+type rawLine struct {
+	start        int
+	end          int
+	suffix       string
+	hyphenated   bool
+	truncated    bool
+	paragraphEnd bool
+
+	// en: set when the paragraph needs the bidirectional algorithm
+	// pt_br: definido quando o parágrafo precisa do algoritmo bidirecional
+	paragraph      *bidi.Paragraph
+	paragraphStart int
+}
+
+type layouter struct {
+	measurer Measurer
+	options  Options
+	text     string
+	widths   map[string]float64
+	lines    []rawLine
+}
+
+// Layout
+// en: Breaks the text in lines and positions each character. New lines ("\n")
+// start paragraphs; lines are broken at spaces, after hyphens already in the text
+// and where the Hyphenator allows
+//
+//	measurer: Any IDraw, with the font already set, or a fontRegistry.Face
+//
+//	Note: the ascent and descent come from MeasureText("Mg"), so the line height
+//	follows the current font
+//
+// pt_br: Quebra o texto em linhas e posiciona cada caractere. Novas linhas ("\n")
+// iniciam parágrafos; linhas são quebradas em espaços, depois de hífens já
+// presentes no texto e onde o Hyphenator permitir
+//
+//	measurer: Qualquer IDraw, com a fonte já definida, ou uma fontRegistry.Face
+//
+//	Nota: o ascendente e o descendente vêm de MeasureText("Mg"), assim a altura
+//	da linha segue a fonte atual
+func Layout(measurer Measurer, text string, options Options) *Block {
+	if options.Ellipsis == "" {
+		options.Ellipsis = KDefaultEllipsis
+	}
+	if options.Hyphen == "" {
+		options.Hyphen = "-"
+	}
+	layout := &layouter{
+		measurer: measurer,
+		options:  options,
+		text:     text,
+		widths:   make(map[string]float64),
+	}
+
+	needsBidi := options.Direction == bidi.KDirectionRTL || bidi.HasRightToLeft(text)
+	start := 0
+	for {
+		end := strings.IndexByte(text[start:], '\n')
+		last := end == -1
+		if last {
+			end = len(text)
+		} else {
+			end += start
+		}
+		paragraphEnd := end
+		if paragraphEnd > start && text[paragraphEnd-1] == '\r' {
+			paragraphEnd -= 1
+		}
+
+		first := len(layout.lines)
+		layout.paragraph(start, paragraphEnd)
+		if needsBidi {
+			paragraph := bidi.NewParagraph(text[start:paragraphEnd], options.Direction)
+			for i := first; i < len(layout.lines); i += 1 {
+				layout.lines[i].paragraph, layout.lines[i].paragraphStart = paragraph, start
+			}
+		}
+		if last {
+			break
+		}
+		start = end + 1
+	}
+
+	truncated := layout.truncate()
+	return layout.position(truncated)
+}
+
+// width
+// en: Measures the text, keeping the results of the layout in cache
+//
+// pt_br: Mede o texto, mantendo os resultados da diagramação em cache
+func (el *layouter) width(text string) float64 {
+	if text == "" {
+		return 0
+	}
+	if width, found := el.widths[text]; found {
+		return width
+	}
+	measured := text
+	if el.options.Reorder {
+		measured, _ = bidi.Shape(text)
+	}
+	width := el.measurer.MeasureText(measured).Width
+	el.widths[text] = width
+	return width
+}
+
+// candidate
+// en: Returns the text of a line from start to pos, without trailing spaces
+//
+// pt_br: Retorna o texto de uma linha de start até pos, sem espaços finais
+func (el *layouter) candidate(start, pos int) (end int) {
+	return start + len(strings.TrimRightFunc(el.text[start:pos], unicode.IsSpace))
+}
+
+// paragraph
+// en: Breaks a paragraph with the greedy algorithm: each line takes as many words
+// as fit
+//
+// pt_br: Quebra um parágrafo com o algoritmo guloso: cada linha leva tantas
+// palavras quanto couberem
+func (el *layouter) paragraph(start, end int) {
+	maxWidth := el.options.MaxWidth
+	if maxWidth <= 0 {
+		el.lines = append(el.lines, rawLine{start: start, end: el.candidate(start, end), paragraphEnd: true})
+		return
+	}
+
+	breaks := el.breakPoints(start, end)
+	lineStart := start
+	last := -1
+	for i := 0; i < len(breaks); {
+		point := breaks[i]
+		if point.pos <= lineStart && point.kind != kBreakEnd {
+			i += 1
+			continue
+		}
+
+		lineEnd := el.candidate(lineStart, point.pos)
+		suffix := ""
+		if point.kind == kBreakHyphen {
+			suffix = el.options.Hyphen
+		}
+		if el.width(el.text[lineStart:lineEnd]+suffix) <= maxWidth {
+			if point.kind == kBreakEnd {
+				el.lines = append(el.lines, rawLine{start: lineStart, end: lineEnd, paragraphEnd: true})
+				return
+			}
+			last = i
+			i += 1
+			continue
+		}
+
+		if last != -1 {
+			good := breaks[last]
+			line := rawLine{start: lineStart, end: el.candidate(lineStart, good.pos)}
+			if good.kind == kBreakHyphen {
+				line.suffix, line.hyphenated = el.options.Hyphen, true
+			}
+			el.lines = append(el.lines, line)
+			lineStart, last = good.pos, -1
+			continue
+		}
+
+		// en: not even the first word fits
+		// pt_br: nem a primeira palavra cabe
+		if el.options.BreakWords {
+			cut := el.fitRunes(lineStart, lineEnd, maxWidth)
+			el.lines = append(el.lines, rawLine{start: lineStart, end: cut})
+			lineStart = cut
+			continue
+		}
+		if point.kind == kBreakEnd {
+			el.lines = append(el.lines, rawLine{start: lineStart, end: lineEnd, paragraphEnd: true})
+			return
+		}
+		line := rawLine{start: lineStart, end: lineEnd, suffix: suffix, hyphenated: suffix != ""}
+		el.lines = append(el.lines, line)
+		lineStart = point.pos
+		i += 1
+	}
+}
+
+// fitRunes
+// en: Returns the largest end, at a character boundary, with the text fitting in
+// the width; at least one character is kept
+//
+// pt_br: Retorna o maior fim, no limite de um caractere, com o texto cabendo na
+// largura; pelo menos um caractere é mantido
+func (el *layouter) fitRunes(start, end int, maxWidth float64) int {
+	_, size := utf8.DecodeRuneInString(el.text[start:])
+	cut := start + size
+	for pos := cut; pos < end; {
+		_, size = utf8.DecodeRuneInString(el.text[pos:])
+		if el.width(el.text[start:pos+size]) > maxWidth {
+			break
+		}
+		pos += size
+		cut = pos
+	}
+	return cut
+}
+
+// breakPoints
+// en: Returns the positions where the lines of the paragraph can end, in order,
+// finishing with the end of the paragraph
+//
+// pt_br: Retorna as posições onde as linhas do parágrafo podem terminar, em ordem,
+// terminando com o fim do parágrafo
+func (el *layouter) breakPoints(start, end int) []breakPoint {
+	breaks := make([]breakPoint, 0)
+	pos := start
+	for pos < end {
+		wordStart := pos
+		for pos < end {
+			character, size := utf8.DecodeRuneInString(el.text[pos:])
+			if unicode.IsSpace(character) {
+				break
+			}
+			pos += size
+			if character == '-' && pos < end {
+				if next, _ := utf8.DecodeRuneInString(el.text[pos:]); !unicode.IsSpace(next) {
+					breaks = append(breaks, breakPoint{pos: pos, kind: kBreakSpace})
+				}
+			}
+		}
+		wordEnd := pos
+
+		if el.options.Hyphenator != nil && wordEnd > wordStart {
+			for _, offset := range el.options.Hyphenator(el.text[wordStart:wordEnd]) {
+				if offset > 0 && offset < wordEnd-wordStart {
+					breaks = append(breaks, breakPoint{pos: wordStart + offset, kind: kBreakHyphen})
+				}
+			}
+		}
+
+		for pos < end {
+			character, size := utf8.DecodeRuneInString(el.text[pos:])
+			if !unicode.IsSpace(character) {
+				break
+			}
+			pos += size
+		}
+		if pos < end && pos > wordEnd {
+			breaks = append(breaks, breakPoint{pos: pos, kind: kBreakSpace})
+		}
+	}
+
+	// en: the hyphenation points of a word come after the hyphens already in it
+	// pt_br: os pontos de hifenização de uma palavra vêm depois dos hífens já nela
+	for i := 1; i < len(breaks); i += 1 {
+		for j := i; j > 0 && breaks[j].pos < breaks[j-1].pos; j -= 1 {
+			breaks[j], breaks[j-1] = breaks[j-1], breaks[j]
+		}
+	}
+	return append(breaks, breakPoint{pos: end, kind: kBreakEnd})
+}
+
+// truncate
+// en: Applies MaxLines, ending the last line with the ellipsis
+//
+// pt_br: Aplica MaxLines, terminando a última linha com as reticências
+func (el *layouter) truncate() bool {
+	if el.options.MaxLines <= 0 || len(el.lines) <= el.options.MaxLines {
+		return false
+	}
+	el.lines = el.lines[:el.options.MaxLines]
+	line := &el.lines[len(el.lines)-1]
+	line.suffix, line.hyphenated, line.truncated, line.paragraphEnd = el.options.Ellipsis, false, true, false
+
+	if el.options.MaxWidth > 0 {
+		for line.end > line.start && el.width(el.text[line.start:line.end]+line.suffix) > el.options.MaxWidth {
+			_, size := utf8.DecodeLastRuneInString(el.text[line.start:line.end])
+			line.end -= size
+			line.end = el.candidate(line.start, line.end)
+		}
+	}
+	return true
+}
+
+// position
+// en: Measures the characters of each line and applies alignment, justification
+// and line height
+//
+// pt_br: Mede os caracteres de cada linha e aplica alinhamento, justificação e
+// altura de linha
+func (el *layouter) position(truncated bool) *Block {
+	reference := el.measurer.MeasureText("Mg")
+	ascent, descent := reference.FontBoundingBoxAscent, reference.FontBoundingBoxDescent
+	if ascent+descent <= 0 {
+		ascent, descent = reference.ActualBoundingBoxAscent, reference.ActualBoundingBoxDescent
+	}
+	if ascent+descent <= 0 {
+		// en: measurer without vertical metrics; the em is estimated from the width
+		// pt_br: medidor sem medidas verticais; o em é estimado pela largura
+		em := reference.Width / 1.4
+		ascent, descent = 0.8*em, 0.2*em
+	}
+	lineHeight := el.options.LineHeight
+	if lineHeight <= 0 {
+		lineHeight = (ascent + descent) * KDefaultLineHeightFactor
+	}
+
+	block := &Block{
+		Lines:      make([]Line, 0, len(el.lines)),
+		LineHeight: lineHeight,
+		Ascent:     ascent,
+		Descent:    descent,
+		Truncated:  truncated,
+	}
+
+	for index, raw := range el.lines {
+		line := Line{
+			Text:       el.text[raw.start:raw.end] + raw.suffix,
+			Start:      raw.start,
+			End:        raw.end,
+			Baseline:   float64(index)*lineHeight + (lineHeight-ascent-descent)/2 + ascent,
+			Hyphenated: raw.hyphenated,
+			Truncated:  raw.truncated,
+		}
+		line.Width = el.width(line.Text)
+
+		spaces := strings.Count(line.Text, " ")
+		if el.options.Align == KAlignJustify && el.options.MaxWidth > 0 && !raw.paragraphEnd && !raw.truncated &&
+			spaces > 0 && line.Width < el.options.MaxWidth {
+			line.WordSpacing = (el.options.MaxWidth - line.Width) / float64(spaces)
+		}
+
+		if raw.paragraph != nil {
+			el.bidiGlyphs(&line, raw)
+			block.Visual = el.options.Reorder
+			line.Width += float64(spaces) * line.WordSpacing
+			if line.Width > block.Width {
+				block.Width = line.Width
+			}
+			block.Lines = append(block.Lines, line)
+			continue
+		}
+
+		spacesBefore := 0
+		for offset, character := range line.Text {
+			glyph := Glyph{
+				Rune:   character,
+				Offset: raw.start + offset,
+				X:      el.width(line.Text[:offset]) + float64(spacesBefore)*line.WordSpacing,
+			}
+			if offset >= raw.end-raw.start {
+				glyph.Offset = raw.end
+			}
+			if character == ' ' {
+				spacesBefore += 1
+			}
+			next := el.width(line.Text[:offset+utf8.RuneLen(character)]) + float64(spacesBefore)*line.WordSpacing
+			glyph.Width = next - glyph.X
+			line.Glyphs = append(line.Glyphs, glyph)
+		}
+		line.Width += float64(spaces) * line.WordSpacing
+
+		if line.Width > block.Width {
+			block.Width = line.Width
+		}
+		block.Lines = append(block.Lines, line)
+	}
+
+	box := block.Width
+	if el.options.MaxWidth > 0 {
+		box = el.options.MaxWidth
+	}
+	for i := range block.Lines {
+		line := &block.Lines[i]
+		align := el.options.Align
+		switch {
+		case align == KAlignStart && line.RightToLeft, align == KAlignEnd && !line.RightToLeft:
+			align = KAlignRight
+		case align == KAlignJustify && line.RightToLeft && line.WordSpacing == 0:
+			align = KAlignRight
+		}
+		switch align {
+		case KAlignCenter:
+			line.X = (box - line.Width) / 2
+		case KAlignRight:
+			line.X = box - line.Width
+		}
+		for j := range line.Glyphs {
+			line.Glyphs[j].X += line.X
+		}
+	}
+	if el.options.MaxWidth > 0 && el.options.Align != KAlignLeft && el.options.Align != KAlignJustify {
+		block.Width = box
+	}
+	block.Height = float64(len(block.Lines)) * lineHeight
+	return block
+}
+
+// bidiGlyphs
+// en: Positions the characters of a line with right to left text: the runs of the
+// line are placed in visual order and the characters of right to left runs are
+// placed from the right. With Options.Reorder the text of the line becomes the
+// shaped text in visual order
+//
+// pt_br: Posiciona os caracteres de uma linha com texto da direita para a
+// esquerda: os runs da linha são colocados em ordem visual e os caracteres de runs
+// da direita para a esquerda são colocados a partir da direita. Com
+// Options.Reorder o texto da linha passa a ser o texto com shaping em ordem visual
+func (el *layouter) bidiGlyphs(line *Line, raw rawLine) {
+	type piece struct {
+		start       int
+		text        string
+		rightToLeft bool
+		added       bool
+	}
+
+	line.RightToLeft = raw.paragraph.GetDirection() == bidi.KDirectionRTL
+	pieces := make([]piece, 0)
+	for _, run := range raw.paragraph.Runs(raw.start-raw.paragraphStart, raw.end-raw.paragraphStart) {
+		start := raw.paragraphStart + run.Start
+		pieces = append(pieces, piece{
+			start:       start,
+			text:        el.text[start : raw.paragraphStart+run.End],
+			rightToLeft: run.IsRightToLeft(),
+		})
+	}
+	if raw.suffix != "" {
+		suffix := piece{start: raw.end, text: raw.suffix, rightToLeft: line.RightToLeft, added: true}
+		if line.RightToLeft {
+			pieces = append([]piece{suffix}, pieces...)
+		} else {
+			pieces = append(pieces, suffix)
+		}
+	}
+
+	visual := make([]rune, 0, len(line.Text))
+	x := 0.0
+	for _, item := range pieces {
+		offsets := make([]int, 0, len(item.text))
+		for offset := range item.text {
+			offsets = append(offsets, offset)
+		}
+		runes, sources := []rune(item.text), make([]int, len(offsets))
+		for i := range sources {
+			sources[i] = i
+		}
+		if el.options.Reorder {
+			var shaped string
+			shaped, sources = bidi.Shape(item.text)
+			runes = []rune(shaped)
+		}
+
+		// en: advance of each prefix, in logical order, with the justification
+		// pt_br: avanço de cada prefixo, em ordem lógica, com a justificação
+		prefix := make([]float64, len(runes)+1)
+		spaces := 0
+		for i := range runes {
+			if runes[i] == ' ' {
+				spaces += 1
+			}
+			prefix[i+1] = el.width(string(runes[:i+1])) + float64(spaces)*line.WordSpacing
+		}
+		width := prefix[len(runes)]
+
+		for i, character := range runes {
+			glyph := Glyph{
+				Rune:        character,
+				Offset:      item.start + offsets[sources[i]],
+				X:           x + prefix[i],
+				Width:       prefix[i+1] - prefix[i],
+				RightToLeft: item.rightToLeft,
+			}
+			if item.added {
+				glyph.Offset = raw.end
+			}
+			if item.rightToLeft {
+				glyph.X = x + width - prefix[i+1]
+				if mirrored, found := bidi.Mirror(character); found && el.options.Reorder {
+					glyph.Rune = mirrored
+				}
+			}
+			line.Glyphs = append(line.Glyphs, glyph)
+		}
+		x += width
+
+		if item.rightToLeft {
+			for i := len(runes) - 1; i >= 0; i -= 1 {
+				character := runes[i]
+				if mirrored, found := bidi.Mirror(character); found {
+					character = mirrored
+				}
+				visual = append(visual, character)
+			}
+		} else {
+			visual = append(visual, runes...)
+		}
+	}
+
+	sort.SliceStable(line.Glyphs, func(i, j int) bool { return line.Glyphs[i].X < line.Glyphs[j].X })
+	if el.options.Reorder {
+		line.Text = string(visual)
+	}
+}
@@ -0,0 +1,301 @@
+package textLayout
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Glyph
+// en: Character of a laid out line
+//
+//	Rune: The character
+//	Offset: Byte offset of the character in the original text; characters added
+//	        by the layout, the hyphen and the ellipsis, have the offset of the
+//	        end of the line
+//	X: Start of the character, relative to the left of the block
+//	Width: Advance of the character, including the extra space of justification
+//	RightToLeft: The character belongs to a right to left run
+//
+// pt_br: Caractere de uma linha diagramada
+//
+//	Rune: O caractere
+//	Offset: Deslocamento em bytes do caractere no texto original; caracteres
+//	        adicionados pela diagramação, o hífen e as reticências, têm o
+//	        deslocamento do fim da linha
+//	X: Início do caractere, relativo à esquerda do bloco
+//	Width: Avanço do caractere, incluindo o espaço extra da justificação
+//	RightToLeft: O caractere pertence a um run da direita para a esquerda
+type Glyph struct {
+	Rune        rune
+	Offset      int
+	X           float64
+	Width       float64
+	RightToLeft bool
+}
+
+// Line
+// en: Line of a laid out block
+//
+//	Text: Text drawn, with the hyphen or ellipsis added by the layout
+//	Start, End: Byte offsets of the line in the original text
+//	X: Start of the line, relative to the left of the block
+//	Baseline: Alphabetic baseline, relative to the top of the block
+//	Width: Width of the text, without the trailing spaces
+//	WordSpacing: Extra space added to each space by justification
+//	Hyphenated: The line ends inside a word
+//	Truncated: The line ends with the ellipsis
+//	RightToLeft: The paragraph of the line is right to left
+//	Glyphs: Characters of Text, from left to right
+//
+// pt_br: Linha de um bloco diagramado
+//
+//	Text: Texto desenhado, com o hífen ou as reticências adicionados pela
+//	      diagramação
+//	Start, End: Deslocamentos em bytes da linha no texto original
+//	X: Início da linha, relativo à esquerda do bloco
+//	Baseline: Linha de base alfabética, relativa ao topo do bloco
+//	Width: Largura do texto, sem os espaços finais
+//	WordSpacing: Espaço extra adicionado a cada espaço pela justificação
+//	Hyphenated: A linha termina dentro de uma palavra
+//	Truncated: A linha termina com as reticências
+//	RightToLeft: O parágrafo da linha é da direita para a esquerda
+//	Glyphs: Caracteres de Text, da esquerda para a direita
+type Line struct {
+	Text        string
+	Start       int
+	End         int
+	X           float64
+	Baseline    float64
+	Width       float64
+	WordSpacing float64
+	Hyphenated  bool
+	Truncated   bool
+	RightToLeft bool
+	Glyphs      []Glyph
+}
+
+// Block
+// en: Result of Layout(): lines ready to draw, measure and hit test. Visual is true
+// when Options.Reorder produced lines in visual order
+//
+// pt_br: Resultado de Layout(): linhas prontas para desenhar, medir e testar
+// posições. Visual é true quando Options.Reorder produziu linhas em ordem visual
+type Block struct {
+	Lines      []Line
+	Width      float64
+	Height     float64
+	LineHeight float64
+	Ascent     float64
+	Descent    float64
+	Truncated  bool
+	Visual     bool
+}
+
+// Draw
+// en: Draws each line with FillText()
+//
+//	x, y: Top left corner of the block; the canvas must use the alphabetic
+//	      textBaseline and the left textAlign, the defaults
+//
+// pt_br: Desenha cada linha com FillText()
+//
+//	x, y: Canto superior esquerdo do bloco; o canvas deve usar textBaseline
+//	      alphabetic e textAlign left, os padrões
+func (el *Block) Draw(canvas Canvas, x, y float64) {
+	el.DrawFunc(x, y, func(text string, textX, textY float64) {
+		canvas.FillText(text, int(math.Round(textX)), int(math.Round(textY)))
+	})
+}
+
+// DrawFunc
+// en: Calls draw for each run of text with the position of its baseline. Justified
+// lines are sent word by word; the others line by line. Useful with StrokeText(),
+// shadows or fontRegistry.Face
+//
+// pt_br: Chama draw para cada trecho de texto com a posição da sua linha de base.
+// Linhas justificadas são enviadas palavra por palavra; as outras linha por linha.
+// Útil com StrokeText(), sombras ou fontRegistry.Face
+func (el *Block) DrawFunc(x, y float64, draw func(text string, x, y float64)) {
+	for _, line := range el.Lines {
+		if line.WordSpacing == 0 {
+			draw(line.Text, x+line.X, y+line.Baseline)
+			continue
+		}
+
+		start := 0
+		for i, glyph := range line.Glyphs {
+			if glyph.Rune != ' ' {
+				continue
+			}
+			if i > start {
+				draw(el.glyphText(line.Glyphs[start:i]), x+line.Glyphs[start].X, y+line.Baseline)
+			}
+			start = i + 1
+		}
+		if start < len(line.Glyphs) {
+			draw(el.glyphText(line.Glyphs[start:]), x+line.Glyphs[start].X, y+line.Baseline)
+		}
+	}
+}
+
+// HitTest
+// en: Returns the byte offset, in the original text, of the caret position closest
+// to the point, and the index of the line
+//
+//	x, y: Point relative to the top left corner of the block
+//
+// pt_br: Retorna o deslocamento em bytes, no texto original, da posição de cursor
+// mais próxima do ponto, e o índice da linha
+//
+//	x, y: Ponto relativo ao canto superior esquerdo do bloco
+func (el *Block) HitTest(x, y float64) (offset, lineIndex int) {
+	if len(el.Lines) == 0 {
+		return 0, -1
+	}
+	lineIndex = int(math.Floor(y / el.LineHeight))
+	if lineIndex < 0 {
+		lineIndex = 0
+	}
+	if lineIndex >= len(el.Lines) {
+		lineIndex = len(el.Lines) - 1
+	}
+
+	line := el.Lines[lineIndex]
+	for _, glyph := range line.Glyphs {
+		if x >= glyph.X+glyph.Width/2 {
+			continue
+		}
+		if glyph.RightToLeft {
+			// en: the left half of a right to left character is after it
+			// pt_br: a metade esquerda de um caractere da direita para a esquerda
+			// fica depois dele
+			return line.nextOffset(glyph.Offset), lineIndex
+		}
+		return glyph.Offset, lineIndex
+	}
+	if line.RightToLeft && len(line.Glyphs) != 0 {
+		last := line.Glyphs[len(line.Glyphs)-1]
+		if last.RightToLeft {
+			return last.Offset, lineIndex
+		}
+	}
+	return line.End, lineIndex
+}
+
+// GlyphAt
+// en: Returns the character under the point; found is false outside of the text
+//
+// pt_br: Retorna o caractere sob o ponto; found é false fora do texto
+func (el *Block) GlyphAt(x, y float64) (glyph Glyph, lineIndex int, found bool) {
+	lineIndex = int(math.Floor(y / el.LineHeight))
+	if y < 0 || lineIndex >= len(el.Lines) {
+		return Glyph{}, -1, false
+	}
+	for _, candidate := range el.Lines[lineIndex].Glyphs {
+		if x >= candidate.X && x < candidate.X+candidate.Width {
+			return candidate, lineIndex, true
+		}
+	}
+	return Glyph{}, lineIndex, false
+}
+
+// CaretPosition
+// en: Returns the position of the caret before the byte offset of the original
+// text: x and the top of the line, relative to the block, and the line height
+//
+// pt_br: Retorna a posição do cursor antes do deslocamento em bytes do texto
+// original: x e o topo da linha, relativos ao bloco, e a altura da linha
+func (el *Block) CaretPosition(offset int) (x, top, height float64) {
+	for index, line := range el.Lines {
+		last := index == len(el.Lines)-1
+		if offset > line.End && !last {
+			continue
+		}
+		if offset < line.Start && index > 0 {
+			continue
+		}
+		top = float64(index) * el.LineHeight
+		found := false
+		var caret Glyph
+		for _, glyph := range line.Glyphs {
+			if glyph.Offset >= offset && glyph.Offset < line.End && (!found || glyph.Offset < caret.Offset) {
+				caret, found = glyph, true
+			}
+		}
+		if found && caret.RightToLeft {
+			return caret.X + caret.Width, top, el.LineHeight
+		}
+		if found {
+			return caret.X, top, el.LineHeight
+		}
+		if line.RightToLeft {
+			return line.X, top, el.LineHeight
+		}
+		return line.X + line.Width, top, el.LineHeight
+	}
+	return 0, 0, el.LineHeight
+}
+
+// GlyphBounds
+// en: Returns the box of the character at the byte offset of the original text,
+// relative to the block; found is false when the character is not visible
+//
+// pt_br: Retorna a caixa do caractere no deslocamento em bytes do texto original,
+// relativa ao bloco; found é false quando o caractere não está visível
+func (el *Block) GlyphBounds(offset int) (x, y, width, height float64, found bool) {
+	for index, line := range el.Lines {
+		for _, glyph := range line.Glyphs {
+			if glyph.Offset == offset && offset < line.End {
+				return glyph.X, float64(index) * el.LineHeight, glyph.Width, el.LineHeight, true
+			}
+		}
+	}
+	return 0, 0, 0, 0, false
+}
+
+// String
+// en: Returns the lines separated by new lines
+//
+// pt_br: Retorna as linhas separadas por novas linhas
+func (el *Block) String() string {
+	lines := make([]string, len(el.Lines))
+	for i, line := range el.Lines {
+		lines[i] = line.Text
+	}
+	return strings.Join(lines, "\n")
+}
+
+// glyphText
+// en: Returns the text of the glyphs; in logical order, for platforms that reorder
+// the text, unless the block is already visual
+//
+// pt_br: Retorna o texto dos glifos; em ordem lógica, para plataformas que
+// reordenam o texto, a não ser que o bloco já seja visual
+func (el *Block) glyphText(glyphs []Glyph) string {
+	if !el.Visual {
+		sorted := make([]Glyph, len(glyphs))
+		copy(sorted, glyphs)
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Offset < sorted[j].Offset })
+		glyphs = sorted
+	}
+	runes := make([]rune, len(glyphs))
+	for i, glyph := range glyphs {
+		runes[i] = glyph.Rune
+	}
+	return string(runes)
+}
+
+// nextOffset
+// en: Returns the smallest offset of the line after the offset
+//
+// pt_br: Retorna o menor deslocamento da linha depois do deslocamento
+func (el Line) nextOffset(offset int) int {
+	next := el.End
+	for _, glyph := range el.Glyphs {
+		if glyph.Offset > offset && glyph.Offset < next {
+			next = glyph.Offset
+		}
+	}
+	return next
+}
@@ -0,0 +1,128 @@
+package textLayout
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/bidi"
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+)
+
+// Measurer
+// en: Measures text with the current font; implemented by IDraw and by
+// fontRegistry.Face
+//
+// pt_br: Mede texto com a fonte atual; implementado por IDraw e por
+// fontRegistry.Face
+type Measurer interface {
+	MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics
+}
+
+// Canvas
+// en: Measures and draws text; implemented by IDraw
+//
+// pt_br: Mede e desenha texto; implementado por IDraw
+type Canvas interface {
+	Measurer
+	FillText(text string, x, y int, maxWidth ...int)
+}
+
+// Align
+// en: Horizontal alignment of the lines
+//
+// pt_br: Alinhamento horizontal das linhas
+type Align int
+
+const (
+	KAlignLeft Align = iota
+	KAlignCenter
+	KAlignRight
+
+	// KAlignJustify
+	// en: Spreads the words to fill the width; the last line of each paragraph is
+	// aligned to the left
+	//
+	// pt_br: Espalha as palavras para preencher a largura; a última linha de cada
+	// parágrafo é alinhada à esquerda
+	KAlignJustify
+
+	// KAlignStart
+	// en: Left in left to right paragraphs and right in right to left paragraphs
+	//
+	// pt_br: Esquerda em parágrafos da esquerda para a direita e direita em
+	// parágrafos da direita para a esquerda
+	KAlignStart
+
+	// KAlignEnd
+	// en: Right in left to right paragraphs and left in right to left paragraphs
+	//
+	// pt_br: Direita em parágrafos da esquerda para a direita e esquerda em
+	// parágrafos da direita para a esquerda
+	KAlignEnd
+)
+
+// KDefaultEllipsis
+// en: Text added to the last line when the text is cut by MaxLines
+//
+// pt_br: Texto adicionado à última linha quando o texto é cortado por MaxLines
+const KDefaultEllipsis = "…"
+
+// KDefaultLineHeightFactor
+// en: Line height, relative to ascent plus descent, used when
+// Options.LineHeight is zero
+//
+// pt_br: Altura de linha, relativa a ascendente mais descendente, usada quando
+// Options.LineHeight é zero
+const KDefaultLineHeightFactor = 1.2
+
+// Options
+// en: Configuration of Layout()
+//
+//	MaxWidth: Width of the block, in pixels; 0 disables the wrapping
+//	LineHeight: Distance between two baselines, in pixels; 0 uses
+//	            KDefaultLineHeightFactor
+//	Align: Horizontal alignment
+//	MaxLines: Maximum number of lines; 0 is unlimited. The last line ends with
+//	          Ellipsis when the text is cut
+//	Ellipsis: Text added to a cut line; empty uses KDefaultEllipsis
+//	Hyphenator: Optional; returns where a word can be broken
+//	Hyphen: Text added at the end of a line broken inside a word; empty uses "-"
+//	BreakWords: Breaks words wider than MaxWidth at any character, instead of
+//	            letting them overflow
+//	Direction: Base direction of the paragraphs. Paragraphs with right to left
+//	           text get glyph positions in visual order; use the same direction
+//	           in IDraw.SetDirection()
+//	Reorder: Shapes and reorders each line with the bidi package, for measurers
+//	         that draw the text as given, like bitmapFont.Writer. Leave false with
+//	         IDraw and fontRegistry.Face, which reorder the text by themselves
+//
+// pt_br: Configuração de Layout()
+//
+//	MaxWidth: Largura do bloco, em pixels; 0 desabilita a quebra de linhas
+//	LineHeight: Distância entre duas linhas de base, em pixels; 0 usa
+//	            KDefaultLineHeightFactor
+//	Align: Alinhamento horizontal
+//	MaxLines: Número máximo de linhas; 0 é ilimitado. A última linha termina com
+//	          Ellipsis quando o texto é cortado
+//	Ellipsis: Texto adicionado a uma linha cortada; vazio usa KDefaultEllipsis
+//	Hyphenator: Opcional; retorna onde uma palavra pode ser quebrada
+//	Hyphen: Texto adicionado ao fim de uma linha quebrada dentro de uma palavra;
+//	        vazio usa "-"
+//	BreakWords: Quebra palavras mais largas do que MaxWidth em qualquer
+//	            caractere, em vez de deixá-las transbordar
+//	Direction: Direção base dos parágrafos. Parágrafos com texto da direita para
+//	           a esquerda recebem posições de glifos em ordem visual; use a mesma
+//	           direção em IDraw.SetDirection()
+//	Reorder: Aplica shaping e reordena cada linha com o pacote bidi, para
+//	         medidores que desenham o texto como recebido, como
+//	         bitmapFont.Writer. Deixe false com IDraw e fontRegistry.Face, que
+//	         reordenam o texto por conta própria
+type Options struct {
+	MaxWidth   float64
+	LineHeight float64
+	Align      Align
+	MaxLines   int
+	Ellipsis   string
+	Hyphenator Hyphenator
+	Hyphen     string
+	BreakWords bool
+	Direction  bidi.Direction
+	Reorder    bool
+}
@@ -0,0 +1,169 @@
+package textOnPath
+
+import (
+	"math"
+	"unicode"
+)
+
+// Glyph
+// en: Glyph placed on the path. (X, Y) is the start of the glyph on its baseline
+// and Angle is the rotation, in radians
+//
+// pt_br: Glifo colocado no caminho. (X, Y) é o início do glifo na sua linha de base
+// e Angle é a rotação, em radianos
+type Glyph struct {
+	Text  string
+	X     float64
+	Y     float64
+	Angle float64
+	Width float64
+}
+
+// Layout
+// en: Places each glyph of the text on the path, measuring the glyphs with
+// MeasureText() and keeping the kerning of the font. Glyphs that fall outside the
+// path are left out, like in SVG
+//
+// pt_br: Coloca cada glifo do texto no caminho, medindo os glifos com
+// MeasureText() e mantendo o kerning da fonte. Glifos que caem fora do caminho
+// são deixados de fora, como no SVG
+func Layout(measurer Measurer, path *Path, text string, options Options) []Glyph {
+	if options.Side == KSideRight {
+		path = path.Reverse()
+	}
+
+	clusters := split(text)
+	advances := make([]float64, len(clusters))
+	total := 0.0
+	prefix := ""
+	previous := 0.0
+	for i, cluster := range clusters {
+		prefix += cluster
+		width := measurer.MeasureText(prefix).Width
+		advances[i] = width - previous
+		previous = width
+		total += advances[i]
+		if i != 0 {
+			total += options.LetterSpacing
+		}
+	}
+
+	offset := options.Offset
+	if options.Relative {
+		offset *= path.Length()
+	}
+	switch options.Align {
+	case KAlignCenter:
+		offset -= total / 2
+	case KAlignEnd:
+		offset -= total
+	}
+
+	glyphs := make([]Glyph, 0, len(clusters))
+	for i, cluster := range clusters {
+		start, end := offset, offset+advances[i]
+		offset = end + options.LetterSpacing
+
+		x0, y0, _, okStart := path.PointAt(start)
+		x1, y1, _, okEnd := path.PointAt(end)
+		if !okStart || !okEnd {
+			continue
+		}
+
+		// en: the chord between the ends of the glyph follows curves better than the
+		// tangent at a single point
+		// pt_br: a corda entre as pontas do glifo acompanha curvas melhor que a
+		// tangente em um único ponto
+		angle := math.Atan2(y1-y0, x1-x0)
+		if x0 == x1 && y0 == y1 {
+			_, _, angle, _ = path.PointAt(start)
+		}
+
+		// en: the glyph is centred on the middle of the chord
+		// pt_br: o glifo é centralizado no meio da corda
+		cx, cy := (x0+x1)/2, (y0+y1)/2
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		cx += sin * options.BaselineShift
+		cy -= cos * options.BaselineShift
+		glyphs = append(glyphs, Glyph{
+			Text:  cluster,
+			X:     cx - cos*advances[i]/2,
+			Y:     cy - sin*advances[i]/2,
+			Angle: angle,
+			Width: advances[i],
+		})
+	}
+	return glyphs
+}
+
+// Draw
+// en: Lays the text out on the path and draws it with the current font and styles,
+// returning the glyphs for hit testing. The current transform is kept and
+// combined with the rotation of each glyph
+//
+// pt_br: Distribui o texto no caminho e o desenha com a fonte e os estilos atuais,
+// retornando os glifos para testes de clique. A transformação atual é mantida e
+// combinada com a rotação de cada glifo
+func Draw(platform Platform, path *Path, text string, options Options) []Glyph {
+	glyphs := Layout(platform, path, text, options)
+	DrawGlyphs(platform, glyphs, options.Mode)
+	return glyphs
+}
+
+// DrawGlyphs
+// en: Draws glyphs returned by Layout()
+//
+// pt_br: Desenha glifos retornados por Layout()
+func DrawGlyphs(platform Platform, glyphs []Glyph, mode Mode) {
+	platform.Save()
+	defer platform.Restore()
+
+	base := platform.GetTransform()
+	for _, glyph := range glyphs {
+		if isBlank(glyph.Text) {
+			continue
+		}
+		cos, sin := math.Cos(glyph.Angle), math.Sin(glyph.Angle)
+		platform.SetTransform(
+			base[0]*cos+base[2]*sin,
+			base[1]*cos+base[3]*sin,
+			-base[0]*sin+base[2]*cos,
+			-base[1]*sin+base[3]*cos,
+			base[0]*glyph.X+base[2]*glyph.Y+base[4],
+			base[1]*glyph.X+base[3]*glyph.Y+base[5],
+		)
+		if mode == KModeFill || mode == KModeFillAndStroke {
+			platform.FillText(glyph.Text, 0, 0)
+		}
+		if mode == KModeStroke || mode == KModeFillAndStroke {
+			platform.StrokeText(glyph.Text, 0, 0)
+		}
+	}
+}
+
+// split
+// en: Splits the text in clusters, keeping combining marks with their base
+// character
+//
+// pt_br: Divide o texto em grupos, mantendo as marcas combinantes com o seu
+// caractere base
+func split(text string) []string {
+	clusters := make([]string, 0, len(text))
+	for _, character := range text {
+		if len(clusters) != 0 && (unicode.Is(unicode.Mn, character) || unicode.Is(unicode.Me, character) || character == '\u200d') {
+			clusters[len(clusters)-1] += string(character)
+			continue
+		}
+		clusters = append(clusters, string(character))
+	}
+	return clusters
+}
+
+func isBlank(text string) bool {
+	for _, character := range text {
+		if !unicode.IsSpace(character) {
+			return false
+		}
+	}
+	return true
+}
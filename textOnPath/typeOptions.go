@@ -0,0 +1,110 @@
+package textOnPath
+
+import (
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+)
+
+// Measurer
+// en: Measures text with the current font; implemented by IDraw
+//
+// pt_br: Mede texto com a fonte atual; implementado por IDraw
+type Measurer interface {
+	MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics
+}
+
+// Platform
+// en: Part of IDraw used to draw the glyphs
+//
+// pt_br: Parte de IDraw usada para desenhar os glifos
+type Platform interface {
+	Measurer
+	FillText(text string, x, y int, maxWidth ...int)
+	StrokeText(text string, x, y int, maxWidth ...int)
+	Save()
+	Restore()
+	GetTransform() [6]float64
+	SetTransform(a, b, c, d, e, f float64)
+}
+
+// Align
+// en: Which part of the text is placed at Options.Offset
+//
+// pt_br: Qual parte do texto é colocada em Options.Offset
+type Align int
+
+const (
+	KAlignStart Align = iota
+	KAlignCenter
+	KAlignEnd
+)
+
+// Side
+// en: Side of the path where the glyphs stand
+//
+// pt_br: Lado do caminho onde os glifos ficam
+type Side int
+
+const (
+	// KSideLeft
+	// en: The text follows the direction of the path, with the top of the glyphs
+	// on the left of the direction; on a clockwise arc the glyphs stand outside
+	// the circle
+	//
+	// pt_br: O texto segue o sentido do caminho, com o topo dos glifos à esquerda
+	// do sentido; em um arco no sentido horário os glifos ficam fora do círculo
+	KSideLeft Side = iota
+
+	// KSideRight
+	// en: The text follows the path in the opposite direction, so the glyphs stand
+	// on the other side; used for the labels at the bottom of gauges
+	//
+	// pt_br: O texto segue o caminho no sentido oposto, então os glifos ficam do
+	// outro lado; usado para os rótulos na parte de baixo dos mostradores
+	KSideRight
+)
+
+// Mode
+// en: How the glyphs are painted
+//
+// pt_br: Como os glifos são pintados
+type Mode int
+
+const (
+	KModeFill Mode = iota
+	KModeStroke
+	KModeFillAndStroke
+)
+
+// Options
+// en: Configuration of Layout() and Draw()
+//
+//	Offset: Distance along the path where the text is anchored, in pixels, or a
+//	        fraction of the length when Relative is true
+//	Relative: Offset is a fraction of the path length; 0.5 is the middle
+//	Align: Which part of the text is placed at Offset
+//	Side: Side of the path where the glyphs stand
+//	BaselineShift: Distance between the path and the baseline; positive values
+//	               move the glyphs away from the path, towards their top
+//	LetterSpacing: Extra space between glyphs, in pixels
+//	Mode: How the glyphs are painted
+//
+// pt_br: Configuração de Layout() e Draw()
+//
+//	Offset: Distância ao longo do caminho onde o texto é ancorado, em pixels, ou
+//	        uma fração do comprimento quando Relative é true
+//	Relative: Offset é uma fração do comprimento do caminho; 0.5 é o meio
+//	Align: Qual parte do texto é colocada em Offset
+//	Side: Lado do caminho onde os glifos ficam
+//	BaselineShift: Distância entre o caminho e a linha de base; valores positivos
+//	               afastam os glifos do caminho, em direção ao seu topo
+//	LetterSpacing: Espaço extra entre glifos, em pixels
+//	Mode: Como os glifos são pintados
+type Options struct {
+	Offset        float64
+	Relative      bool
+	Align         Align
+	Side          Side
+	BaselineShift float64
+	LetterSpacing float64
+	Mode          Mode
+}
@@ -0,0 +1,247 @@
+package textOnPath
+
+import (
+	"math"
+)
+
+// KFlatness
+// en: Maximum distance, in pixels, between a curve and the polyline that replaces
+// it
+//
+// pt_br: Distância máxima, em pixels, entre uma curva e a polilinha que a
+// substitui
+const KFlatness = 0.25
+
+type vertex struct {
+	x        float64
+	y        float64
+	distance float64
+}
+
+// Path
+// en: Path followed by the text, built with the same commands of IDraw. Curves and
+// arcs are converted to polylines and the length of each point is stored, so any
+// distance along the path can be found quickly
+//
+// pt_br: Caminho seguido pelo texto, construído com os mesmos comandos de IDraw.
+// Curvas e arcos são convertidos em polilinhas e o comprimento de cada ponto é
+// guardado, para que qualquer distância ao longo do caminho seja encontrada
+// rapidamente
+type Path struct {
+	subpaths [][]vertex
+	length   float64
+	startX   float64
+	startY   float64
+}
+
+// NewPath
+// en: Returns an empty path
+//
+// pt_br: Retorna um caminho vazio
+func NewPath() *Path {
+	return &Path{subpaths: make([][]vertex, 0)}
+}
+
+// MoveTo
+// en: Starts a new subpath at (x, y). The distance along the path does not count
+// the jump between subpaths
+//
+// pt_br: Começa um novo subcaminho em (x, y). A distância ao longo do caminho não
+// conta o salto entre subcaminhos
+func (el *Path) MoveTo(x, y float64) *Path {
+	el.subpaths = append(el.subpaths, []vertex{{x: x, y: y, distance: el.length}})
+	el.startX, el.startY = x, y
+	return el
+}
+
+// LineTo
+// en: Adds a straight line from the current point to (x, y)
+//
+// pt_br: Adiciona uma linha reta do ponto atual até (x, y)
+func (el *Path) LineTo(x, y float64) *Path {
+	if len(el.subpaths) == 0 {
+		return el.MoveTo(x, y)
+	}
+	last := &el.subpaths[len(el.subpaths)-1]
+	previous := (*last)[len(*last)-1]
+	step := math.Hypot(x-previous.x, y-previous.y)
+	if step == 0 {
+		return el
+	}
+	el.length += step
+	*last = append(*last, vertex{x: x, y: y, distance: el.length})
+	return el
+}
+
+// Arc
+// en: Adds a circular arc, like the arc() method of the canvas. When the path is
+// not empty, a line joins the current point to the start of the arc
+//
+//	x, y: Center of the circle
+//	radius: Radius of the circle
+//	startAngle, endAngle: Angles in radians, measured clockwise from the x axis
+//	counterclockwise: Direction of the arc
+//
+// pt_br: Adiciona um arco circular, como o método arc() do canvas. Quando o caminho
+// não está vazio, uma linha liga o ponto atual ao início do arco
+//
+//	x, y: Centro do círculo
+//	radius: Raio do círculo
+//	startAngle, endAngle: Ângulos em radianos, medidos no sentido horário a partir
+//	                      do eixo x
+//	counterclockwise: Sentido do arco
+func (el *Path) Arc(x, y, radius, startAngle, endAngle float64, counterclockwise bool) *Path {
+	sweep := endAngle - startAngle
+	switch {
+	case !counterclockwise && sweep >= 2*math.Pi:
+		sweep = 2 * math.Pi
+	case counterclockwise && sweep <= -2*math.Pi:
+		sweep = -2 * math.Pi
+	case counterclockwise:
+		sweep = -positiveMod(-sweep, 2*math.Pi)
+	default:
+		sweep = positiveMod(sweep, 2*math.Pi)
+	}
+
+	startX, startY := x+radius*math.Cos(startAngle), y+radius*math.Sin(startAngle)
+	if len(el.subpaths) == 0 {
+		el.MoveTo(startX, startY)
+	} else {
+		el.LineTo(startX, startY)
+	}
+
+	steps := segments(math.Abs(sweep) * radius)
+	if radius > KFlatness {
+		// en: the sagitta of each chord must stay below KFlatness
+		// pt_br: a flecha de cada corda deve ficar abaixo de KFlatness
+		maxAngle := 2 * math.Acos(1-KFlatness/radius)
+		steps = int(math.Max(float64(steps), math.Ceil(math.Abs(sweep)/maxAngle)))
+	}
+	for i := 1; i <= steps; i += 1 {
+		angle := startAngle + sweep*float64(i)/float64(steps)
+		el.LineTo(x+radius*math.Cos(angle), y+radius*math.Sin(angle))
+	}
+	return el
+}
+
+// QuadraticCurveTo
+// en: Adds a quadratic Bézier curve from the current point to (x, y)
+//
+// pt_br: Adiciona uma curva de Bézier quadrática do ponto atual até (x, y)
+func (el *Path) QuadraticCurveTo(cpx, cpy, x, y float64) *Path {
+	x0, y0 := el.current()
+	steps := segments(math.Hypot(cpx-x0, cpy-y0) + math.Hypot(x-cpx, y-cpy))
+	for i := 1; i <= steps; i += 1 {
+		t := float64(i) / float64(steps)
+		u := 1 - t
+		el.LineTo(u*u*x0+2*u*t*cpx+t*t*x, u*u*y0+2*u*t*cpy+t*t*y)
+	}
+	return el
+}
+
+// BezierCurveTo
+// en: Adds a cubic Bézier curve from the current point to (x, y)
+//
+// pt_br: Adiciona uma curva de Bézier cúbica do ponto atual até (x, y)
+func (el *Path) BezierCurveTo(cp1x, cp1y, cp2x, cp2y, x, y float64) *Path {
+	x0, y0 := el.current()
+	steps := segments(math.Hypot(cp1x-x0, cp1y-y0) + math.Hypot(cp2x-cp1x, cp2y-cp1y) + math.Hypot(x-cp2x, y-cp2y))
+	for i := 1; i <= steps; i += 1 {
+		t := float64(i) / float64(steps)
+		u := 1 - t
+		el.LineTo(
+			u*u*u*x0+3*u*u*t*cp1x+3*u*t*t*cp2x+t*t*t*x,
+			u*u*u*y0+3*u*u*t*cp1y+3*u*t*t*cp2y+t*t*t*y,
+		)
+	}
+	return el
+}
+
+// ClosePath
+// en: Adds a line from the current point to the start of the subpath
+//
+// pt_br: Adiciona uma linha do ponto atual até o início do subcaminho
+func (el *Path) ClosePath() *Path {
+	return el.LineTo(el.startX, el.startY)
+}
+
+// Length
+// en: Returns the length of the path, in pixels
+//
+// pt_br: Retorna o comprimento do caminho, em pixels
+func (el *Path) Length() float64 {
+	return el.length
+}
+
+// PointAt
+// en: Returns the point at the distance along the path and the angle of the path
+// at that point, in radians. Returns false when the distance is outside the path
+//
+// pt_br: Retorna o ponto na distância ao longo do caminho e o ângulo do caminho
+// nesse ponto, em radianos. Retorna false quando a distância está fora do caminho
+func (el *Path) PointAt(distance float64) (x, y, angle float64, ok bool) {
+	if distance < 0 || distance > el.length {
+		return
+	}
+	for _, subpath := range el.subpaths {
+		if len(subpath) < 2 || distance > subpath[len(subpath)-1].distance {
+			continue
+		}
+		// en: first vertex at or after the distance
+		// pt_br: primeiro vértice na distância ou depois dela
+		low, high := 1, len(subpath)-1
+		for low < high {
+			middle := (low + high) / 2
+			if subpath[middle].distance < distance {
+				low = middle + 1
+			} else {
+				high = middle
+			}
+		}
+		a, b := subpath[low-1], subpath[low]
+		t := (distance - a.distance) / (b.distance - a.distance)
+		return a.x + (b.x-a.x)*t, a.y + (b.y-a.y)*t, math.Atan2(b.y-a.y, b.x-a.x), true
+	}
+	return
+}
+
+// Reverse
+// en: Returns a copy of the path in the opposite direction
+//
+// pt_br: Retorna uma cópia do caminho no sentido oposto
+func (el *Path) Reverse() *Path {
+	reversed := NewPath()
+	for i := len(el.subpaths) - 1; i >= 0; i -= 1 {
+		subpath := el.subpaths[i]
+		for j := len(subpath) - 1; j >= 0; j -= 1 {
+			if j == len(subpath)-1 {
+				reversed.MoveTo(subpath[j].x, subpath[j].y)
+			} else {
+				reversed.LineTo(subpath[j].x, subpath[j].y)
+			}
+		}
+	}
+	return reversed
+}
+
+func (el *Path) current() (x, y float64) {
+	if len(el.subpaths) == 0 {
+		el.MoveTo(0, 0)
+	}
+	last := el.subpaths[len(el.subpaths)-1]
+	return last[len(last)-1].x, last[len(last)-1].y
+}
+
+// segments
+// en: Returns the number of lines used for a curve, from the length of its control
+// polygon
+//
+// pt_br: Retorna o número de linhas usadas para uma curva, a partir do comprimento
+// do seu polígono de controle
+func segments(length float64) int {
+	return int(math.Max(1, math.Min(512, math.Ceil(math.Sqrt(length/KFlatness)))))
+}
+
+func positiveMod(value, divisor float64) float64 {
+	return value - divisor*math.Floor(value/divisor)
+}
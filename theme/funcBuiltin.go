@@ -0,0 +1,120 @@
+package theme
+
+import (
+	"image/color"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/fontRegistry"
+)
+
+// KDefaultFamily
+// en: Family of the built-in themes
+//
+// pt_br: Família dos temas embutidos
+const KDefaultFamily = "default"
+
+// base
+// en: Returns the tokens shared by the built-in themes: fonts, line widths and
+// shadow offsets
+//
+// pt_br: Retorna os tokens compartilhados pelos temas embutidos: fontes, larguras
+// de linha e deslocamentos de sombra
+func base(variant Variant) *Theme {
+	theme := NewTheme(KDefaultFamily+"-"+string(variant), KDefaultFamily, variant)
+	theme.Fonts[KFontBody] = fontRegistry.Description{Families: []string{"sans-serif"}, Weight: 400, Size: 14}
+	theme.Fonts[KFontTitle] = fontRegistry.Description{Families: []string{"sans-serif"}, Weight: 700, Size: 20}
+	theme.Fonts[KFontMonospace] = fontRegistry.Description{Families: []string{"monospace"}, Weight: 400, Size: 13}
+	theme.LineWidths[KLineWidthThin] = 0.5
+	theme.LineWidths[KLineWidthBorder] = 1
+	theme.LineWidths[KLineWidthFocus] = 2
+	theme.LineWidths[KLineWidthThick] = 3
+	return theme
+}
+
+// shadows
+// en: Adds the shadow presets in the color given
+//
+// pt_br: Adiciona as predefinições de sombra na cor dada
+func shadows(theme *Theme, shadow color.RGBA) {
+	theme.Colors[KColorShadow] = shadow
+	theme.Shadows[KShadowSmall] = Shadow{OffsetY: 1, Blur: 2, Color: shadow}
+	theme.Shadows[KShadowMedium] = Shadow{OffsetY: 3, Blur: 6, Color: shadow}
+	theme.Shadows[KShadowLarge] = Shadow{OffsetY: 8, Blur: 16, Color: shadow}
+}
+
+// Light
+// en: Returns the built-in light theme, dark text on light surfaces
+//
+// pt_br: Retorna o tema claro embutido, texto escuro sobre superfícies claras
+func Light() *Theme {
+	theme := base(KVariantLight)
+	theme.Colors[KColorBackground] = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	theme.Colors[KColorSurface] = color.RGBA{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff}
+	theme.Colors[KColorHover] = color.RGBA{R: 0xee, G: 0xee, B: 0xee, A: 0xff}
+	theme.Colors[KColorPressed] = color.RGBA{R: 0xdd, G: 0xdd, B: 0xdd, A: 0xff}
+	theme.Colors[KColorText] = color.RGBA{R: 0x21, G: 0x21, B: 0x21, A: 0xff}
+	theme.Colors[KColorTextDisabled] = color.RGBA{R: 0x9e, G: 0x9e, B: 0x9e, A: 0xff}
+	theme.Colors[KColorPlaceholder] = color.RGBA{R: 0x75, G: 0x75, B: 0x75, A: 0xff}
+	theme.Colors[KColorBorder] = color.RGBA{R: 0x9e, G: 0x9e, B: 0x9e, A: 0xff}
+	theme.Colors[KColorAccent] = color.RGBA{R: 0x19, G: 0x76, B: 0xd2, A: 0xff}
+	theme.Colors[KColorAccentText] = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	theme.Colors[KColorFocus] = color.RGBA{R: 0x19, G: 0x76, B: 0xd2, A: 0x80}
+	theme.Colors[KColorSelection] = color.RGBA{R: 0x90, G: 0xca, B: 0xf9, A: 0xff}
+	theme.Colors[KColorGrid] = color.RGBA{R: 0xe0, G: 0xe0, B: 0xe0, A: 0xff}
+	shadows(theme, color.RGBA{A: 0x40})
+	return theme
+}
+
+// Dark
+// en: Returns the built-in dark theme, light text on dark surfaces
+//
+// pt_br: Retorna o tema escuro embutido, texto claro sobre superfícies escuras
+func Dark() *Theme {
+	theme := base(KVariantDark)
+	theme.Colors[KColorBackground] = color.RGBA{R: 0x12, G: 0x12, B: 0x12, A: 0xff}
+	theme.Colors[KColorSurface] = color.RGBA{R: 0x1e, G: 0x1e, B: 0x1e, A: 0xff}
+	theme.Colors[KColorHover] = color.RGBA{R: 0x2c, G: 0x2c, B: 0x2c, A: 0xff}
+	theme.Colors[KColorPressed] = color.RGBA{R: 0x3a, G: 0x3a, B: 0x3a, A: 0xff}
+	theme.Colors[KColorText] = color.RGBA{R: 0xe0, G: 0xe0, B: 0xe0, A: 0xff}
+	theme.Colors[KColorTextDisabled] = color.RGBA{R: 0x75, G: 0x75, B: 0x75, A: 0xff}
+	theme.Colors[KColorPlaceholder] = color.RGBA{R: 0x9e, G: 0x9e, B: 0x9e, A: 0xff}
+	theme.Colors[KColorBorder] = color.RGBA{R: 0x61, G: 0x61, B: 0x61, A: 0xff}
+	theme.Colors[KColorAccent] = color.RGBA{R: 0x64, G: 0xb5, B: 0xf6, A: 0xff}
+	theme.Colors[KColorAccentText] = color.RGBA{R: 0x0d, G: 0x0d, B: 0x0d, A: 0xff}
+	theme.Colors[KColorFocus] = color.RGBA{R: 0x64, G: 0xb5, B: 0xf6, A: 0x80}
+	theme.Colors[KColorSelection] = color.RGBA{R: 0x1e, G: 0x4a, B: 0x72, A: 0xff}
+	theme.Colors[KColorGrid] = color.RGBA{R: 0x33, G: 0x33, B: 0x33, A: 0xff}
+	shadows(theme, color.RGBA{A: 0x99})
+	return theme
+}
+
+// HighContrast
+// en: Returns the built-in high contrast theme: black and white with a yellow
+// accent, thicker borders and a wide focus ring
+//
+// pt_br: Retorna o tema de alto contraste embutido: preto e branco com destaque
+// amarelo, bordas mais grossas e um anel de foco largo
+func HighContrast() *Theme {
+	theme := base(KVariantHighContrast)
+	black := color.RGBA{A: 0xff}
+	white := color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	yellow := color.RGBA{R: 0xff, G: 0xff, B: 0x00, A: 0xff}
+	theme.Colors[KColorBackground] = black
+	theme.Colors[KColorSurface] = black
+	theme.Colors[KColorHover] = color.RGBA{R: 0x33, G: 0x33, B: 0x00, A: 0xff}
+	theme.Colors[KColorPressed] = color.RGBA{R: 0x66, G: 0x66, B: 0x00, A: 0xff}
+	theme.Colors[KColorText] = white
+	theme.Colors[KColorTextDisabled] = color.RGBA{R: 0x00, G: 0xff, B: 0x00, A: 0xff}
+	theme.Colors[KColorPlaceholder] = color.RGBA{R: 0xcc, G: 0xcc, B: 0xcc, A: 0xff}
+	theme.Colors[KColorBorder] = white
+	theme.Colors[KColorAccent] = yellow
+	theme.Colors[KColorAccentText] = black
+	theme.Colors[KColorFocus] = color.RGBA{R: 0x00, G: 0xff, B: 0xff, A: 0xff}
+	theme.Colors[KColorSelection] = color.RGBA{R: 0x00, G: 0x00, B: 0xcc, A: 0xff}
+	theme.Colors[KColorGrid] = white
+	theme.LineWidths[KLineWidthThin] = 1
+	theme.LineWidths[KLineWidthBorder] = 2
+	theme.LineWidths[KLineWidthFocus] = 3
+	theme.LineWidths[KLineWidthThick] = 4
+	shadows(theme, color.RGBA{})
+	return theme
+}
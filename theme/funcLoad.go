@@ -0,0 +1,287 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/fontRegistry"
+)
+
+// KMaxReferenceDepth
+// en: Maximum length of a chain of tokens that reference each other
+//
+// pt_br: Comprimento máximo de uma cadeia de tokens que se referenciam
+const KMaxReferenceDepth = 16
+
+// file
+// en: JSON form of a theme
+//
+// pt_br: Forma JSON de um tema
+type file struct {
+	Name       string                     `json:"name"`
+	Family     string                     `json:"family"`
+	Variant    Variant                    `json:"variant"`
+	Extends    string                     `json:"extends"`
+	Colors     map[string]string          `json:"colors"`
+	Fonts      map[string]string          `json:"fonts"`
+	LineWidths map[string]json.RawMessage `json:"lineWidths"`
+	Shadows    map[string]shadowFile      `json:"shadows"`
+}
+
+// shadowFile
+// en: JSON form of a shadow preset
+//
+// pt_br: Forma JSON de uma predefinição de sombra
+type shadowFile struct {
+	OffsetX int     `json:"offsetX"`
+	OffsetY int     `json:"offsetY"`
+	Blur    float64 `json:"blur"`
+	Color   string  `json:"color"`
+}
+
+// LoadFile
+// en: Reads a JSON file of themes and registers them; see Load()
+//
+// pt_br: Lê um arquivo JSON de temas e os registra; veja Load()
+func (el *Registry) LoadFile(name string) (names []string, err error) {
+	var data []byte
+	if data, err = os.ReadFile(name); err != nil {
+		return
+	}
+	if names, err = el.Load(data); err != nil {
+		err = fmt.Errorf("%v: %w", name, err)
+	}
+	return
+}
+
+// Load
+// en: Registers the themes of a JSON document, a single theme object or
+// {"themes": [...]}, and returns their names. Nothing is registered when any
+// theme has an error
+//
+//	{
+//	  "name": "ocean-dark", "family": "ocean", "variant": "dark",
+//	  "extends": "default-dark",
+//	  "colors": {"accent": "#4fc3f7", "focus": "@accent", "text": "oklch(0.9 0 0)"},
+//	  "fonts": {"body": "15px 'Open Sans', sans-serif", "title": "bold 22px serif"},
+//	  "lineWidths": {"border": 1.5, "focus": "@thick"},
+//	  "shadows": {"medium": {"offsetX": 0, "offsetY": 4, "blur": 8, "color": "@shadow"}}
+//	}
+//
+//	extends: Name of a registered theme, or of a previous theme of the same
+//	         document, whose tokens are copied first
+//	colors: CSS colors, as accepted by colorUtils.Parse()
+//	fonts: CSS font shorthands, as accepted by fontRegistry.ParseCSS()
+//	"@name": References another token of the same kind; the shadow color
+//	         references a color token
+//
+// pt_br: Registra os temas de um documento JSON, um único objeto de tema ou
+// {"themes": [...]}, e retorna os seus nomes. Nada é registrado quando algum tema
+// tem um erro
+//
+//	extends: Nome de um tema registrado, ou de um tema anterior do mesmo
+//	         documento, cujos tokens são copiados primeiro
+//	colors: Cores CSS, como aceitas por colorUtils.Parse()
+//	fonts: Atalhos CSS font, como aceitos por fontRegistry.ParseCSS()
+//	"@name": Referencia outro token do mesmo tipo; a cor da sombra referencia um
+//	         token de cor
+func (el *Registry) Load(data []byte) (names []string, err error) {
+	var document struct {
+		Themes []file `json:"themes"`
+	}
+	if err = json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("theme: %w", err)
+	}
+	if document.Themes == nil {
+		var single file
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err = decoder.Decode(&single); err != nil {
+			return nil, fmt.Errorf("theme: %w", err)
+		}
+		document.Themes = []file{single}
+	}
+
+	loaded := make(map[string]*Theme)
+	themes := make([]*Theme, 0, len(document.Themes))
+	for _, item := range document.Themes {
+		var theme *Theme
+		if theme, err = el.decode(item, loaded); err != nil {
+			return nil, err
+		}
+		loaded[theme.Name] = theme
+		themes = append(themes, theme)
+	}
+	for _, theme := range themes {
+		_ = el.Register(theme)
+		names = append(names, theme.Name)
+	}
+	return
+}
+
+// decode
+// en: Converts the JSON form into a theme, resolving extends and references
+//
+// pt_br: Converte a forma JSON em um tema, resolvendo extends e referências
+func (el *Registry) decode(item file, loaded map[string]*Theme) (theme *Theme, err error) {
+	if item.Name == "" {
+		return nil, fmt.Errorf("theme: a theme needs a name")
+	}
+	fail := func(format string, arguments ...interface{}) error {
+		return fmt.Errorf("theme: %v: %v", item.Name, fmt.Sprintf(format, arguments...))
+	}
+	if item.Family == "" {
+		item.Family = item.Name
+	}
+	if item.Variant == "" {
+		item.Variant = KVariantLight
+	}
+
+	theme = NewTheme(item.Name, item.Family, item.Variant)
+	if item.Extends != "" {
+		parent, found := loaded[item.Extends]
+		if !found {
+			parent, found = el.themes[item.Extends]
+		}
+		if !found {
+			return nil, fail("extends %q, which is not registered", item.Extends)
+		}
+		theme = parent.Extend(item.Name, item.Family, item.Variant)
+	}
+
+	for name := range item.Colors {
+		target, text, err := resolve(name, item.Colors, func(token string) bool {
+			_, found := theme.Colors[token]
+			return found
+		})
+		if err != nil {
+			return nil, fail("color %v: %v", name, err)
+		}
+		if target != name {
+			continue
+		}
+		if theme.Colors[name], err = colorUtils.Parse(text); err != nil {
+			return nil, fail("color %v: %v", name, err)
+		}
+	}
+	// en: references are copied after every literal of the document is parsed
+	// pt_br: referências são copiadas depois que todo literal do documento é
+	// interpretado
+	for name := range item.Colors {
+		if target, _, _ := resolve(name, item.Colors, nil); target != name {
+			theme.Colors[name] = theme.Colors[target]
+		}
+	}
+
+	for name := range item.Fonts {
+		target, text, err := resolve(name, item.Fonts, func(token string) bool {
+			_, found := theme.Fonts[token]
+			return found
+		})
+		if err != nil {
+			return nil, fail("font %v: %v", name, err)
+		}
+		if target != name {
+			continue
+		}
+		if theme.Fonts[name], err = fontRegistry.ParseCSS(text); err != nil {
+			return nil, fail("font %v: %v", name, err)
+		}
+	}
+	for name := range item.Fonts {
+		if target, _, _ := resolve(name, item.Fonts, nil); target != name {
+			theme.Fonts[name] = theme.Fonts[target]
+		}
+	}
+
+	widths := make(map[string]string, len(item.LineWidths))
+	for name, raw := range item.LineWidths {
+		var text string
+		if json.Unmarshal(raw, &text) != nil {
+			text = string(raw)
+		}
+		widths[name] = text
+	}
+	for name := range widths {
+		target, text, err := resolve(name, widths, func(token string) bool {
+			_, found := theme.LineWidths[token]
+			return found
+		})
+		if err != nil {
+			return nil, fail("line width %v: %v", name, err)
+		}
+		if target != name {
+			continue
+		}
+		width, err := strconv.ParseFloat(text, 64)
+		if err != nil || width < 0 {
+			return nil, fail("line width %v must be a non-negative number or a reference", name)
+		}
+		theme.LineWidths[name] = width
+	}
+	for name := range widths {
+		if target, _, _ := resolve(name, widths, nil); target != name {
+			theme.LineWidths[name] = theme.LineWidths[target]
+		}
+	}
+
+	for name, shadow := range item.Shadows {
+		value := Shadow{OffsetX: shadow.OffsetX, OffsetY: shadow.OffsetY, Blur: shadow.Blur}
+		switch {
+		case strings.HasPrefix(shadow.Color, "@"):
+			target := strings.TrimPrefix(shadow.Color, "@")
+			var found bool
+			if value.Color, found = theme.Colors[target]; !found {
+				return nil, fail("shadow %v references %q, which does not exist", name, shadow.Color)
+			}
+		case shadow.Color == "":
+			value.Color = color.RGBA{A: 0x40}
+		default:
+			if value.Color, err = colorUtils.Parse(shadow.Color); err != nil {
+				return nil, fail("shadow %v: %v", name, err)
+			}
+		}
+		if value.Blur < 0 {
+			return nil, fail("shadow %v has a negative blur", name)
+		}
+		theme.Shadows[name] = value
+	}
+	return theme, nil
+}
+
+// resolve
+// en: Follows the "@name" references of a token of the document and returns the
+// last token of the chain, with its text when it is in the document. A chain
+// that leaves the document must end in a token for which inherited returns true;
+// cycles and chains longer than KMaxReferenceDepth are errors. A nil inherited
+// skips the checks, for chains already validated
+//
+// pt_br: Segue as referências "@name" de um token do documento e retorna o último
+// token da cadeia, com o seu texto quando ele está no documento. Uma cadeia que
+// sai do documento deve terminar em um token para o qual inherited retorna true;
+// ciclos e cadeias maiores que KMaxReferenceDepth são erros. Um inherited nil
+// pula as verificações, para cadeias já validadas
+func resolve(name string, values map[string]string, inherited func(token string) bool) (target, text string, err error) {
+	target = name
+	for depth := 0; depth <= KMaxReferenceDepth; depth += 1 {
+		value, local := values[target]
+		if !local {
+			if inherited != nil && !inherited(target) {
+				return "", "", fmt.Errorf("references %q, which does not exist", "@"+target)
+			}
+			return target, "", nil
+		}
+		value = strings.TrimSpace(value)
+		if !strings.HasPrefix(value, "@") {
+			return target, value, nil
+		}
+		target = strings.TrimPrefix(value, "@")
+	}
+	return "", "", fmt.Errorf("references nested more than %v levels, or in a cycle", KMaxReferenceDepth)
+}
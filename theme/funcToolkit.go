@@ -0,0 +1,56 @@
+package theme
+
+import (
+	"image/color"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/toolkit"
+)
+
+// ToToolkit
+// en: Returns a copy of base with the colors, line widths and body font of the
+// theme; tokens missing in the theme keep the values of base, as do Radius,
+// Padding and Cursors. Used in a Registry listener:
+//
+//	registry.Subscribe(func(current *theme.Theme) {
+//	  widgets.SetTheme(current.ToToolkit(toolkit.DefaultTheme()))
+//	  widgets.Draw()
+//	})
+//
+// pt_br: Retorna uma cópia de base com as cores, larguras de linha e fonte do
+// corpo do tema; tokens ausentes no tema mantêm os valores de base, assim como
+// Radius, Padding e Cursors. Usado em um ouvinte de Registry, como no exemplo
+// acima
+func (el *Theme) ToToolkit(base toolkit.Theme) toolkit.Theme {
+	colors := []struct {
+		name  string
+		field *color.RGBA
+	}{
+		{KColorText, &base.TextColor},
+		{KColorTextDisabled, &base.DisabledTextColor},
+		{KColorPlaceholder, &base.PlaceholderColor},
+		{KColorSurface, &base.SurfaceColor},
+		{KColorHover, &base.HoverColor},
+		{KColorPressed, &base.PressedColor},
+		{KColorBorder, &base.BorderColor},
+		{KColorAccent, &base.AccentColor},
+		{KColorAccentText, &base.AccentTextColor},
+		{KColorFocus, &base.FocusColor},
+		{KColorSelection, &base.SelectionColor},
+	}
+	for _, item := range colors {
+		if value, found := el.GetColor(item.name); found {
+			*item.field = value
+		}
+	}
+
+	if value, found := el.GetLineWidth(KLineWidthBorder); found {
+		base.BorderWidth = value
+	}
+	if value, found := el.GetLineWidth(KLineWidthFocus); found {
+		base.FocusWidth = value
+	}
+	if value, found := el.GetFont(KFontBody); found {
+		base.Font = &value
+	}
+	return base
+}
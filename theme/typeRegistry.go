@@ -0,0 +1,171 @@
+package theme
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Registry
+// en: Set of themes with the current one. Switching the theme calls the
+// listeners, which redraw what depends on it, like Toolkit.SetTheme() followed by
+// Toolkit.Draw()
+//
+// pt_br: Conjunto de temas com o atual. Trocar o tema chama os ouvintes, que
+// redesenham o que depende dele, como Toolkit.SetTheme() seguido de
+// Toolkit.Draw()
+type Registry struct {
+	themes    map[string]*Theme
+	current   *Theme
+	listeners map[int]func(theme *Theme)
+	next      int
+}
+
+// NewRegistry
+// en: Returns a registry with the built-in themes of the "default" family, with
+// the light one in use
+//
+// pt_br: Retorna um registro com os temas embutidos da família "default", com o
+// claro em uso
+func NewRegistry() *Registry {
+	registry := &Registry{
+		themes:    make(map[string]*Theme),
+		listeners: make(map[int]func(theme *Theme)),
+	}
+	for _, theme := range []*Theme{Light(), Dark(), HighContrast()} {
+		registry.themes[theme.Name] = theme
+	}
+	registry.current = registry.themes[KDefaultFamily+"-"+string(KVariantLight)]
+	return registry
+}
+
+// Register
+// en: Adds or replaces a theme; replacing the current theme calls the listeners
+//
+// pt_br: Adiciona ou substitui um tema; substituir o tema atual chama os ouvintes
+func (el *Registry) Register(theme *Theme) error {
+	if theme == nil || theme.Name == "" {
+		return fmt.Errorf("theme: a theme needs a name")
+	}
+	previous := el.themes[theme.Name]
+	el.themes[theme.Name] = theme
+	if previous != nil && previous == el.current {
+		el.current = theme
+		el.notify()
+	}
+	return nil
+}
+
+// Get
+// en: Returns the theme with the name
+//
+// pt_br: Retorna o tema com o nome
+func (el *Registry) Get(name string) (theme *Theme, found bool) {
+	theme, found = el.themes[name]
+	return
+}
+
+// GetNames
+// en: Returns the sorted names of the themes
+//
+// pt_br: Retorna os nomes ordenados dos temas
+func (el *Registry) GetNames() []string {
+	names := make([]string, 0, len(el.themes))
+	for name := range el.themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetCurrent
+// en: Returns the theme in use
+//
+// pt_br: Retorna o tema em uso
+func (el *Registry) GetCurrent() *Theme {
+	return el.current
+}
+
+// Use
+// en: Switches to the theme with the name and calls the listeners when it changed
+//
+// pt_br: Troca para o tema com o nome e chama os ouvintes quando ele mudou
+func (el *Registry) Use(name string) error {
+	theme, found := el.themes[name]
+	if !found {
+		return fmt.Errorf("theme: %q is not registered", name)
+	}
+	if theme != el.current {
+		el.current = theme
+		el.notify()
+	}
+	return nil
+}
+
+// UseVariant
+// en: Switches to the theme of the same family as the current one with the
+// variant given, like when the user, or the prefers-color-scheme media query read
+// by the application, asks for the dark appearance
+//
+// pt_br: Troca para o tema da mesma família do atual com a variante dada, como
+// quando o usuário, ou a media query prefers-color-scheme lida pela aplicação,
+// pede a aparência escura
+func (el *Registry) UseVariant(variant Variant) error {
+	for _, name := range el.GetNames() {
+		theme := el.themes[name]
+		if theme.Family == el.current.Family && theme.Variant == variant {
+			return el.Use(name)
+		}
+	}
+	return fmt.Errorf("theme: family %q has no %q variant", el.current.Family, variant)
+}
+
+// GetVariants
+// en: Returns the variants of the family of the current theme
+//
+// pt_br: Retorna as variantes da família do tema atual
+func (el *Registry) GetVariants() []Variant {
+	variants := make([]Variant, 0)
+	for _, name := range el.GetNames() {
+		if theme := el.themes[name]; theme.Family == el.current.Family {
+			variants = append(variants, theme.Variant)
+		}
+	}
+	return variants
+}
+
+// Subscribe
+// en: Adds a listener called with the new theme after each switch; returns the id
+// used by Unsubscribe()
+//
+// pt_br: Adiciona um ouvinte chamado com o novo tema após cada troca; retorna o id
+// usado por Unsubscribe()
+func (el *Registry) Subscribe(listener func(theme *Theme)) (id int) {
+	el.next += 1
+	el.listeners[el.next] = listener
+	return el.next
+}
+
+// Unsubscribe
+// en: Removes a listener
+//
+// pt_br: Remove um ouvinte
+func (el *Registry) Unsubscribe(id int) {
+	delete(el.listeners, id)
+}
+
+// notify
+// en: Calls the listeners in the order they subscribed
+//
+// pt_br: Chama os ouvintes na ordem em que se inscreveram
+func (el *Registry) notify() {
+	ids := make([]int, 0, len(el.listeners))
+	for id := range el.listeners {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if listener, found := el.listeners[id]; found {
+			listener(el.current)
+		}
+	}
+}
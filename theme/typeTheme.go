@@ -0,0 +1,292 @@
+package theme
+
+import (
+	"image/color"
+	"sort"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/fontRegistry"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// Variant
+// en: Appearance of a theme; a family has one theme per variant
+//
+// pt_br: Aparência de um tema; uma família tem um tema por variante
+type Variant string
+
+const (
+	KVariantLight        Variant = "light"
+	KVariantDark         Variant = "dark"
+	KVariantHighContrast Variant = "high-contrast"
+)
+
+// Names of the tokens of the built-in themes, used by ToToolkit(). Themes may
+// define any other name
+//
+// Nomes dos tokens dos temas embutidos, usados por ToToolkit(). Temas podem
+// definir qualquer outro nome
+const (
+	KColorBackground   = "background"
+	KColorSurface      = "surface"
+	KColorHover        = "hover"
+	KColorPressed      = "pressed"
+	KColorText         = "text"
+	KColorTextDisabled = "text-disabled"
+	KColorPlaceholder  = "placeholder"
+	KColorBorder       = "border"
+	KColorAccent       = "accent"
+	KColorAccentText   = "accent-text"
+	KColorFocus        = "focus"
+	KColorSelection    = "selection"
+	KColorGrid         = "grid"
+	KColorShadow       = "shadow"
+
+	KFontBody      = "body"
+	KFontTitle     = "title"
+	KFontMonospace = "monospace"
+
+	KLineWidthThin   = "thin"
+	KLineWidthBorder = "border"
+	KLineWidthFocus  = "focus"
+	KLineWidthThick  = "thick"
+
+	KShadowSmall  = "small"
+	KShadowMedium = "medium"
+	KShadowLarge  = "large"
+)
+
+// Platform
+// en: Methods of IDraw used to apply the tokens
+//
+// pt_br: Métodos de IDraw usados para aplicar os tokens
+type Platform interface {
+	SetFillStyle(value interface{})
+	SetStrokeStyle(value interface{})
+	SetLineWidth(value interface{})
+	Font(font font.Font)
+}
+
+// Shadow
+// en: Shadow preset. It implements IFilterShadowInterface, so it can be given
+// wherever the platform accepts a shadow filter
+//
+//	OffsetX, OffsetY: Distance of the shadow, as in IDraw.ShadowOffsetX() and
+//	                  IDraw.ShadowOffsetY()
+//	Blur: Blur level, as in IDraw.SetShadowBlur()
+//	Color: Color of the shadow, as in IDraw.SetShadowColor()
+//
+// pt_br: Predefinição de sombra. Ela implementa IFilterShadowInterface, assim
+// pode ser dada onde a plataforma aceita um filtro de sombra
+//
+//	OffsetX, OffsetY: Distância da sombra, como em IDraw.ShadowOffsetX() e
+//	                  IDraw.ShadowOffsetY()
+//	Blur: Nível de borrão, como em IDraw.SetShadowBlur()
+//	Color: Cor da sombra, como em IDraw.SetShadowColor()
+type Shadow struct {
+	OffsetX int
+	OffsetY int
+	Blur    float64
+	Color   color.RGBA
+}
+
+// PrepareFilter
+// en: Sets the shadow on the platform
+//
+// pt_br: Define a sombra na plataforma
+func (el Shadow) PrepareFilter(platform iotmakerPlatformIDraw.ICanvasShadow) {
+	platform.SetShadowBlur(el.Blur)
+	platform.SetShadowColor(el.Color)
+	platform.ShadowOffsetX(el.OffsetX)
+	platform.ShadowOffsetY(el.OffsetY)
+}
+
+// Theme
+// en: Named tokens of colors, fonts, line widths and shadow presets
+//
+//	Name: Unique name in the registry, like "default-dark"
+//	Family: Themes of a family are variants of each other, see
+//	        Registry.UseVariant()
+//
+// pt_br: Tokens nomeados de cores, fontes, larguras de linha e predefinições de
+// sombra
+//
+//	Name: Nome único no registro, como "default-dark"
+//	Family: Temas de uma família são variantes uns dos outros, veja
+//	        Registry.UseVariant()
+type Theme struct {
+	Name       string
+	Family     string
+	Variant    Variant
+	Colors     map[string]color.RGBA
+	Fonts      map[string]fontRegistry.Description
+	LineWidths map[string]float64
+	Shadows    map[string]Shadow
+}
+
+// NewTheme
+// en: Returns a theme without tokens
+//
+// pt_br: Retorna um tema sem tokens
+func NewTheme(name, family string, variant Variant) *Theme {
+	return &Theme{
+		Name:       name,
+		Family:     family,
+		Variant:    variant,
+		Colors:     make(map[string]color.RGBA),
+		Fonts:      make(map[string]fontRegistry.Description),
+		LineWidths: make(map[string]float64),
+		Shadows:    make(map[string]Shadow),
+	}
+}
+
+// Extend
+// en: Returns a copy of the theme with another name, family and variant, to be
+// changed without touching the original
+//
+// pt_br: Retorna uma cópia do tema com outro nome, família e variante, para ser
+// alterada sem mexer no original
+func (el *Theme) Extend(name, family string, variant Variant) *Theme {
+	theme := NewTheme(name, family, variant)
+	for key, value := range el.Colors {
+		theme.Colors[key] = value
+	}
+	for key, value := range el.Fonts {
+		value.Families = append([]string(nil), value.Families...)
+		theme.Fonts[key] = value
+	}
+	for key, value := range el.LineWidths {
+		theme.LineWidths[key] = value
+	}
+	for key, value := range el.Shadows {
+		theme.Shadows[key] = value
+	}
+	return theme
+}
+
+// GetColor
+// en: Returns the color token
+//
+// pt_br: Retorna o token de cor
+func (el *Theme) GetColor(name string) (value color.RGBA, found bool) {
+	value, found = el.Colors[name]
+	return
+}
+
+// GetFont
+// en: Returns the font token, converted for IDraw.Font()
+//
+// pt_br: Retorna o token de fonte, convertido para IDraw.Font()
+func (el *Theme) GetFont(name string) (value font.Font, found bool) {
+	description, found := el.Fonts[name]
+	if !found {
+		return
+	}
+	return description.ToFont(), true
+}
+
+// GetLineWidth
+// en: Returns the line width token
+//
+// pt_br: Retorna o token de largura de linha
+func (el *Theme) GetLineWidth(name string) (value float64, found bool) {
+	value, found = el.LineWidths[name]
+	return
+}
+
+// GetShadow
+// en: Returns the shadow preset
+//
+// pt_br: Retorna a predefinição de sombra
+func (el *Theme) GetShadow(name string) (value Shadow, found bool) {
+	value, found = el.Shadows[name]
+	return
+}
+
+// Fill
+// en: Sets the color token as fill style; returns false when it does not exist
+//
+// pt_br: Define o token de cor como estilo de preenchimento; retorna false quando
+// ele não existe
+func (el *Theme) Fill(platform Platform, name string) bool {
+	value, found := el.Colors[name]
+	if found {
+		platform.SetFillStyle(value)
+	}
+	return found
+}
+
+// Stroke
+// en: Sets the color token as stroke style; returns false when it does not exist
+//
+// pt_br: Define o token de cor como estilo de contorno; retorna false quando ele
+// não existe
+func (el *Theme) Stroke(platform Platform, name string) bool {
+	value, found := el.Colors[name]
+	if found {
+		platform.SetStrokeStyle(value)
+	}
+	return found
+}
+
+// LineWidth
+// en: Sets the line width token; returns false when it does not exist
+//
+// pt_br: Define o token de largura de linha; retorna false quando ele não existe
+func (el *Theme) LineWidth(platform Platform, name string) bool {
+	value, found := el.LineWidths[name]
+	if found {
+		platform.SetLineWidth(value)
+	}
+	return found
+}
+
+// Font
+// en: Sets the font token; returns false when it does not exist
+//
+// pt_br: Define o token de fonte; retorna false quando ele não existe
+func (el *Theme) Font(platform Platform, name string) bool {
+	value, found := el.GetFont(name)
+	if found {
+		platform.Font(value)
+	}
+	return found
+}
+
+// Shadow
+// en: Sets the shadow preset; returns false when it does not exist. Use
+// IDraw.ResetShadow() to remove it
+//
+// pt_br: Define a predefinição de sombra; retorna false quando ela não existe.
+// Use IDraw.ResetShadow() para removê-la
+func (el *Theme) Shadow(platform iotmakerPlatformIDraw.ICanvasShadow, name string) bool {
+	value, found := el.Shadows[name]
+	if found {
+		value.PrepareFilter(platform)
+	}
+	return found
+}
+
+// GetTokenNames
+// en: Returns the sorted names of the tokens of each kind
+//
+// pt_br: Retorna os nomes ordenados dos tokens de cada tipo
+func (el *Theme) GetTokenNames() (colors, fonts, lineWidths, shadows []string) {
+	for name := range el.Colors {
+		colors = append(colors, name)
+	}
+	for name := range el.Fonts {
+		fonts = append(fonts, name)
+	}
+	for name := range el.LineWidths {
+		lineWidths = append(lineWidths, name)
+	}
+	for name := range el.Shadows {
+		shadows = append(shadows, name)
+	}
+	sort.Strings(colors)
+	sort.Strings(fonts)
+	sort.Strings(lineWidths)
+	sort.Strings(shadows)
+	return
+}
@@ -0,0 +1,337 @@
+package tileMap
+
+import (
+	"image"
+	"math"
+	"time"
+)
+
+// Platform
+// en: Part of IDraw used to draw the map
+//
+// pt_br: Parte de IDraw usada para desenhar o mapa
+type Platform interface {
+	DrawImage(image interface{}, value ...interface{})
+	SetGlobalAlpha(value float64)
+	GetGlobalAlpha() float64
+	GetTransform() [6]float64
+	SetTransform(a, b, c, d, e, f float64)
+	SetFillStyle(value interface{})
+	FillRect(x, y, width, height int)
+	Save()
+	Restore()
+}
+
+// matrix
+// en: Affine transformation with the a, b, c, d, e, f values of IDraw.SetTransform()
+//
+// pt_br: Transformação afim com os valores a, b, c, d, e, f de IDraw.SetTransform()
+type matrix [6]float64
+
+// multiply
+// en: Returns the transformation that applies other first and then el
+//
+// pt_br: Retorna a transformação que aplica other primeiro e depois el
+func (el matrix) multiply(other matrix) matrix {
+	return matrix{
+		el[0]*other[0] + el[2]*other[1],
+		el[1]*other[0] + el[3]*other[1],
+		el[0]*other[2] + el[2]*other[3],
+		el[1]*other[2] + el[3]*other[3],
+		el[0]*other[4] + el[2]*other[5] + el[4],
+		el[1]*other[4] + el[3]*other[5] + el[5],
+	}
+}
+
+// Draw
+// en: Draws the background color and the visible layers inside the viewport of
+// the camera. Only the tiles that touch the view are drawn, with the edges
+// rounded to whole pixels to avoid seams between them; the tiles on the border
+// of the viewport are drawn whole, so the viewport should be the whole canvas, or
+// an offscreen surface of its size, when nothing may be drawn around it
+//
+//	now: Time used to choose the frames of the animated tiles, like time.Now()
+//
+// Game sprites are drawn between the layers with DrawLayer()
+//
+// pt_br: Desenha a cor de fundo e as camadas visíveis dentro da viewport da
+// câmera. Somente os tiles que tocam a visão são desenhados, com as bordas
+// arredondadas para pixels inteiros para evitar frestas entre eles; os tiles na
+// borda da viewport são desenhados inteiros, assim a viewport deve ser o canvas
+// inteiro, ou uma superfície offscreen do seu tamanho, quando nada pode ser
+// desenhado ao redor dela
+//
+//	now: Instante usado para escolher os quadros dos tiles animados, como
+//	     time.Now()
+//
+// Sprites de jogos são desenhados entre as camadas com DrawLayer()
+func (el *Map) Draw(platform Platform, camera *Camera, now time.Time) {
+	if el.BackgroundColor.A != 0 {
+		viewport := camera.Viewport
+		left, top := math.Round(viewport.X), math.Round(viewport.Y)
+		platform.SetFillStyle(el.BackgroundColor)
+		platform.FillRect(int(left), int(top), int(math.Round(viewport.MaxX())-left), int(math.Round(viewport.MaxY())-top))
+	}
+	for _, layer := range el.Layers {
+		if layer.Visible {
+			el.DrawLayer(platform, camera, layer, now)
+		}
+	}
+}
+
+// DrawLayer
+// en: Draws one layer, visible or not, inside Save() and Restore(), with its
+// opacity, offset and parallax. Object layers draw only their tile objects
+//
+// pt_br: Desenha uma camada, visível ou não, dentro de Save() e Restore(), com a
+// sua opacidade, deslocamento e paralaxe. Camadas de objetos desenham somente os
+// seus objetos tile
+func (el *Map) DrawLayer(platform Platform, camera *Camera, layer *Layer, now time.Time) {
+	if layer.Opacity <= 0 {
+		return
+	}
+	platform.Save()
+	defer platform.Restore()
+	platform.SetGlobalAlpha(platform.GetGlobalAlpha() * math.Min(layer.Opacity, 1))
+
+	view := el.layerCamera(camera, layer)
+	switch layer.Kind {
+	case KLayerTile:
+		el.drawTiles(platform, view, layer, now)
+	case KLayerObject:
+		el.drawObjects(platform, view, layer, now)
+	case KLayerImage:
+		el.drawImageLayer(platform, view, layer)
+	}
+}
+
+// layerCamera
+// en: Returns the camera seen by the layer, with the parallax and offset of the
+// layer applied, so the points of the layer can be used directly
+//
+// pt_br: Retorna a câmera vista pela camada, com a paralaxe e o deslocamento da
+// camada aplicados, assim os pontos da camada podem ser usados diretamente
+func (el *Map) layerCamera(camera *Camera, layer *Layer) *Camera {
+	view := *camera
+	view.X = el.ParallaxOriginX + (camera.X-el.ParallaxOriginX)*layer.ParallaxX - layer.OffsetX
+	view.Y = el.ParallaxOriginY + (camera.Y-el.ParallaxOriginY)*layer.ParallaxY - layer.OffsetY
+	return &view
+}
+
+// drawTiles
+// en: Draws the cells of a tile layer that touch the view
+//
+// pt_br: Desenha as células de uma camada de tiles que tocam a visão
+func (el *Map) drawTiles(platform Platform, camera *Camera, layer *Layer, now time.Time) {
+	base := matrix(platform.GetTransform())
+	area := camera.GetView()
+
+	// en: tiles larger than the grid, or with offsets, reach the neighbouring cells
+	// pt_br: tiles maiores que a grade, ou com deslocamentos, alcançam as células
+	// vizinhas
+	marginX, marginY := 0.0, 0.0
+	for _, tileset := range el.Tilesets {
+		marginX = math.Max(marginX, float64(tileset.TileWidth-el.TileWidth)+math.Abs(float64(tileset.OffsetX)))
+		marginY = math.Max(marginY, float64(tileset.TileHeight-el.TileHeight)+math.Abs(float64(tileset.OffsetY)))
+	}
+
+	left, top := el.TileAt(area.X-marginX, area.Y-marginY)
+	right, bottom := el.TileAt(area.MaxX()+marginX, area.MaxY()+marginY)
+	if left < layer.X {
+		left = layer.X
+	}
+	if top < layer.Y {
+		top = layer.Y
+	}
+	if right >= layer.X+layer.Width {
+		right = layer.X + layer.Width - 1
+	}
+	if bottom >= layer.Y+layer.Height {
+		bottom = layer.Y + layer.Height - 1
+	}
+
+	for row := top; row <= bottom; row += 1 {
+		for column := left; column <= right; column += 1 {
+			gid := layer.GetTile(column, row)
+			if gid == 0 {
+				continue
+			}
+			x := float64(column * el.TileWidth)
+			y := float64((row + 1) * el.TileHeight)
+			el.drawGid(platform, camera, base, gid, x, y, 0, 0, now)
+		}
+	}
+}
+
+// drawObjects
+// en: Draws the visible tile objects of an object layer that touch the view
+//
+// pt_br: Desenha os objetos tile visíveis de uma camada de objetos que tocam a
+// visão
+func (el *Map) drawObjects(platform Platform, camera *Camera, layer *Layer, now time.Time) {
+	base := matrix(platform.GetTransform())
+	area := camera.GetView()
+	for _, object := range layer.Objects {
+		if object.Kind != KObjectTile || !object.Visible {
+			continue
+		}
+		bounds := object.rectangle().GetBounds()
+		if !bounds.Intersects(area) {
+			continue
+		}
+		if object.Rotation == 0 {
+			el.drawGid(platform, camera, base, object.Gid, object.X, object.Y, object.Width, object.Height, now)
+			continue
+		}
+
+		// en: rotates around the bottom left corner, in a space where that corner is
+		// the origin
+		// pt_br: gira ao redor do canto inferior esquerdo, em um espaço onde esse
+		// canto é a origem
+		zoom := camera.GetZoom()
+		screenX, screenY := camera.WorldToScreen(object.X, object.Y)
+		sin, cos := math.Sincos(object.Rotation * math.Pi / 180)
+		rotated := base.multiply(matrix{cos * zoom, sin * zoom, -sin * zoom, cos * zoom, screenX, screenY})
+		local := &Camera{Zoom: 1}
+		platform.SetTransform(rotated[0], rotated[1], rotated[2], rotated[3], rotated[4], rotated[5])
+		el.drawGid(platform, local, rotated, object.Gid, 0, 0, object.Width, object.Height, now)
+		platform.SetTransform(base[0], base[1], base[2], base[3], base[4], base[5])
+	}
+}
+
+// drawGid
+// en: Draws the tile of gid with the bottom left corner at (x, y) of the map,
+// resized to width by height when they are not 0; base is the current
+// transformation of the platform
+//
+// pt_br: Desenha o tile de gid com o canto inferior esquerdo em (x, y) do mapa,
+// redimensionado para width por height quando eles não são 0; base é a
+// transformação atual da plataforma
+func (el *Map) drawGid(platform Platform, camera *Camera, base matrix, gid uint32, x, y, width, height float64, now time.Time) {
+	tileset, id, found := el.GetTileset(gid)
+	if !found {
+		return
+	}
+	file, sourceX, sourceY, sourceWidth, sourceHeight := tileset.GetSource(tileset.GetFrame(id, now))
+	picture := el.images[file]
+	if picture == nil {
+		return
+	}
+	if sourceWidth == 0 || sourceHeight == 0 {
+		sourceWidth, sourceHeight = imageSize(picture)
+	}
+	if width == 0 || height == 0 {
+		width, height = float64(sourceWidth), float64(sourceHeight)
+	}
+	x += float64(tileset.OffsetX)
+	y += float64(tileset.OffsetY)
+
+	left, top := camera.WorldToScreen(x, y-height)
+	right, bottom := camera.WorldToScreen(x+width, y)
+	drawImage(
+		platform, base, picture, gid,
+		sourceX, sourceY, sourceWidth, sourceHeight,
+		int(math.Round(left)), int(math.Round(top)), int(math.Round(right)), int(math.Round(bottom)),
+	)
+}
+
+// drawImageLayer
+// en: Draws the image of an image layer, repeated over the view on the axes with
+// RepeatX or RepeatY
+//
+// pt_br: Desenha a imagem de uma camada de imagem, repetida sobre a visão nos
+// eixos com RepeatX ou RepeatY
+func (el *Map) drawImageLayer(platform Platform, camera *Camera, layer *Layer) {
+	picture := el.images[layer.Image]
+	if picture == nil {
+		return
+	}
+	width, height := layer.ImageWidth, layer.ImageHeight
+	if width == 0 || height == 0 {
+		width, height = imageSize(picture)
+	}
+	if width == 0 || height == 0 {
+		return
+	}
+
+	area := camera.GetView()
+	first, last := 0, 0
+	if layer.RepeatX {
+		first = floorDiv(area.X, float64(width))
+		last = floorDiv(area.MaxX(), float64(width))
+	}
+	top, bottom := 0, 0
+	if layer.RepeatY {
+		top = floorDiv(area.Y, float64(height))
+		bottom = floorDiv(area.MaxY(), float64(height))
+	}
+
+	base := matrix(platform.GetTransform())
+	for row := top; row <= bottom; row += 1 {
+		for column := first; column <= last; column += 1 {
+			left, upper := camera.WorldToScreen(float64(column*width), float64(row*height))
+			right, lower := camera.WorldToScreen(float64((column+1)*width), float64((row+1)*height))
+			drawImage(
+				platform, base, picture, 0,
+				0, 0, width, height,
+				int(math.Round(left)), int(math.Round(upper)), int(math.Round(right)), int(math.Round(lower)),
+			)
+		}
+	}
+}
+
+// drawImage
+// en: Draws the source rectangle of the image into the destination rectangle,
+// from (left, top) to (right, bottom), with the flip flags of gid applied over
+// base, the current transformation of the platform
+//
+// pt_br: Desenha o retângulo de origem da imagem no retângulo de destino, de
+// (left, top) a (right, bottom), com as flags de espelhamento de gid aplicadas
+// sobre base, a transformação atual da plataforma
+func drawImage(platform Platform, base matrix, picture interface{}, gid uint32, sourceX, sourceY, sourceWidth, sourceHeight, left, top, right, bottom int) {
+	if right <= left || bottom <= top || sourceWidth <= 0 || sourceHeight <= 0 {
+		return
+	}
+	flags := gid & (KFlipHorizontal | KFlipVertical | KFlipDiagonal)
+	if flags == 0 {
+		platform.DrawImage(picture, sourceX, sourceY, sourceWidth, sourceHeight, left, top, right-left, bottom-top)
+		return
+	}
+
+	width, height := right-left, bottom-top
+	scaleX, scaleY := 1.0, 1.0
+	offsetX, offsetY := float64(left), float64(top)
+	if flags&KFlipHorizontal != 0 {
+		scaleX = -1
+		offsetX += float64(width)
+	}
+	if flags&KFlipVertical != 0 {
+		scaleY = -1
+		offsetY += float64(height)
+	}
+	local := matrix{scaleX, 0, 0, scaleY, offsetX, offsetY}
+	if flags&KFlipDiagonal != 0 {
+		// en: the axes are swapped before the flips
+		// pt_br: os eixos são trocados antes dos espelhamentos
+		local = matrix{0, scaleY, scaleX, 0, offsetX, offsetY}
+		width, height = height, width
+	}
+
+	transform := base.multiply(local)
+	platform.SetTransform(transform[0], transform[1], transform[2], transform[3], transform[4], transform[5])
+	platform.DrawImage(picture, sourceX, sourceY, sourceWidth, sourceHeight, 0, 0, width, height)
+	platform.SetTransform(base[0], base[1], base[2], base[3], base[4], base[5])
+}
+
+// imageSize
+// en: Returns the size of images that implement image.Image; 0 for the others
+//
+// pt_br: Retorna o tamanho de imagens que implementam image.Image; 0 para as
+// outras
+func imageSize(picture interface{}) (width, height int) {
+	if decoded, ok := picture.(image.Image); ok {
+		bounds := decoded.Bounds()
+		return bounds.Dx(), bounds.Dy()
+	}
+	return 0, 0
+}
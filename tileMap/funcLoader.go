@@ -0,0 +1,181 @@
+package tileMap
+
+import (
+	"fmt"
+	"image"
+	_ "image/png"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	iotmakerPlatformIDraw "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw"
+)
+
+// ImageLoader
+// en: Loads an image of the map, with the file name relative to the map, and
+// returns a value accepted by IDraw.DrawImage()
+//
+// pt_br: Carrega uma imagem do mapa, com o nome de arquivo relativo ao mapa, e
+// retorna um valor aceito por IDraw.DrawImage()
+type ImageLoader func(file string) (image interface{}, err error)
+
+// NewHtmlLoader
+// en: Returns a loader that creates the images with IHtml.NewImage(), waiting for
+// the load
+//
+//	html: Platform used to create the images
+//	parent: Parent element passed to NewImage()
+//	base: URL prefixed to the file names of the map, like "maps/"
+//
+// pt_br: Retorna um carregador que cria as imagens com IHtml.NewImage(), esperando
+// o carregamento
+//
+//	html: Plataforma usada para criar as imagens
+//	parent: Elemento pai passado para NewImage()
+//	base: URL prefixada aos nomes de arquivo do mapa, como "maps/"
+func NewHtmlLoader(html iotmakerPlatformIDraw.IHtml, parent interface{}, base string) ImageLoader {
+	return func(file string) (interface{}, error) {
+		return html.NewImage(parent, map[string]interface{}{"src": base + file}, true), nil
+	}
+}
+
+// NewFileLoader
+// en: Returns a loader that decodes the images from fsys, which accepts an
+// embed.FS or os.DirFS(), as image.Image for headless backends. PNG is supported;
+// register other decoders with a blank import
+//
+//	dir: Directory of the map inside fsys; the file names are relative to it
+//
+// pt_br: Retorna um carregador que decodifica as imagens a partir de fsys, que
+// aceita um embed.FS ou os.DirFS(), como image.Image para backends sem navegador.
+// PNG é suportado; registre outros decodificadores com um import em branco
+//
+//	dir: Diretório do mapa dentro de fsys; os nomes de arquivo são relativos a ele
+func NewFileLoader(fsys fs.FS, dir string) ImageLoader {
+	return func(file string) (interface{}, error) {
+		reader, err := fsys.Open(path.Join(dir, file))
+		if err != nil {
+			return nil, fmt.Errorf("tileMap: %v", err)
+		}
+		defer reader.Close()
+
+		decoded, _, err := image.Decode(reader)
+		if err != nil {
+			return nil, fmt.Errorf("tileMap: %v: %v", file, err)
+		}
+		return decoded, nil
+	}
+}
+
+// NewSourceLoader
+// en: Returns a loader that reads the external tilesets from fsys
+//
+//	dir: Directory of the map inside fsys; the file names are relative to it
+//
+// pt_br: Retorna um carregador que lê os tilesets externos de fsys
+//
+//	dir: Diretório do mapa dentro de fsys; os nomes de arquivo são relativos a ele
+func NewSourceLoader(fsys fs.FS, dir string) SourceLoader {
+	return func(file string) ([]byte, error) {
+		return fs.ReadFile(fsys, path.Join(dir, strings.ReplaceAll(file, "\\", "/")))
+	}
+}
+
+// LoadFile
+// en: Reads and parses the map at path, with its external tilesets, then loads
+// its images from the same directory with NewFileLoader()
+//
+// pt_br: Lê e interpreta o mapa em path, com os seus tilesets externos, depois
+// carrega as suas imagens do mesmo diretório com NewFileLoader()
+func LoadFile(path string) (tileMap *Map, err error) {
+	var data []byte
+	if data, err = os.ReadFile(path); err != nil {
+		return nil, fmt.Errorf("tileMap: %v", err)
+	}
+	fsys := os.DirFS(filepath.Dir(path))
+	if tileMap, err = Parse(data, NewSourceLoader(fsys, ".")); err != nil {
+		return nil, err
+	}
+	err = tileMap.LoadImages(NewFileLoader(fsys, "."))
+	return
+}
+
+// LoadFS
+// en: Reads and parses the map name from fsys, which accepts an embed.FS, then
+// loads its tilesets and images from the same directory
+//
+// pt_br: Lê e interpreta o mapa name de fsys, que aceita um embed.FS, depois
+// carrega os seus tilesets e imagens do mesmo diretório
+func LoadFS(fsys fs.FS, name string) (tileMap *Map, err error) {
+	var data []byte
+	if data, err = fs.ReadFile(fsys, name); err != nil {
+		return nil, fmt.Errorf("tileMap: %v", err)
+	}
+	if tileMap, err = Parse(data, NewSourceLoader(fsys, path.Dir(name))); err != nil {
+		return nil, err
+	}
+	err = tileMap.LoadImages(NewFileLoader(fsys, path.Dir(name)))
+	return
+}
+
+// GetImageFiles
+// en: Returns the image files used by the tilesets and image layers, without
+// repetition
+//
+// pt_br: Retorna os arquivos de imagem usados pelos tilesets e camadas de imagem,
+// sem repetição
+func (el *Map) GetImageFiles() (files []string) {
+	found := make(map[string]bool)
+	add := func(file string) {
+		if file != "" && !found[file] {
+			found[file] = true
+			files = append(files, file)
+		}
+	}
+	for _, tileset := range el.Tilesets {
+		add(tileset.Image)
+		ids := make([]int, 0, len(tileset.Tiles))
+		for id := range tileset.Tiles {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		for _, id := range ids {
+			add(tileset.Tiles[id].Image)
+		}
+	}
+	for _, layer := range el.Layers {
+		add(layer.Image)
+	}
+	return
+}
+
+// LoadImages
+// en: Loads every image of the map with the loader. Must be called before drawing
+//
+// pt_br: Carrega todas as imagens do mapa com o carregador. Deve ser chamada antes
+// de desenhar
+func (el *Map) LoadImages(loader ImageLoader) (err error) {
+	images := make(map[string]interface{})
+	for _, file := range el.GetImageFiles() {
+		if images[file], err = loader(file); err != nil {
+			return
+		}
+	}
+	el.images = images
+	return nil
+}
+
+// SetImage
+// en: Defines the image of a file directly, for images created by other means
+//
+// pt_br: Define a imagem de um arquivo diretamente, para imagens criadas por
+// outros meios
+func (el *Map) SetImage(file string, image interface{}) {
+	if el.images == nil {
+		el.images = make(map[string]interface{})
+	}
+	el.images[file] = image
+}
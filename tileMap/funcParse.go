@@ -0,0 +1,303 @@
+package tileMap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SourceLoader
+// en: Returns the content of a file referenced by the map, like an external
+// tileset, with the name relative to the map
+//
+// pt_br: Retorna o conteúdo de um arquivo referenciado pelo mapa, como um tileset
+// externo, com o nome relativo ao mapa
+type SourceLoader func(file string) (data []byte, err error)
+
+// Parse
+// en: Parses a map in the TMX or in the JSON format of Tiled; the format is
+// detected from the content. External tilesets, TSX or JSON, are read with
+// sources, which may be nil when the map has none. Images are loaded later, by
+// LoadImages()
+//
+// Only orthogonal maps are supported. Tile layer data may be CSV, XML or base64,
+// uncompressed or compressed with zlib or gzip; zstd is not supported
+//
+// pt_br: Interpreta um mapa no formato TMX ou no formato JSON do Tiled; o formato é
+// detectado pelo conteúdo. Tilesets externos, TSX ou JSON, são lidos com sources,
+// que pode ser nil quando o mapa não tem nenhum. Imagens são carregadas depois,
+// por LoadImages()
+//
+// Somente mapas ortogonais são suportados. Os dados das camadas de tiles podem ser
+// CSV, XML ou base64, sem compressão ou comprimidos com zlib ou gzip; zstd não é
+// suportado
+func Parse(data []byte, sources SourceLoader) (tileMap *Map, err error) {
+	if isXML(data) {
+		return ParseTMX(data, sources)
+	}
+	return ParseJSON(data, sources)
+}
+
+// isXML
+// en: Returns true when the content starts with a tag
+//
+// pt_br: Retorna true quando o conteúdo começa com uma tag
+func isXML(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(data, "\xef\xbb\xbf \t\r\n"), []byte("<"))
+}
+
+// loadTileset
+// en: Reads an external tileset, TSX or JSON, and makes the files inside it
+// relative to the map
+//
+// pt_br: Lê um tileset externo, TSX ou JSON, e torna os arquivos dentro dele
+// relativos ao mapa
+func loadTileset(source string, firstGid uint32, sources SourceLoader) (tileset *Tileset, err error) {
+	if sources == nil {
+		return nil, fmt.Errorf("tileMap: the external tileset %q needs a SourceLoader", source)
+	}
+	var data []byte
+	if data, err = sources(source); err != nil {
+		return nil, fmt.Errorf("tileMap: %v", err)
+	}
+	if isXML(data) {
+		tileset, err = parseTSX(data)
+	} else {
+		tileset, err = parseTilesetJSON(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tileMap: %v: %v", source, strings.TrimPrefix(err.Error(), "tileMap: "))
+	}
+
+	dir := path.Dir(strings.ReplaceAll(source, "\\", "/"))
+	tileset.Image = relative(dir, tileset.Image)
+	for _, tile := range tileset.Tiles {
+		tile.Image = relative(dir, tile.Image)
+	}
+	tileset.FirstGid = firstGid
+	tileset.Source = source
+	return
+}
+
+// relative
+// en: Joins the directory of an external file to a file name written inside it
+//
+// pt_br: Junta o diretório de um arquivo externo a um nome de arquivo escrito
+// dentro dele
+func relative(dir, file string) string {
+	file = strings.ReplaceAll(file, "\\", "/")
+	if file == "" || dir == "." || path.IsAbs(file) || strings.Contains(file, "://") {
+		return file
+	}
+	return path.Join(dir, file)
+}
+
+// kMaxTiles
+// en: Largest number of tiles of a layer or chunk, 256 MiB of ids, so a corrupt size
+// returns an error instead of exhausting the memory
+//
+// pt_br: Maior número de tiles de uma camada ou pedaço, 256 MiB de ids, assim um
+// tamanho corrompido retorna um erro em vez de esgotar a memória
+const kMaxTiles = 1 << 26
+
+// tileCount
+// en: Returns width * height after checking that both are non-negative and that the
+// product does not exceed kMaxTiles
+//
+// pt_br: Retorna width * height após verificar que ambos são não negativos e que o
+// produto não passa de kMaxTiles
+func tileCount(width, height int) (int, error) {
+	if width < 0 || height < 0 {
+		return 0, fmt.Errorf("tileMap: invalid size %vx%v", width, height)
+	}
+	if width != 0 && height > kMaxTiles/width {
+		return 0, fmt.Errorf("tileMap: size %vx%v exceeds %v tiles", width, height, kMaxTiles)
+	}
+	return width * height, nil
+}
+
+// decodeData
+// en: Decodes the data of a tile layer or chunk, as CSV or base64
+//
+// pt_br: Decodifica os dados de uma camada de tiles ou pedaço, como CSV ou base64
+func decodeData(encoding, compression, text string, size int) (data []uint32, err error) {
+	if size < 0 || size > kMaxTiles {
+		return nil, fmt.Errorf("tileMap: invalid number of tiles %v", size)
+	}
+	switch encoding {
+	case "csv":
+		data = make([]uint32, 0, size)
+		for _, field := range strings.Split(text, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			var gid uint64
+			if gid, err = strconv.ParseUint(field, 10, 32); err != nil {
+				return nil, fmt.Errorf("tileMap: invalid tile %q", field)
+			}
+			data = append(data, uint32(gid))
+		}
+
+	case "base64":
+		var raw []byte
+		if raw, err = base64.StdEncoding.DecodeString(strings.TrimSpace(text)); err != nil {
+			return nil, fmt.Errorf("tileMap: %v", err)
+		}
+		var reader io.ReadCloser
+		switch compression {
+		case "":
+		case "zlib":
+			reader, err = zlib.NewReader(bytes.NewReader(raw))
+		case "gzip":
+			reader, err = gzip.NewReader(bytes.NewReader(raw))
+		default:
+			return nil, fmt.Errorf("tileMap: unsupported compression %q", compression)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tileMap: %v", err)
+		}
+		if reader != nil {
+			raw, err = io.ReadAll(reader)
+			_ = reader.Close()
+			if err != nil {
+				return nil, fmt.Errorf("tileMap: %v", err)
+			}
+		}
+		if len(raw)%4 != 0 {
+			return nil, fmt.Errorf("tileMap: tile data of %v bytes is not a list of 32 bit ids", len(raw))
+		}
+		data = make([]uint32, len(raw)/4)
+		for i := range data {
+			data[i] = binary.LittleEndian.Uint32(raw[i*4:])
+		}
+
+	default:
+		return nil, fmt.Errorf("tileMap: unsupported encoding %q", encoding)
+	}
+
+	if len(data) != size {
+		return nil, fmt.Errorf("tileMap: expected %v tiles, found %v", size, len(data))
+	}
+	return
+}
+
+// chunk
+// en: Part of the data of a tile layer of an infinite map
+//
+// pt_br: Parte dos dados de uma camada de tiles de um mapa infinito
+type chunk struct {
+	x, y          int
+	width, height int
+	data          []uint32
+}
+
+// mergeChunks
+// en: Copies the chunks into a single grid that covers all of them. Returns an
+// error when the grid is larger than kMaxTiles
+//
+// pt_br: Copia os pedaços em uma única grade que cobre todos eles. Retorna um erro
+// quando a grade é maior do que kMaxTiles
+func mergeChunks(layer *Layer, chunks []chunk) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+	left, top := chunks[0].x, chunks[0].y
+	right, bottom := left, top
+	for _, part := range chunks {
+		if part.x < left {
+			left = part.x
+		}
+		if part.y < top {
+			top = part.y
+		}
+		if part.x+part.width > right {
+			right = part.x + part.width
+		}
+		if part.y+part.height > bottom {
+			bottom = part.y + part.height
+		}
+	}
+	size, err := tileCount(right-left, bottom-top)
+	if err != nil {
+		return err
+	}
+	layer.X, layer.Y = left, top
+	layer.Width, layer.Height = right-left, bottom-top
+	layer.Data = make([]uint32, size)
+	for _, part := range chunks {
+		for row := 0; row < part.height; row += 1 {
+			start := (part.y-top+row)*layer.Width + part.x - left
+			copy(layer.Data[start:start+part.width], part.data[row*part.width:(row+1)*part.width])
+		}
+	}
+	return nil
+}
+
+// newLayer
+// en: Returns a layer with the defaults of Tiled
+//
+// pt_br: Retorna uma camada com os padrões do Tiled
+func newLayer(kind LayerKind) *Layer {
+	return &Layer{Kind: kind, Visible: true, Opacity: 1, ParallaxX: 1, ParallaxY: 1, Properties: make(Properties)}
+}
+
+// nest
+// en: Applies the values of a group to a layer inside it
+//
+// pt_br: Aplica os valores de um grupo a uma camada dentro dele
+func nest(layer, group *Layer) {
+	if group == nil {
+		return
+	}
+	if group.Group != "" {
+		layer.Group = group.Group + "/" + group.Name
+	} else {
+		layer.Group = group.Name
+	}
+	layer.Visible = layer.Visible && group.Visible
+	layer.Opacity *= group.Opacity
+	layer.OffsetX += group.OffsetX
+	layer.OffsetY += group.OffsetY
+	layer.ParallaxX *= group.ParallaxX
+	layer.ParallaxY *= group.ParallaxY
+}
+
+// check
+// en: Validates the map after parsing and sorts the tilesets
+//
+// pt_br: Valida o mapa depois da interpretação e ordena os tilesets
+func (el *Map) check(orientation string) error {
+	if orientation != "" && orientation != "orthogonal" {
+		return fmt.Errorf("tileMap: %v maps are not supported, only orthogonal", orientation)
+	}
+	if el.TileWidth <= 0 || el.TileHeight <= 0 {
+		return fmt.Errorf("tileMap: invalid tile size %vx%v", el.TileWidth, el.TileHeight)
+	}
+	sort.SliceStable(el.Tilesets, func(i, j int) bool {
+		return el.Tilesets[i].FirstGid < el.Tilesets[j].FirstGid
+	})
+	for _, layer := range el.Layers {
+		if layer.Kind == KLayerTile && len(layer.Data) != layer.Width*layer.Height {
+			return fmt.Errorf("tileMap: layer %q: expected %v tiles, found %v", layer.Name, layer.Width*layer.Height, len(layer.Data))
+		}
+	}
+	return nil
+}
+
+// floorDiv
+// en: Returns the integer part of value / size, rounded down
+//
+// pt_br: Retorna a parte inteira de value / size, arredondada para baixo
+func floorDiv(value, size float64) int {
+	return int(math.Floor(value / size))
+}
@@ -0,0 +1,414 @@
+package tileMap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// jsonMap
+// en: JSON form of a map
+//
+// pt_br: Forma JSON de um mapa
+type jsonMap struct {
+	Orientation     string         `json:"orientation"`
+	Width           int            `json:"width"`
+	Height          int            `json:"height"`
+	TileWidth       int            `json:"tilewidth"`
+	TileHeight      int            `json:"tileheight"`
+	Infinite        bool           `json:"infinite"`
+	BackgroundColor string         `json:"backgroundcolor"`
+	ParallaxOriginX float64        `json:"parallaxoriginx"`
+	ParallaxOriginY float64        `json:"parallaxoriginy"`
+	Properties      []jsonProperty `json:"properties"`
+	Tilesets        []jsonTileset  `json:"tilesets"`
+	Layers          []jsonLayer    `json:"layers"`
+}
+
+// jsonProperty
+// en: JSON form of a custom property
+//
+// pt_br: Forma JSON de uma propriedade personalizada
+type jsonProperty struct {
+	Name  string          `json:"name"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// jsonTileset
+// en: JSON form of a tileset, embedded or external
+//
+// pt_br: Forma JSON de um tileset, embutido ou externo
+type jsonTileset struct {
+	FirstGid    uint32             `json:"firstgid"`
+	Source      string             `json:"source"`
+	Name        string             `json:"name"`
+	Class       string             `json:"class"`
+	TileWidth   int                `json:"tilewidth"`
+	TileHeight  int                `json:"tileheight"`
+	Spacing     int                `json:"spacing"`
+	Margin      int                `json:"margin"`
+	TileCount   int                `json:"tilecount"`
+	Columns     int                `json:"columns"`
+	Image       string             `json:"image"`
+	ImageWidth  int                `json:"imagewidth"`
+	ImageHeight int                `json:"imageheight"`
+	TileOffset  struct{ X, Y int } `json:"tileoffset"`
+	Properties  []jsonProperty     `json:"properties"`
+	Tiles       []struct {
+		Id          int            `json:"id"`
+		Type        string         `json:"type"`
+		Class       string         `json:"class"`
+		Image       string         `json:"image"`
+		ImageWidth  int            `json:"imagewidth"`
+		ImageHeight int            `json:"imageheight"`
+		Properties  []jsonProperty `json:"properties"`
+		Animation   []struct {
+			TileId   int `json:"tileid"`
+			Duration int `json:"duration"`
+		} `json:"animation"`
+		ObjectGroup *jsonLayer `json:"objectgroup"`
+	} `json:"tiles"`
+}
+
+// jsonLayer
+// en: JSON form of a layer of any kind
+//
+// pt_br: Forma JSON de uma camada de qualquer tipo
+type jsonLayer struct {
+	Id          int             `json:"id"`
+	Name        string          `json:"name"`
+	Class       string          `json:"class"`
+	Type        string          `json:"type"`
+	Visible     *bool           `json:"visible"`
+	Opacity     *float64        `json:"opacity"`
+	OffsetX     float64         `json:"offsetx"`
+	OffsetY     float64         `json:"offsety"`
+	ParallaxX   *float64        `json:"parallaxx"`
+	ParallaxY   *float64        `json:"parallaxy"`
+	Properties  []jsonProperty  `json:"properties"`
+	Width       int             `json:"width"`
+	Height      int             `json:"height"`
+	Encoding    string          `json:"encoding"`
+	Compression string          `json:"compression"`
+	Data        json.RawMessage `json:"data"`
+	Chunks      []struct {
+		X      int             `json:"x"`
+		Y      int             `json:"y"`
+		Width  int             `json:"width"`
+		Height int             `json:"height"`
+		Data   json.RawMessage `json:"data"`
+	} `json:"chunks"`
+	Objects     []jsonObject `json:"objects"`
+	Image       string       `json:"image"`
+	ImageWidth  int          `json:"imagewidth"`
+	ImageHeight int          `json:"imageheight"`
+	RepeatX     bool         `json:"repeatx"`
+	RepeatY     bool         `json:"repeaty"`
+	Layers      []jsonLayer  `json:"layers"`
+}
+
+// jsonObject
+// en: JSON form of an object
+//
+// pt_br: Forma JSON de um objeto
+type jsonObject struct {
+	Id       int                `json:"id"`
+	Name     string             `json:"name"`
+	Type     string             `json:"type"`
+	Class    string             `json:"class"`
+	Visible  *bool              `json:"visible"`
+	X        float64            `json:"x"`
+	Y        float64            `json:"y"`
+	Width    float64            `json:"width"`
+	Height   float64            `json:"height"`
+	Rotation float64            `json:"rotation"`
+	Gid      uint32             `json:"gid"`
+	Ellipse  bool               `json:"ellipse"`
+	Point    bool               `json:"point"`
+	Polygon  []collision.Vector `json:"polygon"`
+	Polyline []collision.Vector `json:"polyline"`
+	Text     *struct {
+		Text string `json:"text"`
+	} `json:"text"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+// ParseJSON
+// en: Parses a map in the JSON format of Tiled; see Parse()
+//
+// pt_br: Interpreta um mapa no formato JSON do Tiled; veja Parse()
+func ParseJSON(data []byte, sources SourceLoader) (tileMap *Map, err error) {
+	var document jsonMap
+	if err = json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("tileMap: %v", err)
+	}
+
+	tileMap = &Map{
+		Width:           document.Width,
+		Height:          document.Height,
+		TileWidth:       document.TileWidth,
+		TileHeight:      document.TileHeight,
+		Infinite:        document.Infinite,
+		ParallaxOriginX: document.ParallaxOriginX,
+		ParallaxOriginY: document.ParallaxOriginY,
+		Properties:      convertProperties(document.Properties),
+	}
+	if tileMap.BackgroundColor, err = parseColor(document.BackgroundColor); err != nil {
+		return nil, err
+	}
+
+	for _, item := range document.Tilesets {
+		var tileset *Tileset
+		if item.Source != "" {
+			tileset, err = loadTileset(item.Source, item.FirstGid, sources)
+		} else {
+			tileset, err = item.convert()
+		}
+		if err != nil {
+			return nil, err
+		}
+		tileset.FirstGid = item.FirstGid
+		tileMap.Tilesets = append(tileMap.Tilesets, tileset)
+	}
+
+	if err = convertLayers(tileMap, document.Layers, nil); err != nil {
+		return nil, err
+	}
+	return tileMap, tileMap.check(document.Orientation)
+}
+
+// parseTilesetJSON
+// en: Parses an external tileset in the JSON format
+//
+// pt_br: Interpreta um tileset externo no formato JSON
+func parseTilesetJSON(data []byte) (tileset *Tileset, err error) {
+	var document jsonTileset
+	if err = json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("tileMap: %v", err)
+	}
+	return document.convert()
+}
+
+// convert
+// en: Converts the JSON form into a tileset
+//
+// pt_br: Converte a forma JSON em um tileset
+func (el jsonTileset) convert() (tileset *Tileset, err error) {
+	tileset = &Tileset{
+		Name:        el.Name,
+		Class:       el.Class,
+		TileWidth:   el.TileWidth,
+		TileHeight:  el.TileHeight,
+		Spacing:     el.Spacing,
+		Margin:      el.Margin,
+		TileCount:   el.TileCount,
+		Columns:     el.Columns,
+		OffsetX:     el.TileOffset.X,
+		OffsetY:     el.TileOffset.Y,
+		Image:       el.Image,
+		ImageWidth:  el.ImageWidth,
+		ImageHeight: el.ImageHeight,
+		Properties:  convertProperties(el.Properties),
+		Tiles:       make(map[int]*Tile),
+	}
+	for _, item := range el.Tiles {
+		tile := &Tile{
+			Id:          item.Id,
+			Class:       item.Class,
+			Properties:  convertProperties(item.Properties),
+			Image:       item.Image,
+			ImageWidth:  item.ImageWidth,
+			ImageHeight: item.ImageHeight,
+		}
+		if tile.Class == "" {
+			tile.Class = item.Type
+		}
+		for _, frame := range item.Animation {
+			tile.Animation = append(tile.Animation, Frame{
+				TileId:   frame.TileId,
+				Duration: time.Duration(frame.Duration) * time.Millisecond,
+			})
+		}
+		if item.ObjectGroup != nil {
+			tile.Objects = convertObjects(item.ObjectGroup.Objects)
+		}
+		tileset.Tiles[tile.Id] = tile
+	}
+	return tileset, nil
+}
+
+// convertLayers
+// en: Converts the layers of the map or of a group, in order
+//
+// pt_br: Converte as camadas do mapa ou de um grupo, em ordem
+func convertLayers(tileMap *Map, items []jsonLayer, group *Layer) (err error) {
+	for _, item := range items {
+		var layer *Layer
+		switch item.Type {
+		case "tilelayer", "group":
+			layer = newLayer(KLayerTile)
+		case "objectgroup":
+			layer = newLayer(KLayerObject)
+		case "imagelayer":
+			layer = newLayer(KLayerImage)
+		default:
+			continue
+		}
+		layer.Id = item.Id
+		layer.Name = item.Name
+		layer.Class = item.Class
+		layer.OffsetX = item.OffsetX
+		layer.OffsetY = item.OffsetY
+		layer.Properties = convertProperties(item.Properties)
+		if item.Visible != nil {
+			layer.Visible = *item.Visible
+		}
+		if item.Opacity != nil {
+			layer.Opacity = *item.Opacity
+		}
+		if item.ParallaxX != nil {
+			layer.ParallaxX = *item.ParallaxX
+		}
+		if item.ParallaxY != nil {
+			layer.ParallaxY = *item.ParallaxY
+		}
+		nest(layer, group)
+
+		switch item.Type {
+		case "group":
+			if err = convertLayers(tileMap, item.Layers, layer); err != nil {
+				return
+			}
+			continue
+		case "tilelayer":
+			if err = item.data(layer); err != nil {
+				return fmt.Errorf("tileMap: layer %q: %v", layer.Name, strings.TrimPrefix(err.Error(), "tileMap: "))
+			}
+		case "objectgroup":
+			layer.Objects = convertObjects(item.Objects)
+		case "imagelayer":
+			layer.Image = item.Image
+			layer.ImageWidth = item.ImageWidth
+			layer.ImageHeight = item.ImageHeight
+			layer.RepeatX = item.RepeatX
+			layer.RepeatY = item.RepeatY
+		}
+		tileMap.Layers = append(tileMap.Layers, layer)
+	}
+	return nil
+}
+
+// data
+// en: Converts the data of a tile layer, with or without chunks
+//
+// pt_br: Converte os dados de uma camada de tiles, com ou sem pedaços
+func (el jsonLayer) data(layer *Layer) (err error) {
+	decode := func(raw json.RawMessage, size int) (data []uint32, err error) {
+		if len(raw) == 0 {
+			return make([]uint32, size), nil
+		}
+		if el.Encoding == "base64" {
+			var text string
+			if err = json.Unmarshal(raw, &text); err != nil {
+				return nil, fmt.Errorf("tileMap: %v", err)
+			}
+			return decodeData(el.Encoding, el.Compression, text, size)
+		}
+		if err = json.Unmarshal(raw, &data); err != nil {
+			return nil, fmt.Errorf("tileMap: %v", err)
+		}
+		if len(data) != size {
+			return nil, fmt.Errorf("tileMap: expected %v tiles, found %v", size, len(data))
+		}
+		return
+	}
+
+	if len(el.Chunks) != 0 {
+		chunks := make([]chunk, len(el.Chunks))
+		for i, item := range el.Chunks {
+			chunks[i] = chunk{x: item.X, y: item.Y, width: item.Width, height: item.Height}
+			var size int
+			if size, err = tileCount(item.Width, item.Height); err != nil {
+				return
+			}
+			if chunks[i].data, err = decode(item.Data, size); err != nil {
+				return
+			}
+		}
+		return mergeChunks(layer, chunks)
+	}
+	size, err := tileCount(el.Width, el.Height)
+	if err != nil {
+		return
+	}
+	layer.Width, layer.Height = el.Width, el.Height
+	layer.Data, err = decode(el.Data, size)
+	return
+}
+
+// convertObjects
+// en: Converts the objects of an object layer
+//
+// pt_br: Converte os objetos de uma camada de objetos
+func convertObjects(items []jsonObject) (objects []*Object) {
+	objects = make([]*Object, 0, len(items))
+	for _, item := range items {
+		object := &Object{
+			Id:         item.Id,
+			Name:       item.Name,
+			Class:      item.Class,
+			Visible:    item.Visible == nil || *item.Visible,
+			X:          item.X,
+			Y:          item.Y,
+			Width:      item.Width,
+			Height:     item.Height,
+			Rotation:   item.Rotation,
+			Gid:        item.Gid,
+			Properties: convertProperties(item.Properties),
+		}
+		if object.Class == "" {
+			object.Class = item.Type
+		}
+		switch {
+		case item.Gid != 0:
+			object.Kind = KObjectTile
+		case item.Ellipse:
+			object.Kind = KObjectEllipse
+		case item.Point:
+			object.Kind = KObjectPoint
+		case item.Polygon != nil:
+			object.Kind = KObjectPolygon
+			object.Points = item.Polygon
+		case item.Polyline != nil:
+			object.Kind = KObjectPolyline
+			object.Points = item.Polyline
+		case item.Text != nil:
+			object.Kind = KObjectText
+			object.Text = item.Text.Text
+		}
+		objects = append(objects, object)
+	}
+	return
+}
+
+// convertProperties
+// en: Converts the list of custom properties into Properties; strings are
+// unquoted and the other values keep their JSON text
+//
+// pt_br: Converte a lista de propriedades personalizadas em Properties; strings
+// perdem as aspas e os outros valores mantêm o seu texto JSON
+func convertProperties(items []jsonProperty) Properties {
+	properties := make(Properties)
+	for _, item := range items {
+		var text string
+		if json.Unmarshal(item.Value, &text) != nil {
+			text = string(item.Value)
+		}
+		properties[item.Name] = text
+	}
+	return properties
+}
@@ -0,0 +1,480 @@
+package tileMap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// element
+// en: Generic XML element, which keeps the order of the layers of the TMX format
+//
+// pt_br: Elemento XML genérico, que mantém a ordem das camadas do formato TMX
+type element struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Content  string     `xml:",chardata"`
+	Children []element  `xml:",any"`
+}
+
+// get
+// en: Returns the value of the attribute
+//
+// pt_br: Retorna o valor do atributo
+func (el element) get(key string) (value string, found bool) {
+	for _, attribute := range el.Attrs {
+		if attribute.Name.Local == key {
+			return attribute.Value, true
+		}
+	}
+	return "", false
+}
+
+// child
+// en: Returns the first child with the name
+//
+// pt_br: Retorna o primeiro filho com o nome
+func (el element) child(name string) (child element, found bool) {
+	for _, child = range el.Children {
+		if child.XMLName.Local == name {
+			return child, true
+		}
+	}
+	return element{}, false
+}
+
+// xmlReader
+// en: Converts attribute values keeping the first error
+//
+// pt_br: Converte valores de atributos guardando o primeiro erro
+type xmlReader struct {
+	err error
+}
+
+func (el *xmlReader) string(node element, key string) string {
+	value, _ := node.get(key)
+	return value
+}
+
+func (el *xmlReader) float(node element, key string, fallback float64) float64 {
+	text, found := node.get(key)
+	if !found || el.err != nil {
+		return fallback
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		el.err = fmt.Errorf("<%v>: invalid %v %q", node.XMLName.Local, key, text)
+	}
+	return value
+}
+
+func (el *xmlReader) int(node element, key string, fallback int) int {
+	value := el.float(node, key, float64(fallback))
+	if el.err == nil && (value < math.MinInt32 || value > math.MaxInt32) {
+		el.err = fmt.Errorf("<%v>: %v out of range", node.XMLName.Local, key)
+		return fallback
+	}
+	return int(value)
+}
+
+func (el *xmlReader) bool(node element, key string, fallback bool) bool {
+	text, found := node.get(key)
+	if !found || el.err != nil {
+		return fallback
+	}
+	value, err := strconv.ParseBool(strings.TrimSpace(text))
+	if err != nil {
+		el.err = fmt.Errorf("<%v>: invalid %v %q", node.XMLName.Local, key, text)
+	}
+	return value
+}
+
+func (el *xmlReader) color(node element, key string) color.RGBA {
+	text, _ := node.get(key)
+	value, err := parseColor(text)
+	if err != nil && el.err == nil {
+		el.err = err
+	}
+	return value
+}
+
+func (el *xmlReader) class(node element) string {
+	if value, found := node.get("class"); found {
+		return value
+	}
+	return el.string(node, "type")
+}
+
+func (el *xmlReader) properties(node element) Properties {
+	properties := make(Properties)
+	list, found := node.child("properties")
+	if !found {
+		return properties
+	}
+	for _, property := range list.Children {
+		if property.XMLName.Local != "property" {
+			continue
+		}
+		value, found := property.get("value")
+		if !found {
+			value = property.Content
+			if members, found := property.child("properties"); found {
+				value = el.members(members)
+			}
+		}
+		properties[el.string(property, "name")] = value
+	}
+	return properties
+}
+
+// members
+// en: Converts the members of a class property to JSON, like the format of Tiled
+//
+// pt_br: Converte os membros de uma propriedade de classe para JSON, como o
+// formato do Tiled
+func (el *xmlReader) members(node element) string {
+	parts := make([]string, 0, len(node.Children))
+	for _, property := range node.Children {
+		value, found := property.get("value")
+		if members, nested := property.child("properties"); !found && nested {
+			value = el.members(members)
+		} else {
+			switch el.string(property, "type") {
+			case "int", "float", "bool", "object":
+			default:
+				value = strconv.Quote(value)
+			}
+		}
+		parts = append(parts, strconv.Quote(el.string(property, "name"))+":"+value)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// ParseTMX
+// en: Parses a map in the TMX format of Tiled; see Parse()
+//
+// pt_br: Interpreta um mapa no formato TMX do Tiled; veja Parse()
+func ParseTMX(data []byte, sources SourceLoader) (tileMap *Map, err error) {
+	var root element
+	if err = xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("tileMap: %v", err)
+	}
+	if root.XMLName.Local != "map" {
+		return nil, fmt.Errorf("tileMap: expected <map>, found <%v>", root.XMLName.Local)
+	}
+
+	values := &xmlReader{}
+	tileMap = &Map{
+		Width:           values.int(root, "width", 0),
+		Height:          values.int(root, "height", 0),
+		TileWidth:       values.int(root, "tilewidth", 0),
+		TileHeight:      values.int(root, "tileheight", 0),
+		Infinite:        values.bool(root, "infinite", false),
+		BackgroundColor: values.color(root, "backgroundcolor"),
+		ParallaxOriginX: values.float(root, "parallaxoriginx", 0),
+		ParallaxOriginY: values.float(root, "parallaxoriginy", 0),
+		Properties:      values.properties(root),
+	}
+
+	for _, child := range root.Children {
+		if child.XMLName.Local != "tileset" {
+			continue
+		}
+		var tileset *Tileset
+		firstGid := uint32(values.int(child, "firstgid", 1))
+		if source := values.string(child, "source"); source != "" {
+			tileset, err = loadTileset(source, firstGid, sources)
+		} else {
+			tileset, err = values.tileset(child)
+		}
+		if err != nil {
+			return nil, err
+		}
+		tileset.FirstGid = firstGid
+		tileMap.Tilesets = append(tileMap.Tilesets, tileset)
+	}
+
+	if err = values.layers(tileMap, root, nil); err != nil {
+		return nil, err
+	}
+	if values.err != nil {
+		return nil, fmt.Errorf("tileMap: %v", values.err)
+	}
+	return tileMap, tileMap.check(values.string(root, "orientation"))
+}
+
+// parseTSX
+// en: Parses an external tileset in the TSX format
+//
+// pt_br: Interpreta um tileset externo no formato TSX
+func parseTSX(data []byte) (tileset *Tileset, err error) {
+	var root element
+	if err = xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("tileMap: %v", err)
+	}
+	if root.XMLName.Local != "tileset" {
+		return nil, fmt.Errorf("tileMap: expected <tileset>, found <%v>", root.XMLName.Local)
+	}
+	values := &xmlReader{}
+	return values.tileset(root)
+}
+
+// tileset
+// en: Converts a <tileset> element
+//
+// pt_br: Converte um elemento <tileset>
+func (el *xmlReader) tileset(node element) (tileset *Tileset, err error) {
+	tileset = &Tileset{
+		Name:       el.string(node, "name"),
+		Class:      el.string(node, "class"),
+		TileWidth:  el.int(node, "tilewidth", 0),
+		TileHeight: el.int(node, "tileheight", 0),
+		Spacing:    el.int(node, "spacing", 0),
+		Margin:     el.int(node, "margin", 0),
+		TileCount:  el.int(node, "tilecount", 0),
+		Columns:    el.int(node, "columns", 0),
+		Properties: el.properties(node),
+		Tiles:      make(map[int]*Tile),
+	}
+	if offset, found := node.child("tileoffset"); found {
+		tileset.OffsetX = el.int(offset, "x", 0)
+		tileset.OffsetY = el.int(offset, "y", 0)
+	}
+	if image, found := node.child("image"); found {
+		tileset.Image = el.string(image, "source")
+		tileset.ImageWidth = el.int(image, "width", 0)
+		tileset.ImageHeight = el.int(image, "height", 0)
+	}
+
+	for _, child := range node.Children {
+		if child.XMLName.Local != "tile" {
+			continue
+		}
+		tile := &Tile{
+			Id:         el.int(child, "id", 0),
+			Class:      el.class(child),
+			Properties: el.properties(child),
+		}
+		if image, found := child.child("image"); found {
+			tile.Image = el.string(image, "source")
+			tile.ImageWidth = el.int(image, "width", 0)
+			tile.ImageHeight = el.int(image, "height", 0)
+		}
+		if animation, found := child.child("animation"); found {
+			for _, frame := range animation.Children {
+				if frame.XMLName.Local == "frame" {
+					tile.Animation = append(tile.Animation, Frame{
+						TileId:   el.int(frame, "tileid", 0),
+						Duration: time.Duration(el.int(frame, "duration", 0)) * time.Millisecond,
+					})
+				}
+			}
+		}
+		if group, found := child.child("objectgroup"); found {
+			tile.Objects = el.objects(group)
+		}
+		tileset.Tiles[tile.Id] = tile
+	}
+	if el.err != nil {
+		return nil, fmt.Errorf("tileMap: %v", el.err)
+	}
+	return tileset, nil
+}
+
+// layers
+// en: Converts the layers inside the map or a group, in order
+//
+// pt_br: Converte as camadas dentro do mapa ou de um grupo, em ordem
+func (el *xmlReader) layers(tileMap *Map, parent element, group *Layer) (err error) {
+	for _, child := range parent.Children {
+		var layer *Layer
+		switch child.XMLName.Local {
+		case "layer":
+			layer = newLayer(KLayerTile)
+		case "objectgroup":
+			layer = newLayer(KLayerObject)
+		case "imagelayer":
+			layer = newLayer(KLayerImage)
+		case "group":
+			layer = newLayer(KLayerTile)
+		default:
+			continue
+		}
+		layer.Id = el.int(child, "id", 0)
+		layer.Name = el.string(child, "name")
+		layer.Class = el.string(child, "class")
+		layer.Visible = el.bool(child, "visible", true)
+		layer.Opacity = el.float(child, "opacity", 1)
+		layer.OffsetX = el.float(child, "offsetx", 0)
+		layer.OffsetY = el.float(child, "offsety", 0)
+		layer.ParallaxX = el.float(child, "parallaxx", 1)
+		layer.ParallaxY = el.float(child, "parallaxy", 1)
+		layer.Properties = el.properties(child)
+		nest(layer, group)
+
+		switch child.XMLName.Local {
+		case "group":
+			if err = el.layers(tileMap, child, layer); err != nil {
+				return
+			}
+			continue
+		case "layer":
+			layer.Width = el.int(child, "width", 0)
+			layer.Height = el.int(child, "height", 0)
+			if err = el.data(layer, child); err != nil {
+				return fmt.Errorf("tileMap: layer %q: %v", layer.Name, strings.TrimPrefix(err.Error(), "tileMap: "))
+			}
+		case "objectgroup":
+			layer.Objects = el.objects(child)
+		case "imagelayer":
+			layer.RepeatX = el.bool(child, "repeatx", false)
+			layer.RepeatY = el.bool(child, "repeaty", false)
+			if image, found := child.child("image"); found {
+				layer.Image = el.string(image, "source")
+				layer.ImageWidth = el.int(image, "width", 0)
+				layer.ImageHeight = el.int(image, "height", 0)
+			}
+		}
+		tileMap.Layers = append(tileMap.Layers, layer)
+	}
+	return nil
+}
+
+// data
+// en: Converts the <data> element of a tile layer, with or without chunks
+//
+// pt_br: Converte o elemento <data> de uma camada de tiles, com ou sem pedaços
+func (el *xmlReader) data(layer *Layer, node element) (err error) {
+	size, err := tileCount(layer.Width, layer.Height)
+	if err != nil {
+		return err
+	}
+	data, found := node.child("data")
+	if !found {
+		layer.Data = make([]uint32, size)
+		return nil
+	}
+	encoding := el.string(data, "encoding")
+	compression := el.string(data, "compression")
+
+	decode := func(node element, size int) ([]uint32, error) {
+		if encoding != "" {
+			return decodeData(encoding, compression, node.Content, size)
+		}
+		gids := make([]uint32, 0, size)
+		for _, tile := range node.Children {
+			if tile.XMLName.Local == "tile" {
+				gid, _ := strconv.ParseUint(el.string(tile, "gid"), 10, 32)
+				gids = append(gids, uint32(gid))
+			}
+		}
+		if len(gids) != size {
+			return nil, fmt.Errorf("tileMap: expected %v tiles, found %v", size, len(gids))
+		}
+		return gids, nil
+	}
+
+	chunks := make([]chunk, 0)
+	for _, child := range data.Children {
+		if child.XMLName.Local != "chunk" {
+			continue
+		}
+		part := chunk{
+			x:      el.int(child, "x", 0),
+			y:      el.int(child, "y", 0),
+			width:  el.int(child, "width", 0),
+			height: el.int(child, "height", 0),
+		}
+		var count int
+		if count, err = tileCount(part.width, part.height); err != nil {
+			return
+		}
+		if part.data, err = decode(child, count); err != nil {
+			return
+		}
+		chunks = append(chunks, part)
+	}
+	if len(chunks) != 0 {
+		return mergeChunks(layer, chunks)
+	}
+	layer.Data, err = decode(data, size)
+	return
+}
+
+// objects
+// en: Converts the <object> elements of an object layer
+//
+// pt_br: Converte os elementos <object> de uma camada de objetos
+func (el *xmlReader) objects(node element) (objects []*Object) {
+	objects = make([]*Object, 0)
+	for _, child := range node.Children {
+		if child.XMLName.Local != "object" {
+			continue
+		}
+		object := &Object{
+			Id:         el.int(child, "id", 0),
+			Name:       el.string(child, "name"),
+			Class:      el.class(child),
+			Visible:    el.bool(child, "visible", true),
+			X:          el.float(child, "x", 0),
+			Y:          el.float(child, "y", 0),
+			Width:      el.float(child, "width", 0),
+			Height:     el.float(child, "height", 0),
+			Rotation:   el.float(child, "rotation", 0),
+			Properties: el.properties(child),
+		}
+		if gid, found := child.get("gid"); found {
+			value, _ := strconv.ParseUint(gid, 10, 32)
+			object.Gid = uint32(value)
+			object.Kind = KObjectTile
+		}
+		for _, shape := range child.Children {
+			switch shape.XMLName.Local {
+			case "ellipse":
+				object.Kind = KObjectEllipse
+			case "point":
+				object.Kind = KObjectPoint
+			case "polygon", "polyline":
+				object.Kind = KObjectPolygon
+				if shape.XMLName.Local == "polyline" {
+					object.Kind = KObjectPolyline
+				}
+				object.Points = el.points(shape)
+			case "text":
+				object.Kind = KObjectText
+				object.Text = shape.Content
+			}
+		}
+		objects = append(objects, object)
+	}
+	return
+}
+
+// points
+// en: Converts the points attribute, "x,y x,y ..."
+//
+// pt_br: Converte o atributo points, "x,y x,y ..."
+func (el *xmlReader) points(node element) (points []collision.Vector) {
+	for _, pair := range strings.Fields(el.string(node, "points")) {
+		coordinates := strings.Split(pair, ",")
+		if len(coordinates) != 2 {
+			if el.err == nil {
+				el.err = fmt.Errorf("<%v>: invalid point %q", node.XMLName.Local, pair)
+			}
+			return nil
+		}
+		x, errX := strconv.ParseFloat(coordinates[0], 64)
+		y, errY := strconv.ParseFloat(coordinates[1], 64)
+		if (errX != nil || errY != nil) && el.err == nil {
+			el.err = fmt.Errorf("<%v>: invalid point %q", node.XMLName.Local, pair)
+		}
+		points = append(points, collision.Vector{X: x, Y: y})
+	}
+	return
+}
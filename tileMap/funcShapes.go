@@ -0,0 +1,313 @@
+package tileMap
+
+import (
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// kEllipseSides
+// en: Sides of the polygons that replace ellipses and rotated circles
+//
+// pt_br: Lados dos polígonos que substituem elipses e círculos girados
+const kEllipseSides = 16
+
+// GetShapes
+// en: Returns the collision shapes of the object, in pixels of the map
+//
+//	Rectangles, texts and tile objects: AABB, or Polygon when rotated
+//	Ellipses: Circle when round and not rotated, otherwise a Polygon of 16 sides
+//	Polygons: Polygon when convex; concave ones are split in triangles
+//	Polylines: One Polygon of two vertices per segment
+//	Points and objects without area: none
+//
+// pt_br: Retorna as formas de colisão do objeto, em pixels do mapa
+//
+//	Retângulos, textos e objetos tile: AABB, ou Polygon quando girados
+//	Elipses: Circle quando redondas e não giradas, caso contrário um Polygon de
+//	         16 lados
+//	Polígonos: Polygon quando convexos; os côncavos são divididos em triângulos
+//	Polilinhas: Um Polygon de dois vértices por segmento
+//	Pontos e objetos sem área: nenhuma
+func (el *Object) GetShapes() (shapes []collision.Shape) {
+	switch el.Kind {
+	case KObjectRectangle, KObjectText, KObjectTile:
+		if el.Width <= 0 || el.Height <= 0 {
+			return nil
+		}
+		rectangle := el.rectangle()
+		if el.Rotation == 0 {
+			return []collision.Shape{rectangle.GetBounds()}
+		}
+		return []collision.Shape{rectangle}
+
+	case KObjectEllipse:
+		if el.Width <= 0 || el.Height <= 0 {
+			return nil
+		}
+		if el.Width == el.Height && el.Rotation == 0 {
+			return []collision.Shape{collision.NewCircle(el.X+el.Width/2, el.Y+el.Height/2, el.Width/2)}
+		}
+		points := make([]collision.Vector, kEllipseSides)
+		for i := range points {
+			sin, cos := math.Sincos(2 * math.Pi * float64(i) / kEllipseSides)
+			points[i] = collision.Vector{X: el.Width / 2 * (1 + cos), Y: el.Height / 2 * (1 + sin)}
+		}
+		return []collision.Shape{el.transform(points)}
+
+	case KObjectPolygon:
+		if len(el.Points) < 3 {
+			return nil
+		}
+		polygon := el.transform(el.Points)
+		if convex(polygon.Vertices) {
+			return []collision.Shape{polygon}
+		}
+		for _, triangle := range triangulate(polygon.Vertices) {
+			shapes = append(shapes, collision.Polygon{Vertices: triangle})
+		}
+		return
+
+	case KObjectPolyline:
+		polyline := el.transform(el.Points)
+		for i := 0; i+1 < len(polyline.Vertices); i += 1 {
+			shapes = append(shapes, collision.Polygon{Vertices: []collision.Vector{polyline.Vertices[i], polyline.Vertices[i+1]}})
+		}
+		return
+	}
+	return nil
+}
+
+// GetShapes
+// en: Returns the collision shapes of every object of an object layer, visible or
+// not, with the offset of the layer; the parallax is ignored. Collision layers
+// are often hidden in Tiled
+//
+// pt_br: Retorna as formas de colisão de todos os objetos de uma camada de
+// objetos, visíveis ou não, com o deslocamento da camada; a paralaxe é ignorada.
+// Camadas de colisão são frequentemente escondidas no Tiled
+func (el *Layer) GetShapes() (shapes []collision.Shape) {
+	for _, object := range el.Objects {
+		for _, shape := range object.GetShapes() {
+			shapes = append(shapes, mapShape(shape, func(point collision.Vector) collision.Vector {
+				return collision.Vector{X: point.X + el.OffsetX, Y: point.Y + el.OffsetY}
+			}))
+		}
+	}
+	return
+}
+
+// GetTileShapes
+// en: Returns the collision shapes edited in the tilesets for the tiles of a tile
+// layer that touch the area, in pixels of the map, with the flips of the tiles
+// and the offset of the layer applied
+//
+// pt_br: Retorna as formas de colisão editadas nos tilesets para os tiles de uma
+// camada de tiles que tocam a área, em pixels do mapa, com os espelhamentos dos
+// tiles e o deslocamento da camada aplicados
+func (el *Map) GetTileShapes(layer *Layer, area collision.AABB) (shapes []collision.Shape) {
+	if layer.Kind != KLayerTile {
+		return nil
+	}
+	left, top := el.TileAt(area.X-layer.OffsetX, area.Y-layer.OffsetY)
+	right, bottom := el.TileAt(area.MaxX()-layer.OffsetX, area.MaxY()-layer.OffsetY)
+	for row := top - 1; row <= bottom+1; row += 1 {
+		for column := left - 1; column <= right+1; column += 1 {
+			gid := layer.GetTile(column, row)
+			tileset, id, found := el.GetTileset(gid)
+			if !found {
+				continue
+			}
+			tile, found := tileset.Tiles[id]
+			if !found || len(tile.Objects) == 0 {
+				continue
+			}
+
+			_, _, _, width, height := tileset.GetSource(id)
+			x := float64(column*el.TileWidth+tileset.OffsetX) + layer.OffsetX
+			y := float64((row+1)*el.TileHeight-height+tileset.OffsetY) + layer.OffsetY
+			place := func(point collision.Vector) collision.Vector {
+				boxWidth, boxHeight := float64(width), float64(height)
+				if gid&KFlipDiagonal != 0 {
+					point.X, point.Y = point.Y, point.X
+					boxWidth, boxHeight = boxHeight, boxWidth
+				}
+				if gid&KFlipHorizontal != 0 {
+					point.X = boxWidth - point.X
+				}
+				if gid&KFlipVertical != 0 {
+					point.Y = boxHeight - point.Y
+				}
+				return collision.Vector{X: point.X + x, Y: point.Y + y}
+			}
+
+			for _, object := range tile.Objects {
+				for _, shape := range object.GetShapes() {
+					shape = mapShape(shape, place)
+					if collision.Intersects(shape, area) {
+						shapes = append(shapes, shape)
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+// rectangle
+// en: Returns the rectangle of the object, rotated around (X, Y); tile objects
+// grow up from (X, Y)
+//
+// pt_br: Retorna o retângulo do objeto, girado ao redor de (X, Y); objetos tile
+// crescem para cima a partir de (X, Y)
+func (el *Object) rectangle() collision.Polygon {
+	top := 0.0
+	if el.Kind == KObjectTile {
+		top = -el.Height
+	}
+	return el.transform([]collision.Vector{
+		{X: 0, Y: top},
+		{X: el.Width, Y: top},
+		{X: el.Width, Y: top + el.Height},
+		{X: 0, Y: top + el.Height},
+	})
+}
+
+// transform
+// en: Rotates the points of the object around its origin and moves them to
+// (X, Y)
+//
+// pt_br: Gira os pontos do objeto ao redor da sua origem e os move para (X, Y)
+func (el *Object) transform(points []collision.Vector) collision.Polygon {
+	sin, cos := math.Sincos(el.Rotation * math.Pi / 180)
+	polygon := collision.Polygon{Vertices: make([]collision.Vector, len(points))}
+	for i, point := range points {
+		polygon.Vertices[i] = collision.Vector{
+			X: el.X + point.X*cos - point.Y*sin,
+			Y: el.Y + point.X*sin + point.Y*cos,
+		}
+	}
+	return polygon
+}
+
+// mapShape
+// en: Returns the shape with its points moved by place, which may only translate
+// and mirror the axes, so boxes stay aligned to them
+//
+// pt_br: Retorna a forma com os seus pontos movidos por place, que pode somente
+// transladar e espelhar os eixos, assim caixas continuam alinhadas a eles
+func mapShape(shape collision.Shape, place func(point collision.Vector) collision.Vector) collision.Shape {
+	switch converted := shape.(type) {
+	case collision.AABB:
+		first := place(collision.Vector{X: converted.X, Y: converted.Y})
+		second := place(collision.Vector{X: converted.MaxX(), Y: converted.MaxY()})
+		return collision.NewAABB(
+			math.Min(first.X, second.X), math.Min(first.Y, second.Y),
+			math.Abs(second.X-first.X), math.Abs(second.Y-first.Y),
+		)
+	case collision.Circle:
+		center := place(collision.Vector{X: converted.X, Y: converted.Y})
+		return collision.NewCircle(center.X, center.Y, converted.Radius)
+	case collision.Polygon:
+		polygon := collision.Polygon{Vertices: make([]collision.Vector, len(converted.Vertices))}
+		for i, vertex := range converted.Vertices {
+			polygon.Vertices[i] = place(vertex)
+		}
+		return polygon
+	}
+	return shape
+}
+
+// cross
+// en: Returns the z component of the cross product of (b - a) and (c - a)
+//
+// pt_br: Retorna o componente z do produto vetorial de (b - a) e (c - a)
+func cross(a, b, c collision.Vector) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// convex
+// en: Returns true when every corner of the polygon turns to the same side
+//
+// pt_br: Retorna true quando todos os cantos do polígono viram para o mesmo lado
+func convex(points []collision.Vector) bool {
+	sign := 0.0
+	for i := range points {
+		turn := cross(points[i], points[(i+1)%len(points)], points[(i+2)%len(points)])
+		if turn == 0 {
+			continue
+		}
+		if sign == 0 {
+			sign = turn
+		} else if (sign > 0) != (turn > 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// triangulate
+// en: Splits a simple polygon in triangles by ear clipping
+//
+// pt_br: Divide um polígono simples em triângulos por corte de orelhas
+func triangulate(points []collision.Vector) (triangles [][]collision.Vector) {
+	area := 0.0
+	for i := range points {
+		next := points[(i+1)%len(points)]
+		area += points[i].X*next.Y - next.X*points[i].Y
+	}
+
+	remaining := make([]collision.Vector, len(points))
+	copy(remaining, points)
+	for len(remaining) > 3 {
+		clipped := false
+		for i := range remaining {
+			previous := remaining[(i+len(remaining)-1)%len(remaining)]
+			current := remaining[i]
+			next := remaining[(i+1)%len(remaining)]
+			turn := cross(previous, current, next)
+			if turn == 0 || (turn > 0) != (area > 0) {
+				continue
+			}
+			ear := true
+			for _, point := range remaining {
+				if point == previous || point == current || point == next {
+					continue
+				}
+				if inside(point, previous, current, next) {
+					ear = false
+					break
+				}
+			}
+			if !ear {
+				continue
+			}
+			triangles = append(triangles, []collision.Vector{previous, current, next})
+			remaining = append(remaining[:i:i], remaining[i+1:]...)
+			clipped = true
+			break
+		}
+		if !clipped {
+			// en: self intersecting or degenerate polygons end with a fan
+			// pt_br: polígonos que se cruzam ou degenerados terminam com um leque
+			for i := 1; i+1 < len(remaining); i += 1 {
+				triangles = append(triangles, []collision.Vector{remaining[0], remaining[i], remaining[i+1]})
+			}
+			return
+		}
+	}
+	return append(triangles, remaining)
+}
+
+// inside
+// en: Returns true when the point is inside the triangle or on its border
+//
+// pt_br: Retorna true quando o ponto está dentro do triângulo ou na sua borda
+func inside(point, a, b, c collision.Vector) bool {
+	first := cross(a, b, point)
+	second := cross(b, c, point)
+	third := cross(c, a, point)
+	negative := first < 0 || second < 0 || third < 0
+	positive := first > 0 || second > 0 || third > 0
+	return !(negative && positive)
+}
@@ -0,0 +1,139 @@
+package tileMap
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// Camera
+// en: Part of the map shown on the canvas
+//
+//	X, Y: Point of the map, in pixels, at the top left corner of the viewport
+//	Zoom: Screen pixels per map pixel; 0 is the same as 1
+//	Viewport: Area of the canvas where the map is drawn
+//
+// pt_br: Parte do mapa mostrada no canvas
+//
+//	X, Y: Ponto do mapa, em pixels, no canto superior esquerdo da viewport
+//	Zoom: Pixels da tela por pixel do mapa; 0 é o mesmo que 1
+//	Viewport: Área do canvas onde o mapa é desenhado
+type Camera struct {
+	X        float64
+	Y        float64
+	Zoom     float64
+	Viewport collision.AABB
+}
+
+// NewCamera
+// en: Returns a camera at the origin of the map, without zoom
+//
+// pt_br: Retorna uma câmera na origem do mapa, sem zoom
+func NewCamera(viewport collision.AABB) *Camera {
+	return &Camera{Zoom: 1, Viewport: viewport}
+}
+
+// GetZoom
+// en: Returns the zoom, with 0 replaced by 1
+//
+// pt_br: Retorna o zoom, com 0 substituído por 1
+func (el *Camera) GetZoom() float64 {
+	if el.Zoom <= 0 {
+		return 1
+	}
+	return el.Zoom
+}
+
+// GetView
+// en: Returns the area of the map shown in the viewport, in pixels of the map
+//
+// pt_br: Retorna a área do mapa mostrada na viewport, em pixels do mapa
+func (el *Camera) GetView() collision.AABB {
+	zoom := el.GetZoom()
+	return collision.NewAABB(el.X, el.Y, el.Viewport.Width/zoom, el.Viewport.Height/zoom)
+}
+
+// WorldToScreen
+// en: Converts a point of the map into a point of the canvas
+//
+// pt_br: Converte um ponto do mapa em um ponto do canvas
+func (el *Camera) WorldToScreen(x, y float64) (screenX, screenY float64) {
+	zoom := el.GetZoom()
+	return el.Viewport.X + (x-el.X)*zoom, el.Viewport.Y + (y-el.Y)*zoom
+}
+
+// ScreenToWorld
+// en: Converts a point of the canvas, like the mouse pointer, into a point of the
+// map
+//
+// pt_br: Converte um ponto do canvas, como o ponteiro do mouse, em um ponto do
+// mapa
+func (el *Camera) ScreenToWorld(screenX, screenY float64) (x, y float64) {
+	zoom := el.GetZoom()
+	return el.X + (screenX-el.Viewport.X)/zoom, el.Y + (screenY-el.Viewport.Y)/zoom
+}
+
+// Scroll
+// en: Moves the camera by the distance given in pixels of the canvas, like a drag
+// of the mouse
+//
+// pt_br: Move a câmera pela distância dada em pixels do canvas, como um arraste
+// do mouse
+func (el *Camera) Scroll(screenX, screenY float64) {
+	zoom := el.GetZoom()
+	el.X += screenX / zoom
+	el.Y += screenY / zoom
+}
+
+// CenterOn
+// en: Moves the camera to show the point of the map in the center of the viewport
+//
+// pt_br: Move a câmera para mostrar o ponto do mapa no centro da viewport
+func (el *Camera) CenterOn(x, y float64) {
+	view := el.GetView()
+	el.X = x - view.Width/2
+	el.Y = y - view.Height/2
+}
+
+// SetZoom
+// en: Changes the zoom keeping the point of the map under the point of the canvas
+// in place, like the mouse wheel over the map
+//
+// pt_br: Muda o zoom mantendo o ponto do mapa sob o ponto do canvas no lugar, como
+// a roda do mouse sobre o mapa
+func (el *Camera) SetZoom(zoom, screenX, screenY float64) {
+	if zoom <= 0 {
+		return
+	}
+	x, y := el.ScreenToWorld(screenX, screenY)
+	el.Zoom = zoom
+	el.X = x - (screenX-el.Viewport.X)/zoom
+	el.Y = y - (screenY-el.Viewport.Y)/zoom
+}
+
+// Clamp
+// en: Moves the camera so the view stays inside the bounds, like Map.GetBounds();
+// on the axes where the view is larger, the bounds are centered
+//
+// pt_br: Move a câmera para que a visão fique dentro dos limites, como
+// Map.GetBounds(); nos eixos onde a visão é maior, os limites são centralizados
+func (el *Camera) Clamp(bounds collision.AABB) {
+	view := el.GetView()
+	el.X = clampAxis(el.X, view.Width, bounds.X, bounds.Width)
+	el.Y = clampAxis(el.Y, view.Height, bounds.Y, bounds.Height)
+}
+
+// clampAxis
+// en: Clamps the start of the view on one axis
+//
+// pt_br: Limita o início da visão em um eixo
+func clampAxis(start, size, boundsStart, boundsSize float64) float64 {
+	if size >= boundsSize {
+		return boundsStart - (size-boundsSize)/2
+	}
+	if start < boundsStart {
+		return boundsStart
+	}
+	if start+size > boundsStart+boundsSize {
+		return boundsStart + boundsSize - size
+	}
+	return start
+}
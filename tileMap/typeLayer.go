@@ -0,0 +1,229 @@
+package tileMap
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// LayerKind
+// en: Kind of content of a layer
+//
+// pt_br: Tipo de conteúdo de uma camada
+type LayerKind int
+
+const (
+	// KLayerTile
+	// en: Grid of global tile ids
+	//
+	// pt_br: Grade de ids globais de tiles
+	KLayerTile LayerKind = iota
+
+	// KLayerObject
+	// en: Free objects: shapes, points, texts and tiles
+	//
+	// pt_br: Objetos livres: formas, pontos, textos e tiles
+	KLayerObject
+
+	// KLayerImage
+	// en: Single image, optionally repeated
+	//
+	// pt_br: Imagem única, opcionalmente repetida
+	KLayerImage
+)
+
+// Layer
+// en: Layer of the map. Groups of Tiled are flattened: their layers receive the
+// product of the opacities and parallax factors, the sum of the offsets and are
+// visible only when every group is
+//
+//	Group: Names of the groups of the layer, separated by "/"
+//	OffsetX, OffsetY: Offset of the drawing, in pixels
+//	ParallaxX, ParallaxY: Speed of the layer relative to the camera; 1 moves with
+//	                      the map, 0 stays fixed on the screen
+//	X, Y: Column and row of the first tile of Data; non-zero only in infinite maps
+//	Width, Height: Columns and rows of Data
+//	Data: Global tile ids with the flip flags, row by row; 0 is an empty cell
+//	Objects: Objects of an object layer, in pixels of the map
+//	Image: File of the image of an image layer, relative to the map
+//	ImageWidth, ImageHeight: Size of the image; 0 reads it from image.Image
+//	RepeatX, RepeatY: The image is repeated on the axis to fill the view
+//
+// pt_br: Camada do mapa. Grupos do Tiled são achatados: as suas camadas recebem o
+// produto das opacidades e dos fatores de paralaxe, a soma dos deslocamentos e são
+// visíveis somente quando todos os grupos são
+//
+//	Group: Nomes dos grupos da camada, separados por "/"
+//	OffsetX, OffsetY: Deslocamento do desenho, em pixels
+//	ParallaxX, ParallaxY: Velocidade da camada relativa à câmera; 1 se move com o
+//	                      mapa, 0 fica fixa na tela
+//	X, Y: Coluna e linha do primeiro tile de Data; diferentes de zero somente em
+//	      mapas infinitos
+//	Width, Height: Colunas e linhas de Data
+//	Data: Ids globais de tiles com as flags de espelhamento, linha por linha; 0 é
+//	      uma célula vazia
+//	Objects: Objetos de uma camada de objetos, em pixels do mapa
+//	Image: Arquivo da imagem de uma camada de imagem, relativo ao mapa
+//	ImageWidth, ImageHeight: Tamanho da imagem; 0 o lê de image.Image
+//	RepeatX, RepeatY: A imagem é repetida no eixo para preencher a visão
+type Layer struct {
+	Id         int
+	Name       string
+	Class      string
+	Group      string
+	Kind       LayerKind
+	Visible    bool
+	Opacity    float64
+	OffsetX    float64
+	OffsetY    float64
+	ParallaxX  float64
+	ParallaxY  float64
+	Properties Properties
+
+	X      int
+	Y      int
+	Width  int
+	Height int
+	Data   []uint32
+
+	Objects []*Object
+
+	Image       string
+	ImageWidth  int
+	ImageHeight int
+	RepeatX     bool
+	RepeatY     bool
+}
+
+// GetTile
+// en: Returns the global tile id, with the flip flags, of the cell; 0 when the
+// cell is empty or out of the layer
+//
+// pt_br: Retorna o id global do tile, com as flags de espelhamento, da célula; 0
+// quando a célula está vazia ou fora da camada
+func (el *Layer) GetTile(column, row int) uint32 {
+	column -= el.X
+	row -= el.Y
+	if column < 0 || row < 0 || column >= el.Width || row >= el.Height {
+		return 0
+	}
+	return el.Data[row*el.Width+column]
+}
+
+// SetTile
+// en: Changes the global tile id of the cell, like a door that opens; returns
+// false when the cell is out of the layer
+//
+// pt_br: Muda o id global do tile da célula, como uma porta que se abre; retorna
+// false quando a célula está fora da camada
+func (el *Layer) SetTile(column, row int, gid uint32) bool {
+	column -= el.X
+	row -= el.Y
+	if el.Kind != KLayerTile || column < 0 || row < 0 || column >= el.Width || row >= el.Height {
+		return false
+	}
+	el.Data[row*el.Width+column] = gid
+	return true
+}
+
+// GetObject
+// en: Returns the first object of the layer with the name
+//
+// pt_br: Retorna o primeiro objeto da camada com o nome
+func (el *Layer) GetObject(name string) (object *Object, found bool) {
+	for _, object = range el.Objects {
+		if object.Name == name {
+			return object, true
+		}
+	}
+	return nil, false
+}
+
+// ObjectKind
+// en: Shape of an object
+//
+// pt_br: Forma de um objeto
+type ObjectKind int
+
+const (
+	// KObjectRectangle
+	// en: Rectangle of Width by Height
+	//
+	// pt_br: Retângulo de Width por Height
+	KObjectRectangle ObjectKind = iota
+
+	// KObjectEllipse
+	// en: Ellipse inscribed in the rectangle of Width by Height
+	//
+	// pt_br: Elipse inscrita no retângulo de Width por Height
+	KObjectEllipse
+
+	// KObjectPoint
+	// en: Single point, without area
+	//
+	// pt_br: Ponto único, sem área
+	KObjectPoint
+
+	// KObjectPolygon
+	// en: Closed polygon of Points
+	//
+	// pt_br: Polígono fechado de Points
+	KObjectPolygon
+
+	// KObjectPolyline
+	// en: Open line through Points
+	//
+	// pt_br: Linha aberta passando por Points
+	KObjectPolyline
+
+	// KObjectText
+	// en: Text inside the rectangle of Width by Height
+	//
+	// pt_br: Texto dentro do retângulo de Width por Height
+	KObjectText
+
+	// KObjectTile
+	// en: Tile of Gid scaled to Width by Height
+	//
+	// pt_br: Tile de Gid escalado para Width por Height
+	KObjectTile
+)
+
+// Object
+// en: Object of an object layer, or collision object of a tile
+//
+//	X, Y: Position, in pixels; the top left corner of the shapes and the bottom
+//	      left corner of tile objects, as in Tiled
+//	Rotation: Rotation around (X, Y), in degrees, clockwise
+//	Gid: Global tile id, with the flip flags, of tile objects
+//	Points: Vertices of polygons and polylines, relative to (X, Y)
+//	Text: Text of text objects
+//
+// Object templates are not supported; objects made from them keep only the
+// values overridden in the map
+//
+// pt_br: Objeto de uma camada de objetos, ou objeto de colisão de um tile
+//
+//	X, Y: Posição, em pixels; o canto superior esquerdo das formas e o canto
+//	      inferior esquerdo de objetos tile, como no Tiled
+//	Rotation: Rotação ao redor de (X, Y), em graus, no sentido horário
+//	Gid: Id global do tile, com as flags de espelhamento, de objetos tile
+//	Points: Vértices de polígonos e polilinhas, relativos a (X, Y)
+//	Text: Texto de objetos de texto
+//
+// Templates de objetos não são suportados; objetos feitos a partir deles mantêm
+// somente os valores sobrescritos no mapa
+type Object struct {
+	Id         int
+	Name       string
+	Class      string
+	Kind       ObjectKind
+	Visible    bool
+	X          float64
+	Y          float64
+	Width      float64
+	Height     float64
+	Rotation   float64
+	Gid        uint32
+	Points     []collision.Vector
+	Text       string
+	Properties Properties
+}
@@ -0,0 +1,155 @@
+package tileMap
+
+import (
+	"image/color"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// Map
+// en: Orthogonal tile map made with the Tiled editor, loaded from TMX or JSON by
+// Parse(), LoadFile() or LoadFS()
+//
+//	Width, Height: Size of the map, in tiles; the bounds of the data for infinite
+//	               maps
+//	TileWidth, TileHeight: Size of the grid cells, in pixels
+//	Infinite: The tile layers were saved in chunks; see Layer.X and Layer.Y
+//	BackgroundColor: Color drawn behind the layers; zero means none
+//	ParallaxOriginX, ParallaxOriginY: Point of the map where the layers with
+//	                                  parallax line up with the others
+//	Tilesets: Tilesets sorted by FirstGid
+//	Layers: Layers in drawing order, with the groups flattened; see Layer.Group
+//
+// pt_br: Mapa de tiles ortogonal feito com o editor Tiled, carregado de TMX ou
+// JSON por Parse(), LoadFile() ou LoadFS()
+//
+//	Width, Height: Tamanho do mapa, em tiles; os limites dos dados para mapas
+//	               infinitos
+//	TileWidth, TileHeight: Tamanho das células da grade, em pixels
+//	Infinite: As camadas de tiles foram salvas em pedaços; veja Layer.X e Layer.Y
+//	BackgroundColor: Cor desenhada atrás das camadas; zero significa nenhuma
+//	ParallaxOriginX, ParallaxOriginY: Ponto do mapa onde as camadas com paralaxe
+//	                                  se alinham com as outras
+//	Tilesets: Tilesets ordenados por FirstGid
+//	Layers: Camadas em ordem de desenho, com os grupos achatados; veja
+//	        Layer.Group
+type Map struct {
+	Width           int
+	Height          int
+	TileWidth       int
+	TileHeight      int
+	Infinite        bool
+	BackgroundColor color.RGBA
+	ParallaxOriginX float64
+	ParallaxOriginY float64
+	Properties      Properties
+	Tilesets        []*Tileset
+	Layers          []*Layer
+
+	images map[string]interface{}
+}
+
+// GetBounds
+// en: Returns the area of the map, in pixels
+//
+// pt_br: Retorna a área do mapa, em pixels
+func (el *Map) GetBounds() collision.AABB {
+	x, y := 0, 0
+	width, height := el.Width, el.Height
+	if el.Infinite {
+		x, y, width, height = 0, 0, 0, 0
+		first := true
+		for _, layer := range el.Layers {
+			if layer.Kind != KLayerTile || layer.Width == 0 || layer.Height == 0 {
+				continue
+			}
+			if first {
+				x, y, width, height = layer.X, layer.Y, layer.Width, layer.Height
+				first = false
+				continue
+			}
+			right, bottom := x+width, y+height
+			if layer.X < x {
+				x = layer.X
+			}
+			if layer.Y < y {
+				y = layer.Y
+			}
+			if layer.X+layer.Width > right {
+				right = layer.X + layer.Width
+			}
+			if layer.Y+layer.Height > bottom {
+				bottom = layer.Y + layer.Height
+			}
+			width, height = right-x, bottom-y
+		}
+	}
+	return collision.NewAABB(
+		float64(x*el.TileWidth), float64(y*el.TileHeight),
+		float64(width*el.TileWidth), float64(height*el.TileHeight),
+	)
+}
+
+// GetLayer
+// en: Returns the first layer with the name
+//
+// pt_br: Retorna a primeira camada com o nome
+func (el *Map) GetLayer(name string) (layer *Layer, found bool) {
+	for _, layer = range el.Layers {
+		if layer.Name == name {
+			return layer, true
+		}
+	}
+	return nil, false
+}
+
+// GetTileset
+// en: Returns the tileset of the global tile id and the id of the tile inside it.
+// The flip flags of gid are ignored
+//
+// pt_br: Retorna o tileset do id global do tile e o id do tile dentro dele. As
+// flags de espelhamento de gid são ignoradas
+func (el *Map) GetTileset(gid uint32) (tileset *Tileset, id int, found bool) {
+	gid &= KGidMask
+	if gid == 0 {
+		return nil, 0, false
+	}
+	for i := len(el.Tilesets) - 1; i >= 0; i -= 1 {
+		if tileset = el.Tilesets[i]; gid >= tileset.FirstGid {
+			id = int(gid - tileset.FirstGid)
+			// en: the ids of image collections may have gaps, beyond TileCount
+			// pt_br: os ids de coleções de imagens podem ter lacunas, além de
+			// TileCount
+			if tileset.Image != "" && tileset.TileCount > 0 && id >= tileset.TileCount {
+				return nil, 0, false
+			}
+			return tileset, id, true
+		}
+	}
+	return nil, 0, false
+}
+
+// GetTile
+// en: Returns the tile of the tileset for the global tile id, with its class,
+// properties, animation and collision objects
+//
+// pt_br: Retorna o tile do tileset para o id global do tile, com a sua classe,
+// propriedades, animação e objetos de colisão
+func (el *Map) GetTile(gid uint32) (tile *Tile, found bool) {
+	tileset, id, found := el.GetTileset(gid)
+	if !found {
+		return nil, false
+	}
+	tile, found = tileset.Tiles[id]
+	return
+}
+
+// TileAt
+// en: Returns the column and row of the grid cell under the point of the map, in
+// pixels
+//
+// pt_br: Retorna a coluna e a linha da célula da grade sob o ponto do mapa, em
+// pixels
+func (el *Map) TileAt(x, y float64) (column, row int) {
+	return floorDiv(x, float64(el.TileWidth)), floorDiv(y, float64(el.TileHeight))
+}
@@ -0,0 +1,85 @@
+package tileMap
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+// Properties
+// en: Custom properties defined in Tiled, by name, with the values as text. Class
+// properties keep the JSON of their members
+//
+// pt_br: Propriedades personalizadas definidas no Tiled, pelo nome, com os valores
+// como texto. Propriedades de classe mantêm o JSON dos seus membros
+type Properties map[string]string
+
+// GetString
+// en: Returns the value of the property
+//
+// pt_br: Retorna o valor da propriedade
+func (el Properties) GetString(name string) (value string, found bool) {
+	value, found = el[name]
+	return
+}
+
+// GetInt
+// en: Returns the value of an int or object property; found is false when the
+// property is missing or is not a number
+//
+// pt_br: Retorna o valor de uma propriedade int ou object; found é false quando a
+// propriedade não existe ou não é um número
+func (el Properties) GetInt(name string) (value int, found bool) {
+	number, err := strconv.Atoi(el[name])
+	return number, err == nil
+}
+
+// GetFloat
+// en: Returns the value of a float or int property
+//
+// pt_br: Retorna o valor de uma propriedade float ou int
+func (el Properties) GetFloat(name string) (value float64, found bool) {
+	number, err := strconv.ParseFloat(el[name], 64)
+	return number, err == nil
+}
+
+// GetBool
+// en: Returns the value of a bool property
+//
+// pt_br: Retorna o valor de uma propriedade bool
+func (el Properties) GetBool(name string) (value bool, found bool) {
+	boolean, err := strconv.ParseBool(el[name])
+	return boolean, err == nil
+}
+
+// GetColor
+// en: Returns the value of a color property, written by Tiled as #AARRGGBB
+//
+// pt_br: Retorna o valor de uma propriedade color, escrita pelo Tiled como
+// #AARRGGBB
+func (el Properties) GetColor(name string) (value color.RGBA, found bool) {
+	value, err := parseColor(el[name])
+	return value, err == nil && el[name] != ""
+}
+
+// parseColor
+// en: Parses the colors of Tiled, #AARRGGBB or #RRGGBB, with the # optional; an
+// empty text is the zero color
+//
+// pt_br: Interpreta as cores do Tiled, #AARRGGBB ou #RRGGBB, com o # opcional; um
+// texto vazio é a cor zero
+func parseColor(text string) (color.RGBA, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(text), "#")
+	if hex == "" {
+		return color.RGBA{}, nil
+	}
+	if len(hex) == 6 {
+		hex = "ff" + hex
+	}
+	number, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 8 || err != nil {
+		return color.RGBA{}, fmt.Errorf("tileMap: invalid color %q", text)
+	}
+	return color.RGBA{A: uint8(number >> 24), R: uint8(number >> 16), G: uint8(number >> 8), B: uint8(number)}, nil
+}
@@ -0,0 +1,173 @@
+package tileMap
+
+import (
+	"time"
+)
+
+const (
+	// KFlipHorizontal
+	// en: Flag of the global tile id that mirrors the tile horizontally
+	//
+	// pt_br: Flag do id global do tile que espelha o tile na horizontal
+	KFlipHorizontal uint32 = 0x80000000
+
+	// KFlipVertical
+	// en: Flag of the global tile id that mirrors the tile vertically
+	//
+	// pt_br: Flag do id global do tile que espelha o tile na vertical
+	KFlipVertical uint32 = 0x40000000
+
+	// KFlipDiagonal
+	// en: Flag of the global tile id that swaps the axes of the tile, applied before
+	// the other flips; with them it gives the rotations by 90 degrees
+	//
+	// pt_br: Flag do id global do tile que troca os eixos do tile, aplicada antes
+	// dos outros espelhamentos; com eles dá as rotações de 90 graus
+	KFlipDiagonal uint32 = 0x20000000
+
+	// KGidMask
+	// en: Bits of the global tile id without the flags, including the hexagonal
+	// rotation flag, which is ignored
+	//
+	// pt_br: Bits do id global do tile sem as flags, incluindo a flag de rotação
+	// hexagonal, que é ignorada
+	KGidMask uint32 = 0x0fffffff
+)
+
+// Tileset
+// en: Set of tiles cut from one image, or a collection of images, one per tile
+//
+//	FirstGid: Global id of the first tile in the map
+//	Source: File of an external tileset, relative to the map; empty when embedded
+//	TileWidth, TileHeight: Size of the tiles, drawn aligned to the bottom left
+//	                       corner of the grid cell
+//	Spacing, Margin: Pixels between the tiles and around them in the image
+//	Columns: Tiles per row of the image
+//	OffsetX, OffsetY: Offset of the drawing of the tiles, in pixels
+//	Image: File of the image, relative to the map; empty for collections
+//	Tiles: Tiles with an image, class, properties, animation or collision
+//	       objects, by id
+//
+// pt_br: Conjunto de tiles cortados de uma imagem, ou uma coleção de imagens, uma
+// por tile
+//
+//	FirstGid: Id global do primeiro tile no mapa
+//	Source: Arquivo de um tileset externo, relativo ao mapa; vazio quando
+//	        embutido
+//	TileWidth, TileHeight: Tamanho dos tiles, desenhados alinhados ao canto
+//	                       inferior esquerdo da célula da grade
+//	Spacing, Margin: Pixels entre os tiles e ao redor deles na imagem
+//	Columns: Tiles por linha da imagem
+//	OffsetX, OffsetY: Deslocamento do desenho dos tiles, em pixels
+//	Image: Arquivo da imagem, relativo ao mapa; vazio para coleções
+//	Tiles: Tiles com imagem, classe, propriedades, animação ou objetos de
+//	       colisão, pelo id
+type Tileset struct {
+	FirstGid    uint32
+	Name        string
+	Class       string
+	Source      string
+	TileWidth   int
+	TileHeight  int
+	Spacing     int
+	Margin      int
+	TileCount   int
+	Columns     int
+	OffsetX     int
+	OffsetY     int
+	Image       string
+	ImageWidth  int
+	ImageHeight int
+	Properties  Properties
+	Tiles       map[int]*Tile
+}
+
+// Tile
+// en: Data of one tile of a tileset
+//
+//	Image: File of the image of the tile in collections, relative to the map
+//	Animation: Frames played in a loop in place of the tile
+//	Objects: Collision shapes edited in the tileset, relative to the top left
+//	         corner of the tile
+//
+// pt_br: Dados de um tile de um tileset
+//
+//	Image: Arquivo da imagem do tile em coleções, relativo ao mapa
+//	Animation: Quadros tocados em loop no lugar do tile
+//	Objects: Formas de colisão editadas no tileset, relativas ao canto superior
+//	         esquerdo do tile
+type Tile struct {
+	Id          int
+	Class       string
+	Properties  Properties
+	Image       string
+	ImageWidth  int
+	ImageHeight int
+	Animation   []Frame
+	Objects     []*Object
+}
+
+// Frame
+// en: Frame of an animated tile
+//
+// pt_br: Quadro de um tile animado
+type Frame struct {
+	TileId   int
+	Duration time.Duration
+}
+
+// GetFrame
+// en: Returns the id of the tile shown at the time now by the tile id. Every
+// animation is counted from the Unix epoch, so the tiles with the same animation
+// stay in step, like in Tiled
+//
+// pt_br: Retorna o id do tile mostrado no instante now pelo id do tile. Toda
+// animação é contada a partir da época Unix, assim os tiles com a mesma animação
+// ficam em sincronia, como no Tiled
+func (el *Tileset) GetFrame(id int, now time.Time) int {
+	tile, found := el.Tiles[id]
+	if !found || len(tile.Animation) == 0 {
+		return id
+	}
+	var total time.Duration
+	for _, frame := range tile.Animation {
+		total += frame.Duration
+	}
+	if total <= 0 {
+		return tile.Animation[0].TileId
+	}
+	elapsed := time.Duration(now.UnixNano()) % total
+	for _, frame := range tile.Animation {
+		if elapsed < frame.Duration {
+			return frame.TileId
+		}
+		elapsed -= frame.Duration
+	}
+	return tile.Animation[len(tile.Animation)-1].TileId
+}
+
+// GetSource
+// en: Returns the image file and the rectangle of the tile inside it
+//
+// pt_br: Retorna o arquivo de imagem e o retângulo do tile dentro dele
+func (el *Tileset) GetSource(id int) (image string, x, y, width, height int) {
+	if tile, found := el.Tiles[id]; found && tile.Image != "" {
+		return tile.Image, 0, 0, tile.ImageWidth, tile.ImageHeight
+	}
+	if el.Image == "" {
+		return "", 0, 0, 0, 0
+	}
+	columns := el.Columns
+	if columns <= 0 {
+		columns = 1
+		if el.TileWidth+el.Spacing > 0 {
+			columns = (el.ImageWidth - 2*el.Margin + el.Spacing) / (el.TileWidth + el.Spacing)
+		}
+		if columns <= 0 {
+			columns = 1
+		}
+	}
+	x = el.Margin + (id%columns)*(el.TileWidth+el.Spacing)
+	y = el.Margin + (id/columns)*(el.TileHeight+el.Spacing)
+	return el.Image, x, y, el.TileWidth, el.TileHeight
+}
@@ -0,0 +1,135 @@
+package toolkit
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// kArcSteps
+// en: Number of lines of each rounded corner
+//
+// pt_br: Número de linhas de cada canto arredondado
+const kArcSteps = 6
+
+// roundedRect
+// en: Adds the outline of a rectangle with rounded corners to a new path
+//
+// pt_br: Adiciona o contorno de um retângulo com cantos arredondados a um novo
+// caminho
+func roundedRect(platform Platform, bounds collision.AABB, radius float64) {
+	radius = math.Max(0, math.Min(radius, math.Min(bounds.Width, bounds.Height)/2))
+	platform.BeginPath()
+	if radius == 0 {
+		platform.MoveTo(bounds.X, bounds.Y)
+		platform.LineTo(bounds.MaxX(), bounds.Y)
+		platform.LineTo(bounds.MaxX(), bounds.MaxY())
+		platform.LineTo(bounds.X, bounds.MaxY())
+		platform.ClosePath(bounds.X, bounds.Y)
+		return
+	}
+
+	corners := [4][3]float64{
+		{bounds.MaxX() - radius, bounds.Y + radius, -math.Pi / 2},
+		{bounds.MaxX() - radius, bounds.MaxY() - radius, 0},
+		{bounds.X + radius, bounds.MaxY() - radius, math.Pi / 2},
+		{bounds.X + radius, bounds.Y + radius, math.Pi},
+	}
+	platform.MoveTo(bounds.X+radius, bounds.Y)
+	for _, corner := range corners {
+		for i := 0; i <= kArcSteps; i += 1 {
+			angle := corner[2] + math.Pi/2*float64(i)/kArcSteps
+			platform.LineTo(corner[0]+radius*math.Cos(angle), corner[1]+radius*math.Sin(angle))
+		}
+	}
+	platform.ClosePath(bounds.X+radius, bounds.Y)
+}
+
+// box
+// en: Fills a rounded rectangle and strokes its border, when the width is not
+// zero
+//
+// pt_br: Preenche um retângulo arredondado e contorna a sua borda, quando a
+// largura não é zero
+func box(platform Platform, bounds collision.AABB, radius float64, fill, border color.RGBA, width float64) {
+	roundedRect(platform, bounds, radius)
+	if fill.A != 0 {
+		platform.SetFillStyle(fill)
+		platform.Fill()
+	}
+	if border.A != 0 && width > 0 {
+		platform.SetStrokeStyle(border)
+		platform.SetLineWidth(width)
+		platform.Stroke()
+	}
+}
+
+// focusRing
+// en: Strokes the focus ring of the theme around the bounds
+//
+// pt_br: Contorna o anel de foco do tema em volta dos limites
+func focusRing(context *Context, bounds collision.AABB, radius float64) {
+	width := context.Theme.FocusWidth
+	if width <= 0 || context.Theme.FocusColor.A == 0 {
+		return
+	}
+	outset := width/2 + 1
+	ring := collision.NewAABB(bounds.X-outset, bounds.Y-outset, bounds.Width+2*outset, bounds.Height+2*outset)
+	box(context.Platform, ring, radius+outset, color.RGBA{}, context.Theme.FocusColor, width)
+}
+
+// baseline
+// en: Returns the baseline that centers a line of text vertically in the bounds
+//
+// pt_br: Retorna a linha de base que centraliza uma linha de texto verticalmente
+// nos limites
+func baseline(context *Context, bounds collision.AABB) float64 {
+	metrics := context.Platform.MeasureText("Mg")
+	ascent, descent := metrics.FontBoundingBoxAscent, metrics.FontBoundingBoxDescent
+	if ascent+descent <= 0 {
+		ascent, descent = metrics.ActualBoundingBoxAscent, metrics.ActualBoundingBoxDescent
+	}
+	if ascent+descent <= 0 {
+		ascent = metrics.Width / 2 * 1.2
+	}
+	return bounds.Y + (bounds.Height-ascent-descent)/2 + ascent
+}
+
+// fitText
+// en: Shortens the text with an ellipsis until it fits the width; needs the font
+// already applied
+//
+// pt_br: Encurta o texto com reticências até que ele caiba na largura; precisa da
+// fonte já aplicada
+func fitText(platform Platform, text string, width float64) string {
+	if platform.MeasureText(text).Width <= width {
+		return text
+	}
+	runes := []rune(text)
+	low, high := 0, len(runes)
+	for low < high {
+		middle := (low + high + 1) / 2
+		if platform.MeasureText(string(runes[:middle])+"…").Width <= width {
+			low = middle
+		} else {
+			high = middle - 1
+		}
+	}
+	if low == 0 {
+		return ""
+	}
+	return string(runes[:low]) + "…"
+}
+
+// drawText
+// en: Draws a line of text at the left of the x and at the baseline given
+//
+// pt_br: Desenha uma linha de texto à esquerda do x e na linha de base dada
+func drawText(platform Platform, text string, x, baseline float64, fill color.RGBA) {
+	if text == "" {
+		return
+	}
+	platform.SetFillStyle(fill)
+	platform.FillText(text, int(math.Round(x)), int(math.Round(baseline)))
+}
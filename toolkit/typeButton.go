@@ -0,0 +1,108 @@
+package toolkit
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/colorUtils"
+)
+
+// Button
+// en: Push button with a label; activated by a click, Enter or Space
+//
+//	Primary: Fills the button with the accent color of the theme
+//	OnClick: Called on each activation
+//
+// pt_br: Botão com um rótulo; ativado por um clique, Enter ou Espaço
+//
+//	Primary: Preenche o botão com a cor de destaque do tema
+//	OnClick: Chamada a cada ativação
+type Button struct {
+	Base
+	Primary bool
+	OnClick func(button *Button)
+	label   string
+}
+
+// NewButton
+// en: Returns a button with the label
+//
+// pt_br: Retorna um botão com o rótulo
+func NewButton(id string, bounds collision.AABB, label string, onClick func(button *Button)) *Button {
+	return &Button{Base: NewBase(id, bounds), OnClick: onClick, label: label}
+}
+
+// GetLabel
+// en: Returns the label
+//
+// pt_br: Retorna o rótulo
+func (el *Button) GetLabel() string {
+	return el.label
+}
+
+// SetLabel
+// en: Replaces the label
+//
+// pt_br: Substitui o rótulo
+func (el *Button) SetLabel(label string) {
+	el.label = label
+	el.Invalidate()
+}
+
+// Click
+// en: Activates the button, as a click does
+//
+// pt_br: Ativa o botão, como um clique faz
+func (el *Button) Click() {
+	if el.GetEnabled() && el.OnClick != nil {
+		el.OnClick(el)
+	}
+}
+
+// PointerUp
+// en: Completes the click when the pointer is released over the button
+//
+// pt_br: Completa o clique quando o ponteiro é solto sobre o botão
+func (el *Button) PointerUp(context *Context, x, y float64, inside bool) {
+	if inside {
+		el.Click()
+	}
+}
+
+// KeyDown
+// en: Activates the button with Enter and Space
+//
+// pt_br: Ativa o botão com Enter e Espaço
+func (el *Button) KeyDown(context *Context, event KeyEvent) bool {
+	if event.Key == KKeyEnter || event.Key == KKeySpace {
+		el.Click()
+		return true
+	}
+	return false
+}
+
+// Draw
+// en: Draws the button for the state
+//
+// pt_br: Desenha o botão para o estado
+func (el *Button) Draw(context *Context, state State) {
+	theme := context.Theme
+	bounds := el.GetBounds()
+	fill, text := context.surfaceColor(state), context.textColor(state)
+	border := theme.BorderColor
+	if el.Primary && !state.Has(KStateDisabled) {
+		fill, text, border = theme.AccentColor, theme.AccentTextColor, theme.AccentColor
+		switch {
+		case state.Has(KStatePressed):
+			fill = colorUtils.Mix(theme.AccentColor, theme.TextColor, 0.3)
+		case state.Has(KStateHover):
+			fill = colorUtils.Mix(theme.AccentColor, theme.TextColor, 0.15)
+		}
+	}
+	box(context.Platform, bounds, theme.Radius, fill, border, theme.BorderWidth)
+	if state.Has(KStateFocused) {
+		focusRing(context, bounds, theme.Radius)
+	}
+
+	label := fitText(context.Platform, el.label, bounds.Width-2*theme.Padding)
+	width := context.Platform.MeasureText(label).Width
+	drawText(context.Platform, label, bounds.X+(bounds.Width-width)/2, baseline(context, bounds), text)
+}
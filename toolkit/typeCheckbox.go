@@ -0,0 +1,129 @@
+package toolkit
+
+import (
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// Checkbox
+// en: Box that toggles between checked and unchecked, with a label at its right;
+// toggled by a click or Space
+//
+//	OnChange: Called after each change made by the user
+//
+// pt_br: Caixa que alterna entre marcada e desmarcada, com um rótulo à sua
+// direita; alternada por um clique ou Espaço
+//
+//	OnChange: Chamada após cada mudança feita pelo usuário
+type Checkbox struct {
+	Base
+	OnChange func(checkbox *Checkbox, checked bool)
+	label    string
+	checked  bool
+}
+
+// NewCheckbox
+// en: Returns an unchecked checkbox with the label
+//
+// pt_br: Retorna uma caixa de seleção desmarcada com o rótulo
+func NewCheckbox(id string, bounds collision.AABB, label string, onChange func(checkbox *Checkbox, checked bool)) *Checkbox {
+	return &Checkbox{Base: NewBase(id, bounds), OnChange: onChange, label: label}
+}
+
+// GetChecked
+// en: Returns true when checked
+//
+// pt_br: Retorna true quando marcada
+func (el *Checkbox) GetChecked() bool {
+	return el.checked
+}
+
+// SetChecked
+// en: Checks or unchecks the box without calling OnChange
+//
+// pt_br: Marca ou desmarca a caixa sem chamar OnChange
+func (el *Checkbox) SetChecked(checked bool) {
+	el.checked = checked
+	el.Invalidate()
+}
+
+// SetLabel
+// en: Replaces the label
+//
+// pt_br: Substitui o rótulo
+func (el *Checkbox) SetLabel(label string) {
+	el.label = label
+	el.Invalidate()
+}
+
+// toggle
+// en: Inverts the state and calls OnChange
+//
+// pt_br: Inverte o estado e chama OnChange
+func (el *Checkbox) toggle() {
+	el.checked = !el.checked
+	if el.OnChange != nil {
+		el.OnChange(el, el.checked)
+	}
+}
+
+// PointerUp
+// en: Toggles when the pointer is released over the checkbox
+//
+// pt_br: Alterna quando o ponteiro é solto sobre a caixa de seleção
+func (el *Checkbox) PointerUp(context *Context, x, y float64, inside bool) {
+	if inside {
+		el.toggle()
+	}
+}
+
+// KeyDown
+// en: Toggles with Space
+//
+// pt_br: Alterna com Espaço
+func (el *Checkbox) KeyDown(context *Context, event KeyEvent) bool {
+	if event.Key == KKeySpace {
+		el.toggle()
+		return true
+	}
+	return false
+}
+
+// Draw
+// en: Draws the box, the check mark and the label for the state
+//
+// pt_br: Desenha a caixa, a marca e o rótulo para o estado
+func (el *Checkbox) Draw(context *Context, state State) {
+	theme := context.Theme
+	platform := context.Platform
+	bounds := el.GetBounds()
+	size := math.Min(bounds.Height, 18)
+	square := collision.NewAABB(bounds.X, bounds.Y+(bounds.Height-size)/2, size, size)
+
+	fill, border := context.surfaceColor(state), theme.BorderColor
+	if el.checked && !state.Has(KStateDisabled) {
+		fill, border = theme.AccentColor, theme.AccentColor
+	}
+	box(platform, square, theme.Radius/2, fill, border, theme.BorderWidth)
+	if state.Has(KStateFocused) {
+		focusRing(context, square, theme.Radius/2)
+	}
+	if el.checked {
+		mark := theme.AccentTextColor
+		if state.Has(KStateDisabled) {
+			mark = theme.DisabledTextColor
+		}
+		platform.SetStrokeStyle(mark)
+		platform.SetLineWidth(math.Max(1.5, size/8))
+		platform.BeginPath()
+		platform.MoveTo(square.X+size*0.22, square.Y+size*0.52)
+		platform.LineTo(square.X+size*0.42, square.Y+size*0.72)
+		platform.LineTo(square.X+size*0.78, square.Y+size*0.3)
+		platform.Stroke()
+	}
+
+	left := square.MaxX() + theme.Padding
+	label := fitText(platform, el.label, bounds.MaxX()-left)
+	drawText(platform, label, left, baseline(context, bounds), context.textColor(state))
+}
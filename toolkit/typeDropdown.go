@@ -0,0 +1,381 @@
+package toolkit
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// KDefaultMaxRows
+// en: Number of rows of the open list of a dropdown when MaxRows is zero
+//
+// pt_br: Número de linhas da lista aberta de um dropdown quando MaxRows é zero
+const KDefaultMaxRows = 8
+
+// Dropdown
+// en: Button that opens a list of items below it and shows the selected one. The
+// list is an Overlay, drawn over the other widgets. Closed, the arrows change the
+// selection and Enter, Space or Alt+Down open the list; open, the arrows, Home
+// and End move the highlight, Enter or Space select and Escape closes
+//
+//	Placeholder: Text shown while nothing is selected
+//	MaxRows: Rows visible in the open list, which scrolls with the highlight;
+//	         0 uses KDefaultMaxRows
+//	OnChange: Called after each selection made by the user
+//
+// pt_br: Botão que abre uma lista de itens abaixo dele e mostra o selecionado. A
+// lista é uma Overlay, desenhada sobre os outros widgets. Fechado, as setas mudam
+// a seleção e Enter, Espaço ou Alt+Baixo abrem a lista; aberto, as setas, Home e
+// End movem o destaque, Enter ou Espaço selecionam e Escape fecha
+//
+//	Placeholder: Texto mostrado enquanto nada está selecionado
+//	MaxRows: Linhas visíveis na lista aberta, que rola com o destaque; 0 usa
+//	         KDefaultMaxRows
+//	OnChange: Chamada após cada seleção feita pelo usuário
+type Dropdown struct {
+	Base
+	Placeholder string
+	MaxRows     int
+	OnChange    func(dropdown *Dropdown, index int, item string)
+	items       []string
+	selected    int
+	highlight   int
+	top         int
+	open        bool
+}
+
+// NewDropdown
+// en: Returns a closed dropdown without selection
+//
+// pt_br: Retorna um dropdown fechado sem seleção
+func NewDropdown(id string, bounds collision.AABB, items []string, onChange func(dropdown *Dropdown, index int, item string)) *Dropdown {
+	return &Dropdown{
+		Base:     NewBase(id, bounds),
+		OnChange: onChange,
+		items:    append(make([]string, 0, len(items)), items...),
+		selected: -1,
+	}
+}
+
+// GetItems
+// en: Returns a copy of the items
+//
+// pt_br: Retorna uma cópia dos itens
+func (el *Dropdown) GetItems() []string {
+	return append(make([]string, 0, len(el.items)), el.items...)
+}
+
+// SetItems
+// en: Replaces the items; the selection is removed when it no longer exists
+//
+// pt_br: Substitui os itens; a seleção é removida quando ela não existe mais
+func (el *Dropdown) SetItems(items []string) {
+	el.items = append(make([]string, 0, len(items)), items...)
+	if el.selected >= len(el.items) {
+		el.selected = -1
+	}
+	el.highlight, el.top = 0, 0
+	el.Invalidate()
+}
+
+// GetSelected
+// en: Returns the index and the text of the selected item; index is -1 without
+// selection
+//
+// pt_br: Retorna o índice e o texto do item selecionado; o índice é -1 sem
+// seleção
+func (el *Dropdown) GetSelected() (index int, item string) {
+	if el.selected < 0 {
+		return -1, ""
+	}
+	return el.selected, el.items[el.selected]
+}
+
+// SetSelected
+// en: Selects an item without calling OnChange; -1 removes the selection
+//
+// pt_br: Seleciona um item sem chamar OnChange; -1 remove a seleção
+func (el *Dropdown) SetSelected(index int) {
+	if index < -1 || index >= len(el.items) {
+		index = -1
+	}
+	el.selected = index
+	el.Invalidate()
+}
+
+// GetOpen
+// en: Returns true while the list is open
+//
+// pt_br: Retorna true enquanto a lista está aberta
+func (el *Dropdown) GetOpen() bool {
+	return el.open
+}
+
+// SetOpen
+// en: Opens or closes the list; an empty list does not open
+//
+// pt_br: Abre ou fecha a lista; uma lista vazia não abre
+func (el *Dropdown) SetOpen(open bool) {
+	el.open = open && len(el.items) != 0
+	if el.open {
+		el.highlight = el.selected
+		if el.highlight < 0 {
+			el.highlight = 0
+		}
+		el.reveal()
+	}
+	el.Invalidate()
+}
+
+// rows
+// en: Returns the number of visible rows of the open list
+//
+// pt_br: Retorna o número de linhas visíveis da lista aberta
+func (el *Dropdown) rows() int {
+	rows := el.MaxRows
+	if rows <= 0 {
+		rows = KDefaultMaxRows
+	}
+	if rows > len(el.items) {
+		rows = len(el.items)
+	}
+	return rows
+}
+
+// reveal
+// en: Scrolls the list so the highlighted row is visible
+//
+// pt_br: Rola a lista para que a linha destacada fique visível
+func (el *Dropdown) reveal() {
+	rows := el.rows()
+	if el.highlight < el.top {
+		el.top = el.highlight
+	}
+	if el.highlight >= el.top+rows {
+		el.top = el.highlight - rows + 1
+	}
+	if el.top > len(el.items)-rows {
+		el.top = len(el.items) - rows
+	}
+	if el.top < 0 {
+		el.top = 0
+	}
+}
+
+// choose
+// en: Selects an item, closes the list and calls OnChange when the selection
+// changed
+//
+// pt_br: Seleciona um item, fecha a lista e chama OnChange quando a seleção mudou
+func (el *Dropdown) choose(index int) {
+	el.open = false
+	if index < 0 || index >= len(el.items) || index == el.selected {
+		return
+	}
+	el.selected = index
+	if el.OnChange != nil {
+		el.OnChange(el, index, el.items[index])
+	}
+}
+
+// GetOverlay
+// en: Returns the rectangle of the open list, below the dropdown
+//
+// pt_br: Retorna o retângulo da lista aberta, abaixo do dropdown
+func (el *Dropdown) GetOverlay(context *Context) (bounds collision.AABB, open bool) {
+	header := el.GetBounds()
+	return collision.NewAABB(header.X, header.MaxY(), header.Width, header.Height*float64(el.rows())), el.open
+}
+
+// rowAt
+// en: Returns the index of the item under the point of the open list, or -1
+//
+// pt_br: Retorna o índice do item sob o ponto da lista aberta, ou -1
+func (el *Dropdown) rowAt(context *Context, x, y float64) int {
+	list, open := el.GetOverlay(context)
+	if !open || !list.ContainsPoint(x, y) {
+		return -1
+	}
+	index := el.top + int(math.Floor((y-list.Y)/el.GetBounds().Height))
+	if index >= len(el.items) {
+		return -1
+	}
+	return index
+}
+
+// PointerDown
+// en: Opens or closes the list when the dropdown is pressed; highlights a row of
+// the open list
+//
+// pt_br: Abre ou fecha a lista quando o dropdown é pressionado; destaca uma linha
+// da lista aberta
+func (el *Dropdown) PointerDown(context *Context, x, y float64) {
+	if row := el.rowAt(context, x, y); row != -1 {
+		el.highlight = row
+		return
+	}
+	el.SetOpen(!el.open)
+}
+
+// PointerMove
+// en: Highlights the row under the pointer
+//
+// pt_br: Destaca a linha sob o ponteiro
+func (el *Dropdown) PointerMove(context *Context, x, y float64, pressed bool) {
+	if row := el.rowAt(context, x, y); row != -1 && row != el.highlight {
+		el.highlight = row
+		el.Invalidate()
+	}
+}
+
+// PointerUp
+// en: Selects the row under the pointer, also after a drag from the dropdown
+//
+// pt_br: Seleciona a linha sob o ponteiro, também após um arrasto a partir do
+// dropdown
+func (el *Dropdown) PointerUp(context *Context, x, y float64, inside bool) {
+	if row := el.rowAt(context, x, y); inside && row != -1 {
+		el.choose(row)
+	}
+}
+
+// KeyDown
+// en: Navigates the items; see Dropdown
+//
+// pt_br: Navega pelos itens; veja Dropdown
+func (el *Dropdown) KeyDown(context *Context, event KeyEvent) bool {
+	if len(el.items) == 0 {
+		return false
+	}
+	if !el.open {
+		switch {
+		case event.Key == KKeyEnter || event.Key == KKeySpace || (event.Alt && event.Key == KKeyArrowDown):
+			el.SetOpen(true)
+		case event.Key == KKeyArrowDown:
+			el.choose(int(math.Min(float64(el.selected+1), float64(len(el.items)-1))))
+		case event.Key == KKeyArrowUp:
+			el.choose(int(math.Max(float64(el.selected-1), 0)))
+		case event.Key == KKeyHome:
+			el.choose(0)
+		case event.Key == KKeyEnd:
+			el.choose(len(el.items) - 1)
+		default:
+			return false
+		}
+		return true
+	}
+
+	switch event.Key {
+	case KKeyArrowDown:
+		el.highlight += 1
+	case KKeyArrowUp:
+		el.highlight -= 1
+	case KKeyPageDown:
+		el.highlight += el.rows()
+	case KKeyPageUp:
+		el.highlight -= el.rows()
+	case KKeyHome:
+		el.highlight = 0
+	case KKeyEnd:
+		el.highlight = len(el.items) - 1
+	case KKeyEnter, KKeySpace:
+		el.choose(el.highlight)
+		return true
+	case KKeyEscape:
+		el.open = false
+		return true
+	case KKeyTab:
+		el.open = false
+		return false
+	default:
+		return false
+	}
+	el.highlight = int(math.Max(0, math.Min(float64(len(el.items)-1), float64(el.highlight))))
+	el.reveal()
+	return true
+}
+
+// Blur
+// en: Closes the list when the focus leaves
+//
+// pt_br: Fecha a lista quando o foco sai
+func (el *Dropdown) Blur(context *Context) {
+	el.open = false
+}
+
+// Draw
+// en: Draws the dropdown with the selected item and an arrow
+//
+// pt_br: Desenha o dropdown com o item selecionado e uma seta
+func (el *Dropdown) Draw(context *Context, state State) {
+	theme := context.Theme
+	platform := context.Platform
+	bounds := el.GetBounds()
+	box(platform, bounds, theme.Radius, context.surfaceColor(state), theme.BorderColor, theme.BorderWidth)
+	if state.Has(KStateFocused) {
+		focusRing(context, bounds, theme.Radius)
+	}
+
+	size := math.Min(bounds.Height/4, 5)
+	arrowX := bounds.MaxX() - theme.Padding - size
+	centerY := bounds.Y + bounds.Height/2
+	platform.SetStrokeStyle(context.textColor(state))
+	platform.SetLineWidth(1.5)
+	platform.BeginPath()
+	if el.open {
+		platform.MoveTo(arrowX-size, centerY+size/2)
+		platform.LineTo(arrowX, centerY-size/2)
+		platform.LineTo(arrowX+size, centerY+size/2)
+	} else {
+		platform.MoveTo(arrowX-size, centerY-size/2)
+		platform.LineTo(arrowX, centerY+size/2)
+		platform.LineTo(arrowX+size, centerY-size/2)
+	}
+	platform.Stroke()
+
+	text, fill := el.Placeholder, theme.PlaceholderColor
+	if index, item := el.GetSelected(); index != -1 {
+		text, fill = item, context.textColor(state)
+	}
+	width := arrowX - size - theme.Padding - (bounds.X + theme.Padding)
+	drawText(platform, fitText(platform, text, width), bounds.X+theme.Padding, baseline(context, bounds), fill)
+}
+
+// DrawOverlay
+// en: Draws the open list, with the highlighted and the selected rows
+//
+// pt_br: Desenha a lista aberta, com as linhas destacada e selecionada
+func (el *Dropdown) DrawOverlay(context *Context, state State) {
+	theme := context.Theme
+	platform := context.Platform
+	list, _ := el.GetOverlay(context)
+	box(platform, list, 0, theme.SurfaceColor, theme.BorderColor, theme.BorderWidth)
+
+	height := el.GetBounds().Height
+	for row := 0; row < el.rows(); row += 1 {
+		index := el.top + row
+		area := collision.NewAABB(list.X, list.Y+float64(row)*height, list.Width, height)
+		fill := color.RGBA{}
+		switch {
+		case index == el.highlight:
+			fill = theme.HoverColor
+		case index == el.selected:
+			fill = theme.SelectionColor
+		}
+		if fill.A != 0 {
+			box(platform, area, 0, fill, color.RGBA{}, 0)
+		}
+		text := fitText(platform, el.items[index], list.Width-2*theme.Padding)
+		drawText(platform, text, list.X+theme.Padding, baseline(context, area), theme.TextColor)
+	}
+
+	// en: scroll bar, when there are hidden items
+	// pt_br: barra de rolagem, quando há itens ocultos
+	if len(el.items) > el.rows() {
+		thumb := list.Height * float64(el.rows()) / float64(len(el.items))
+		offset := (list.Height - thumb) * float64(el.top) / float64(len(el.items)-el.rows())
+		bar := collision.NewAABB(list.MaxX()-4, list.Y+offset, 3, thumb)
+		box(platform, bar, 1.5, theme.BorderColor, color.RGBA{}, 0)
+	}
+}
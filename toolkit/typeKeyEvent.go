@@ -0,0 +1,40 @@
+package toolkit
+
+// KeyEvent
+// en: Key pressed, with the names of the key property of the DOM KeyboardEvent,
+// like "Enter", "ArrowLeft" or "a"
+//
+// pt_br: Tecla pressionada, com os nomes da propriedade key do KeyboardEvent do
+// DOM, como "Enter", "ArrowLeft" ou "a"
+type KeyEvent struct {
+	Key     string
+	Shift   bool
+	Control bool
+	Alt     bool
+	Meta    bool
+}
+
+const (
+	KKeyTab        = "Tab"
+	KKeyEnter      = "Enter"
+	KKeySpace      = " "
+	KKeyEscape     = "Escape"
+	KKeyBackspace  = "Backspace"
+	KKeyDelete     = "Delete"
+	KKeyArrowLeft  = "ArrowLeft"
+	KKeyArrowRight = "ArrowRight"
+	KKeyArrowUp    = "ArrowUp"
+	KKeyArrowDown  = "ArrowDown"
+	KKeyHome       = "Home"
+	KKeyEnd        = "End"
+	KKeyPageUp     = "PageUp"
+	KKeyPageDown   = "PageDown"
+)
+
+// shortcut
+// en: Returns true when Control, or Meta on macOS, is held
+//
+// pt_br: Retorna true quando Control, ou Meta no macOS, está pressionada
+func (el KeyEvent) shortcut() bool {
+	return el.Control || el.Meta
+}
@@ -0,0 +1,194 @@
+package toolkit
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// Slider
+// en: Horizontal track with a thumb that selects a value between Min and Max.
+// The thumb follows the pointer while pressed; the arrow keys move it by Step,
+// Page Up and Page Down by a tenth of the range, and Home and End to the limits
+//
+//	Step: Values are rounded to multiples of Step from Min; 0 disables the
+//	      rounding and the arrows move by a hundredth of the range
+//	OnChange: Called after each change made by the user
+//
+// pt_br: Trilha horizontal com um cursor que seleciona um valor entre Min e Max.
+// O cursor segue o ponteiro enquanto pressionado; as setas o movem por Step,
+// Page Up e Page Down por um décimo da faixa, e Home e End até os limites
+//
+//	Step: Valores são arredondados para múltiplos de Step a partir de Min; 0
+//	      desabilita o arredondamento e as setas movem por um centésimo da faixa
+//	OnChange: Chamada após cada mudança feita pelo usuário
+type Slider struct {
+	Base
+	Min      float64
+	Max      float64
+	Step     float64
+	OnChange func(slider *Slider, value float64)
+	value    float64
+}
+
+// NewSlider
+// en: Returns a slider at Min
+//
+// pt_br: Retorna um controle deslizante em Min
+func NewSlider(id string, bounds collision.AABB, min, max, step float64, onChange func(slider *Slider, value float64)) *Slider {
+	if max < min {
+		min, max = max, min
+	}
+	return &Slider{Base: NewBase(id, bounds), Min: min, Max: max, Step: step, OnChange: onChange, value: min}
+}
+
+// GetValue
+// en: Returns the value
+//
+// pt_br: Retorna o valor
+func (el *Slider) GetValue() float64 {
+	return el.value
+}
+
+// SetValue
+// en: Sets the value, limited and rounded to Step, without calling OnChange
+//
+// pt_br: Define o valor, limitado e arredondado para Step, sem chamar OnChange
+func (el *Slider) SetValue(value float64) {
+	el.value = el.normalize(value)
+	el.Invalidate()
+}
+
+// normalize
+// en: Rounds the value to Step and limits it to the range
+//
+// pt_br: Arredonda o valor para Step e o limita à faixa
+func (el *Slider) normalize(value float64) float64 {
+	if el.Step > 0 {
+		value = el.Min + math.Round((value-el.Min)/el.Step)*el.Step
+	}
+	return math.Max(el.Min, math.Min(el.Max, value))
+}
+
+// change
+// en: Sets the value and calls OnChange when it changed
+//
+// pt_br: Define o valor e chama OnChange quando ele mudou
+func (el *Slider) change(value float64) {
+	value = el.normalize(value)
+	if value == el.value {
+		return
+	}
+	el.value = value
+	if el.OnChange != nil {
+		el.OnChange(el, value)
+	}
+}
+
+// track
+// en: Returns the horizontal limits of the center of the thumb and its radius
+//
+// pt_br: Retorna os limites horizontais do centro do cursor e o seu raio
+func (el *Slider) track() (left, right, radius float64) {
+	bounds := el.GetBounds()
+	radius = math.Min(bounds.Height/2, 8)
+	return bounds.X + radius, bounds.MaxX() - radius, radius
+}
+
+// valueAt
+// en: Returns the value under the x coordinate
+//
+// pt_br: Retorna o valor sob a coordenada x
+func (el *Slider) valueAt(x float64) float64 {
+	left, right, _ := el.track()
+	if right <= left {
+		return el.Min
+	}
+	return el.Min + (x-left)/(right-left)*(el.Max-el.Min)
+}
+
+// PointerDown
+// en: Moves the thumb to the pointer
+//
+// pt_br: Move o cursor para o ponteiro
+func (el *Slider) PointerDown(context *Context, x, y float64) {
+	el.change(el.valueAt(x))
+}
+
+// PointerMove
+// en: Drags the thumb while pressed
+//
+// pt_br: Arrasta o cursor enquanto pressionado
+func (el *Slider) PointerMove(context *Context, x, y float64, pressed bool) {
+	if pressed {
+		el.change(el.valueAt(x))
+	}
+}
+
+// KeyDown
+// en: Moves the thumb with the arrows, Page Up, Page Down, Home and End
+//
+// pt_br: Move o cursor com as setas, Page Up, Page Down, Home e End
+func (el *Slider) KeyDown(context *Context, event KeyEvent) bool {
+	step := el.Step
+	if step <= 0 {
+		step = (el.Max - el.Min) / 100
+	}
+	page := math.Max(step, (el.Max-el.Min)/10)
+	switch event.Key {
+	case KKeyArrowLeft, KKeyArrowDown:
+		el.change(el.value - step)
+	case KKeyArrowRight, KKeyArrowUp:
+		el.change(el.value + step)
+	case KKeyPageDown:
+		el.change(el.value - page)
+	case KKeyPageUp:
+		el.change(el.value + page)
+	case KKeyHome:
+		el.change(el.Min)
+	case KKeyEnd:
+		el.change(el.Max)
+	default:
+		return false
+	}
+	return true
+}
+
+// Draw
+// en: Draws the track, the part up to the value and the thumb
+//
+// pt_br: Desenha a trilha, a parte até o valor e o cursor
+func (el *Slider) Draw(context *Context, state State) {
+	theme := context.Theme
+	bounds := el.GetBounds()
+	left, right, radius := el.track()
+	centerY := bounds.Y + bounds.Height/2
+	fraction := 0.0
+	if el.Max > el.Min {
+		fraction = (el.value - el.Min) / (el.Max - el.Min)
+	}
+	thumbX := left + (right-left)*fraction
+
+	accent := theme.AccentColor
+	if state.Has(KStateDisabled) {
+		accent = theme.DisabledTextColor
+	}
+	height := math.Max(2, radius/2)
+	track := collision.NewAABB(left, centerY-height/2, right-left, height)
+	box(context.Platform, track, height/2, theme.PressedColor, color.RGBA{}, 0)
+	track.Width = thumbX - left
+	box(context.Platform, track, height/2, accent, color.RGBA{}, 0)
+
+	thumb := collision.NewAABB(thumbX-radius, centerY-radius, 2*radius, 2*radius)
+	fill := theme.SurfaceColor
+	if state.Has(KStatePressed) {
+		fill = theme.PressedColor
+	} else if state.Has(KStateHover) {
+		fill = theme.HoverColor
+	}
+	box(context.Platform, thumb, radius, fill, accent, math.Max(theme.BorderWidth, 2))
+	if state.Has(KStateFocused) {
+		focusRing(context, thumb, radius)
+	}
+}
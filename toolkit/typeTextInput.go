@@ -0,0 +1,397 @@
+package toolkit
+
+import (
+	"image/color"
+	"strings"
+	"unicode"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+)
+
+// TextInput
+// en: Single line text field with caret, selection and horizontal scroll. The
+// platform has no clipboard, so pasted text arrives by Toolkit.TextInput() and
+// GetSelectedText() gives the text to be copied
+//
+//	Placeholder: Text shown while the field is empty
+//	Password: Shows a bullet for each character
+//	MaxLength: Maximum number of characters; 0 is unlimited
+//	OnChange: Called after each change made by the user
+//	OnSubmit: Called by Enter
+//
+//	Keys: Left, Right, Home and End move the caret, with Shift they select;
+//	Backspace and Delete remove; Control+A, or Meta+A, selects all
+//
+// pt_br: Campo de texto de uma linha com cursor, seleção e rolagem horizontal. A
+// plataforma não tem área de transferência, então texto colado chega por
+// Toolkit.TextInput() e GetSelectedText() dá o texto a ser copiado
+//
+//	Placeholder: Texto mostrado enquanto o campo está vazio
+//	Password: Mostra um ponto para cada caractere
+//	MaxLength: Número máximo de caracteres; 0 é ilimitado
+//	OnChange: Chamada após cada mudança feita pelo usuário
+//	OnSubmit: Chamada por Enter
+//
+//	Teclas: Esquerda, Direita, Home e End movem o cursor, com Shift elas
+//	selecionam; Backspace e Delete removem; Control+A, ou Meta+A, seleciona tudo
+type TextInput struct {
+	Base
+	Placeholder string
+	Password    bool
+	MaxLength   int
+	OnChange    func(input *TextInput, text string)
+	OnSubmit    func(input *TextInput, text string)
+	text        []rune
+	caret       int
+	anchor      int
+	first       int
+}
+
+// NewTextInput
+// en: Returns an empty text field
+//
+// pt_br: Retorna um campo de texto vazio
+func NewTextInput(id string, bounds collision.AABB, placeholder string, onChange func(input *TextInput, text string)) *TextInput {
+	return &TextInput{Base: NewBase(id, bounds), Placeholder: placeholder, OnChange: onChange, text: make([]rune, 0)}
+}
+
+// GetText
+// en: Returns the text
+//
+// pt_br: Retorna o texto
+func (el *TextInput) GetText() string {
+	return string(el.text)
+}
+
+// SetText
+// en: Replaces the text without calling OnChange; the caret goes to the end
+//
+// pt_br: Substitui o texto sem chamar OnChange; o cursor vai para o fim
+func (el *TextInput) SetText(text string) {
+	el.text = []rune(text)
+	if el.MaxLength > 0 && len(el.text) > el.MaxLength {
+		el.text = el.text[:el.MaxLength]
+	}
+	el.caret, el.anchor, el.first = len(el.text), len(el.text), 0
+	el.Invalidate()
+}
+
+// GetSelection
+// en: Returns the selected range, in characters; start equals end when nothing is
+// selected
+//
+// pt_br: Retorna a faixa selecionada, em caracteres; start é igual a end quando
+// nada está selecionado
+func (el *TextInput) GetSelection() (start, end int) {
+	if el.anchor < el.caret {
+		return el.anchor, el.caret
+	}
+	return el.caret, el.anchor
+}
+
+// SetSelection
+// en: Selects a range of characters; the caret stays at end
+//
+// pt_br: Seleciona uma faixa de caracteres; o cursor fica em end
+func (el *TextInput) SetSelection(start, end int) {
+	el.anchor = el.limit(start)
+	el.caret = el.limit(end)
+	el.Invalidate()
+}
+
+// GetSelectedText
+// en: Returns the selected text, to be copied by the application
+//
+// pt_br: Retorna o texto selecionado, para ser copiado pela aplicação
+func (el *TextInput) GetSelectedText() string {
+	start, end := el.GetSelection()
+	return string(el.text[start:end])
+}
+
+// Cursor
+// en: Shows the text cursor
+//
+// pt_br: Mostra o cursor de texto
+func (el *TextInput) Cursor(x, y float64) Cursor {
+	return KCursorText
+}
+
+// limit
+// en: Limits a position to the text
+//
+// pt_br: Limita uma posição ao texto
+func (el *TextInput) limit(position int) int {
+	if position < 0 {
+		return 0
+	}
+	if position > len(el.text) {
+		return len(el.text)
+	}
+	return position
+}
+
+// display
+// en: Returns the characters shown, bullets for passwords
+//
+// pt_br: Retorna os caracteres mostrados, pontos para senhas
+func (el *TextInput) display() []rune {
+	if el.Password {
+		return []rune(strings.Repeat("•", len(el.text)))
+	}
+	return el.text
+}
+
+// content
+// en: Returns the area of the text, inside the padding
+//
+// pt_br: Retorna a área do texto, dentro do espaçamento
+func (el *TextInput) content(context *Context) collision.AABB {
+	bounds := el.GetBounds()
+	padding := context.Theme.Padding
+	return collision.NewAABB(bounds.X+padding, bounds.Y, bounds.Width-2*padding, bounds.Height)
+}
+
+// scroll
+// en: Adjusts the first visible character so the caret is visible
+//
+// pt_br: Ajusta o primeiro caractere visível para que o cursor fique visível
+func (el *TextInput) scroll(context *Context) {
+	display := el.display()
+	width := el.content(context).Width
+	if el.first > el.caret {
+		el.first = el.caret
+	}
+	for el.first < el.caret && context.MeasureText(string(display[el.first:el.caret])) > width {
+		el.first += 1
+	}
+}
+
+// positionAt
+// en: Returns the caret position nearest to the x coordinate
+//
+// pt_br: Retorna a posição do cursor mais próxima da coordenada x
+func (el *TextInput) positionAt(context *Context, x float64) int {
+	display := el.display()
+	offset := x - el.content(context).X
+	previous := 0.0
+	for i := el.first; i < len(display); i += 1 {
+		width := context.MeasureText(string(display[el.first : i+1]))
+		if offset < (previous+width)/2 {
+			return i
+		}
+		previous = width
+	}
+	return len(display)
+}
+
+// replace
+// en: Replaces the selection with the text and calls OnChange
+//
+// pt_br: Substitui a seleção pelo texto e chama OnChange
+func (el *TextInput) replace(context *Context, text []rune) {
+	start, end := el.GetSelection()
+	if el.MaxLength > 0 {
+		room := el.MaxLength - (len(el.text) - (end - start))
+		if room < 0 {
+			room = 0
+		}
+		if len(text) > room {
+			text = text[:room]
+		}
+	}
+	if start == end && len(text) == 0 {
+		return
+	}
+	updated := make([]rune, 0, len(el.text)-(end-start)+len(text))
+	updated = append(updated, el.text[:start]...)
+	updated = append(updated, text...)
+	updated = append(updated, el.text[end:]...)
+	el.text = updated
+	el.caret = start + len(text)
+	el.anchor = el.caret
+	el.scroll(context)
+	if el.OnChange != nil {
+		el.OnChange(el, string(el.text))
+	}
+}
+
+// move
+// en: Moves the caret, extending the selection when selection is true
+//
+// pt_br: Move o cursor, estendendo a seleção quando selection é true
+func (el *TextInput) move(context *Context, position int, selection bool) {
+	el.caret = el.limit(position)
+	if !selection {
+		el.anchor = el.caret
+	}
+	el.scroll(context)
+}
+
+// PointerDown
+// en: Places the caret under the pointer
+//
+// pt_br: Posiciona o cursor sob o ponteiro
+func (el *TextInput) PointerDown(context *Context, x, y float64) {
+	el.move(context, el.positionAt(context, x), false)
+}
+
+// PointerMove
+// en: Selects while pressed
+//
+// pt_br: Seleciona enquanto pressionado
+func (el *TextInput) PointerMove(context *Context, x, y float64, pressed bool) {
+	if pressed {
+		el.move(context, el.positionAt(context, x), true)
+	}
+}
+
+// KeyDown
+// en: Edits the text and moves the caret; printable keys arrive by TextInput()
+//
+// pt_br: Edita o texto e move o cursor; teclas imprimíveis chegam por
+// TextInput()
+func (el *TextInput) KeyDown(context *Context, event KeyEvent) bool {
+	start, end := el.GetSelection()
+	switch {
+	case event.Key == KKeyArrowLeft:
+		if start != end && !event.Shift {
+			el.move(context, start, false)
+		} else {
+			el.move(context, el.caret-1, event.Shift)
+		}
+	case event.Key == KKeyArrowRight:
+		if start != end && !event.Shift {
+			el.move(context, end, false)
+		} else {
+			el.move(context, el.caret+1, event.Shift)
+		}
+	case event.Key == KKeyHome:
+		el.move(context, 0, event.Shift)
+	case event.Key == KKeyEnd:
+		el.move(context, len(el.text), event.Shift)
+	case event.Key == KKeyBackspace:
+		if start == end && start > 0 {
+			el.anchor = start - 1
+		}
+		el.replace(context, nil)
+	case event.Key == KKeyDelete:
+		if start == end && end < len(el.text) {
+			el.anchor = end + 1
+		}
+		el.replace(context, nil)
+	case event.Key == KKeyEnter:
+		if el.OnSubmit != nil {
+			el.OnSubmit(el, string(el.text))
+		}
+	case event.shortcut() && strings.EqualFold(event.Key, "a"):
+		el.anchor, el.caret = 0, len(el.text)
+	default:
+		return false
+	}
+	return true
+}
+
+// TextInput
+// en: Inserts typed or pasted text in place of the selection; line breaks become
+// spaces and other control characters are removed
+//
+// pt_br: Insere texto digitado ou colado no lugar da seleção; quebras de linha
+// viram espaços e outros caracteres de controle são removidos
+func (el *TextInput) TextInput(context *Context, text string) {
+	filtered := make([]rune, 0, len(text))
+	for _, character := range strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\t", " ").Replace(text) {
+		if !unicode.IsControl(character) {
+			filtered = append(filtered, character)
+		}
+	}
+	el.replace(context, filtered)
+}
+
+// Blur
+// en: Removes the selection when the focus leaves
+//
+// pt_br: Remove a seleção quando o foco sai
+func (el *TextInput) Blur(context *Context) {
+	el.anchor = el.caret
+}
+
+// Draw
+// en: Draws the field, the visible part of the text, the selection and the caret
+//
+// pt_br: Desenha o campo, a parte visível do texto, a seleção e o cursor
+func (el *TextInput) Draw(context *Context, state State) {
+	theme := context.Theme
+	platform := context.Platform
+	bounds := el.GetBounds()
+	focused := state.Has(KStateFocused)
+	fill := theme.SurfaceColor
+	if state.Has(KStateHover) && !focused {
+		fill = theme.HoverColor
+	}
+	border := theme.BorderColor
+	if focused {
+		border = theme.AccentColor
+	}
+	box(platform, bounds, theme.Radius, fill, border, theme.BorderWidth)
+	if focused {
+		focusRing(context, bounds, theme.Radius)
+	}
+
+	area := el.content(context)
+	y := baseline(context, bounds)
+	if len(el.text) == 0 {
+		if el.Placeholder != "" && !focused {
+			drawText(platform, fitText(platform, el.Placeholder, area.Width), area.X, y, theme.PlaceholderColor)
+		}
+		if focused {
+			el.drawCaret(context, area, area.X)
+		}
+		return
+	}
+
+	// en: the platform has no clip, so only the characters that fit are drawn
+	// pt_br: a plataforma não tem recorte, então só os caracteres que cabem são
+	// desenhados
+	display := el.display()
+	first := el.first
+	if first > len(display) {
+		first = 0
+	}
+	last := first
+	for last < len(display) && platform.MeasureText(string(display[first:last+1])).Width <= area.Width {
+		last += 1
+	}
+	offset := func(position int) float64 {
+		if position < first {
+			position = first
+		}
+		if position > last {
+			position = last
+		}
+		return area.X + platform.MeasureText(string(display[first:position])).Width
+	}
+
+	start, end := el.GetSelection()
+	if focused && start != end && end > first && start < last {
+		selection := collision.NewAABB(offset(start), bounds.Y+3, offset(end)-offset(start), bounds.Height-6)
+		box(platform, selection, 0, theme.SelectionColor, color.RGBA{}, 0)
+	}
+	drawText(platform, string(display[first:last]), area.X, y, context.textColor(state))
+	if focused {
+		el.drawCaret(context, area, offset(el.caret))
+	}
+}
+
+// drawCaret
+// en: Draws the caret as a vertical line at x
+//
+// pt_br: Desenha o cursor como uma linha vertical em x
+func (el *TextInput) drawCaret(context *Context, area collision.AABB, x float64) {
+	platform := context.Platform
+	platform.SetStrokeStyle(context.Theme.TextColor)
+	platform.SetLineWidth(1)
+	platform.BeginPath()
+	platform.MoveTo(x+0.5, area.Y+4)
+	platform.LineTo(x+0.5, area.MaxY()-4)
+	platform.Stroke()
+}
@@ -0,0 +1,111 @@
+package toolkit
+
+import (
+	"image/color"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/browserMouse"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// Cursor
+// en: Role of the mouse cursor over a widget; Theme.Cursors converts it into the
+// cursor of the platform
+//
+// pt_br: Papel do cursor do mouse sobre um widget; Theme.Cursors o converte no
+// cursor da plataforma
+type Cursor int
+
+const (
+	// KCursorDefault
+	// en: Cursor captured from the platform by NewToolkit()
+	//
+	// pt_br: Cursor capturado da plataforma por NewToolkit()
+	KCursorDefault Cursor = iota
+
+	// KCursorPointer
+	// en: Clickable widgets, like buttons
+	//
+	// pt_br: Widgets clicáveis, como botões
+	KCursorPointer
+
+	// KCursorText
+	// en: Editable text
+	//
+	// pt_br: Texto editável
+	KCursorText
+
+	// KCursorDisabled
+	// en: Disabled widgets
+	//
+	// pt_br: Widgets desabilitados
+	KCursorDisabled
+)
+
+// Theme
+// en: Colors, font and sizes shared by the widgets of a toolkit
+//
+//	Font: Optional; when nil, the current font of the platform is used
+//	Cursors: Cursor of the platform for each role; roles missing in the map use
+//	         the cursor captured by NewToolkit(). The values of
+//	         browserMouse.CursorType are defined by the platform module, so the
+//	         map is filled by the application, like
+//	         {KCursorPointer: browserMouse.KCursorPointer}
+//	BorderWidth, FocusWidth: Widths of the border and of the focus ring
+//	Radius: Radius of the rounded corners
+//	Padding: Space between the border and the content
+//
+// pt_br: Cores, fonte e tamanhos compartilhados pelos widgets de um toolkit
+//
+//	Font: Opcional; quando nil, a fonte atual da plataforma é usada
+//	Cursors: Cursor da plataforma para cada papel; papéis ausentes no mapa usam o
+//	         cursor capturado por NewToolkit(). Os valores de
+//	         browserMouse.CursorType são definidos pelo módulo da plataforma,
+//	         então o mapa é preenchido pela aplicação, como
+//	         {KCursorPointer: browserMouse.KCursorPointer}
+//	BorderWidth, FocusWidth: Larguras da borda e do anel de foco
+//	Radius: Raio dos cantos arredondados
+//	Padding: Espaço entre a borda e o conteúdo
+type Theme struct {
+	Font              *font.Font
+	Cursors           map[Cursor]browserMouse.CursorType
+	TextColor         color.RGBA
+	DisabledTextColor color.RGBA
+	PlaceholderColor  color.RGBA
+	SurfaceColor      color.RGBA
+	HoverColor        color.RGBA
+	PressedColor      color.RGBA
+	BorderColor       color.RGBA
+	AccentColor       color.RGBA
+	AccentTextColor   color.RGBA
+	FocusColor        color.RGBA
+	SelectionColor    color.RGBA
+	BorderWidth       float64
+	FocusWidth        float64
+	Radius            float64
+	Padding           float64
+}
+
+// DefaultTheme
+// en: Returns a light theme, with blue accents
+//
+// pt_br: Retorna um tema claro, com destaques em azul
+func DefaultTheme() Theme {
+	return Theme{
+		Cursors:           make(map[Cursor]browserMouse.CursorType),
+		TextColor:         color.RGBA{R: 0x21, G: 0x21, B: 0x21, A: 0xff},
+		DisabledTextColor: color.RGBA{R: 0x9e, G: 0x9e, B: 0x9e, A: 0xff},
+		PlaceholderColor:  color.RGBA{R: 0x75, G: 0x75, B: 0x75, A: 0xff},
+		SurfaceColor:      color.RGBA{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff},
+		HoverColor:        color.RGBA{R: 0xee, G: 0xee, B: 0xee, A: 0xff},
+		PressedColor:      color.RGBA{R: 0xdd, G: 0xdd, B: 0xdd, A: 0xff},
+		BorderColor:       color.RGBA{R: 0x9e, G: 0x9e, B: 0x9e, A: 0xff},
+		AccentColor:       color.RGBA{R: 0x19, G: 0x76, B: 0xd2, A: 0xff},
+		AccentTextColor:   color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		FocusColor:        color.RGBA{R: 0x19, G: 0x76, B: 0xd2, A: 0x80},
+		SelectionColor:    color.RGBA{R: 0x90, G: 0xca, B: 0xf9, A: 0xff},
+		BorderWidth:       1,
+		FocusWidth:        2,
+		Radius:            4,
+		Padding:           8,
+	}
+}
@@ -0,0 +1,443 @@
+package toolkit
+
+import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/browserMouse"
+)
+
+// Toolkit
+// en: Retained set of widgets with focus, hover and pressed states. The toolkit
+// does not listen to events by itself: the application forwards the events of
+// IDraw.AddEventListener(), or of any other backend, to PointerMove(),
+// PointerDown(), PointerUp(), KeyDown() and TextInput(), and calls Draw() when
+// NeedsRedraw() returns true
+//
+// pt_br: Conjunto retido de widgets com estados de foco, hover e pressionado. O
+// toolkit não escuta eventos sozinho: a aplicação repassa os eventos de
+// IDraw.AddEventListener(), ou de qualquer outro backend, para PointerMove(),
+// PointerDown(), PointerUp(), KeyDown() e TextInput(), e chama Draw() quando
+// NeedsRedraw() retorna true
+type Toolkit struct {
+	platform Platform
+	theme    Theme
+	widgets  []Widget
+	focus    Widget
+	hover    Widget
+	pressed  Widget
+	initial  browserMouse.CursorType
+	cursor   browserMouse.CursorType
+	pointerX float64
+	pointerY float64
+	dirty    bool
+}
+
+// NewToolkit
+// en: Returns an empty toolkit; the current cursor of the platform becomes the
+// cursor of KCursorDefault
+//
+// pt_br: Retorna um toolkit vazio; o cursor atual da plataforma vira o cursor de
+// KCursorDefault
+func NewToolkit(platform Platform, theme Theme) *Toolkit {
+	cursor := platform.GetMouseCursor()
+	return &Toolkit{
+		platform: platform,
+		theme:    theme,
+		widgets:  make([]Widget, 0),
+		initial:  cursor,
+		cursor:   cursor,
+		dirty:    true,
+	}
+}
+
+// context
+// en: Returns the context given to the widgets
+//
+// pt_br: Retorna o contexto dado aos widgets
+func (el *Toolkit) context() *Context {
+	return &Context{Platform: el.platform, Theme: &el.theme}
+}
+
+// GetTheme
+// en: Returns the theme in use
+//
+// pt_br: Retorna o tema em uso
+func (el *Toolkit) GetTheme() Theme {
+	return el.theme
+}
+
+// SetTheme
+// en: Replaces the theme and asks for a redraw
+//
+// pt_br: Substitui o tema e pede um redesenho
+func (el *Toolkit) SetTheme(theme Theme) {
+	el.theme = theme
+	el.dirty = true
+	el.updateCursor(el.hover, el.pointerX, el.pointerY)
+}
+
+// Add
+// en: Adds widgets; they are drawn and receive the focus in the order added
+//
+// pt_br: Adiciona widgets; eles são desenhados e recebem o foco na ordem em que
+// foram adicionados
+func (el *Toolkit) Add(widgets ...Widget) {
+	for _, widget := range widgets {
+		widget.base().toolkit = el
+		el.widgets = append(el.widgets, widget)
+	}
+	el.dirty = true
+}
+
+// Remove
+// en: Removes the widget with the id; returns false when it does not exist
+//
+// pt_br: Remove o widget com o id; retorna false quando ele não existe
+func (el *Toolkit) Remove(id string) bool {
+	for i, widget := range el.widgets {
+		if widget.GetId() != id {
+			continue
+		}
+		if el.focus == widget {
+			el.setFocus(nil)
+		}
+		if el.hover == widget {
+			el.hover = nil
+			el.updateCursor(nil, el.pointerX, el.pointerY)
+		}
+		if el.pressed == widget {
+			el.pressed = nil
+		}
+		widget.base().toolkit = nil
+		el.widgets = append(el.widgets[:i], el.widgets[i+1:]...)
+		el.dirty = true
+		return true
+	}
+	return false
+}
+
+// Get
+// en: Returns the widget with the id, or nil
+//
+// pt_br: Retorna o widget com o id, ou nil
+func (el *Toolkit) Get(id string) Widget {
+	for _, widget := range el.widgets {
+		if widget.GetId() == id {
+			return widget
+		}
+	}
+	return nil
+}
+
+// GetWidgets
+// en: Returns the widgets in drawing order
+//
+// pt_br: Retorna os widgets na ordem de desenho
+func (el *Toolkit) GetWidgets() []Widget {
+	return append(make([]Widget, 0, len(el.widgets)), el.widgets...)
+}
+
+// GetFocus
+// en: Returns the focused widget, or nil
+//
+// pt_br: Retorna o widget com foco, ou nil
+func (el *Toolkit) GetFocus() Widget {
+	return el.focus
+}
+
+// SetFocus
+// en: Moves the focus to the widget with the id; an empty id removes the focus.
+// Returns false when the widget does not exist or does not accept the focus
+//
+// pt_br: Move o foco para o widget com o id; um id vazio remove o foco. Retorna
+// false quando o widget não existe ou não aceita o foco
+func (el *Toolkit) SetFocus(id string) bool {
+	if id == "" {
+		el.setFocus(nil)
+		return true
+	}
+	widget := el.Get(id)
+	if widget == nil || !widget.Focusable() || !widget.GetEnabled() {
+		return false
+	}
+	el.setFocus(widget)
+	return true
+}
+
+// setFocus
+// en: Moves the focus, calling Blur() of the widget that loses it
+//
+// pt_br: Move o foco, chamando Blur() do widget que o perde
+func (el *Toolkit) setFocus(widget Widget) {
+	if el.focus == widget {
+		return
+	}
+	previous := el.focus
+	el.focus = widget
+	if previous != nil {
+		previous.Blur(el.context())
+	}
+	el.dirty = true
+}
+
+// FocusNext
+// en: Moves the focus to the next enabled widget that accepts it, wrapping
+// around; Tab calls it
+//
+// pt_br: Move o foco para o próximo widget habilitado que o aceita, voltando ao
+// início; Tab a chama
+func (el *Toolkit) FocusNext() {
+	el.moveFocus(1)
+}
+
+// FocusPrevious
+// en: Moves the focus to the previous enabled widget that accepts it, wrapping
+// around; Shift+Tab calls it
+//
+// pt_br: Move o foco para o widget habilitado anterior que o aceita, voltando ao
+// fim; Shift+Tab a chama
+func (el *Toolkit) FocusPrevious() {
+	el.moveFocus(-1)
+}
+
+// moveFocus
+// en: Moves the focus in the direction given
+//
+// pt_br: Move o foco na direção dada
+func (el *Toolkit) moveFocus(direction int) {
+	count := len(el.widgets)
+	if count == 0 {
+		return
+	}
+	start := -1
+	if direction < 0 {
+		start = count
+	}
+	for i, widget := range el.widgets {
+		if widget == el.focus {
+			start = i
+		}
+	}
+	for step := 1; step <= count; step += 1 {
+		widget := el.widgets[((start+direction*step)%count+count)%count]
+		if widget.Focusable() && widget.GetEnabled() {
+			el.setFocus(widget)
+			return
+		}
+	}
+}
+
+// hit
+// en: Returns the widget under the point: open overlays first, then the widgets
+// from the top
+//
+// pt_br: Retorna o widget sob o ponto: sobreposições abertas primeiro, depois os
+// widgets a partir do topo
+func (el *Toolkit) hit(x, y float64) Widget {
+	context := el.context()
+	for i := len(el.widgets) - 1; i >= 0; i -= 1 {
+		if overlay, ok := el.widgets[i].(Overlay); ok {
+			if bounds, open := overlay.GetOverlay(context); open && bounds.ContainsPoint(x, y) {
+				return el.widgets[i]
+			}
+		}
+	}
+	for i := len(el.widgets) - 1; i >= 0; i -= 1 {
+		if el.widgets[i].Contains(x, y) {
+			return el.widgets[i]
+		}
+	}
+	return nil
+}
+
+// updateCursor
+// en: Sets the cursor of the platform for the widget under the pointer, only
+// when it changes
+//
+// pt_br: Define o cursor da plataforma para o widget sob o ponteiro, apenas quando
+// ele muda
+func (el *Toolkit) updateCursor(widget Widget, x, y float64) {
+	role := KCursorDefault
+	switch {
+	case widget == nil:
+	case !widget.GetEnabled():
+		role = KCursorDisabled
+	default:
+		role = widget.Cursor(x, y)
+	}
+	cursor, found := el.theme.Cursors[role]
+	if !found {
+		cursor = el.initial
+	}
+	if cursor != el.cursor {
+		el.cursor = cursor
+		el.platform.SetMouseCursor(cursor)
+	}
+}
+
+// PointerMove
+// en: Receives the position of the pointer; a pressed widget receives every move,
+// as a drag, until PointerUp()
+//
+// pt_br: Recebe a posição do ponteiro; um widget pressionado recebe todo
+// movimento, como um arrasto, até PointerUp()
+func (el *Toolkit) PointerMove(x, y float64) {
+	el.pointerX, el.pointerY = x, y
+	target := el.hit(x, y)
+	if target != el.hover {
+		el.hover = target
+		el.dirty = true
+	}
+	el.updateCursor(target, x, y)
+
+	switch {
+	case el.pressed != nil:
+		el.pressed.PointerMove(el.context(), x, y, true)
+		el.dirty = true
+	case target != nil && target.GetEnabled():
+		target.PointerMove(el.context(), x, y, false)
+	}
+}
+
+// PointerDown
+// en: Receives a press of the main button; the focus moves to the widget under
+// the pointer, or is removed when the widget does not accept it
+//
+// pt_br: Recebe um clique do botão principal; o foco vai para o widget sob o
+// ponteiro, ou é removido quando o widget não o aceita
+func (el *Toolkit) PointerDown(x, y float64) {
+	el.pointerX, el.pointerY = x, y
+	target := el.hit(x, y)
+	el.hover = target
+	el.dirty = true
+	if target == nil || !target.GetEnabled() {
+		el.setFocus(nil)
+		return
+	}
+	if target.Focusable() {
+		el.setFocus(target)
+	} else {
+		el.setFocus(nil)
+	}
+	el.pressed = target
+	target.PointerDown(el.context(), x, y)
+}
+
+// PointerUp
+// en: Receives the release of the main button; the pressed widget is told whether
+// the pointer is still over it, which completes a click
+//
+// pt_br: Recebe a soltura do botão principal; o widget pressionado é informado se
+// o ponteiro ainda está sobre ele, o que completa um clique
+func (el *Toolkit) PointerUp(x, y float64) {
+	el.pointerX, el.pointerY = x, y
+	pressed := el.pressed
+	el.pressed = nil
+	if pressed == nil {
+		return
+	}
+	pressed.PointerUp(el.context(), x, y, el.hit(x, y) == pressed)
+	el.hover = el.hit(x, y)
+	el.updateCursor(el.hover, x, y)
+	el.dirty = true
+}
+
+// KeyDown
+// en: Sends the key to the focused widget; keys it does not handle move the focus
+// with Tab and Shift+Tab. Returns true when the key was used, so the application
+// can prevent the default action of the browser
+//
+// pt_br: Envia a tecla ao widget com foco; teclas que ele não trata movem o foco
+// com Tab e Shift+Tab. Retorna true quando a tecla foi usada, assim a aplicação
+// pode impedir a ação padrão do navegador
+func (el *Toolkit) KeyDown(event KeyEvent) bool {
+	if el.focus != nil && el.focus.KeyDown(el.context(), event) {
+		el.dirty = true
+		return true
+	}
+	if event.Key != KKeyTab || event.Control || event.Alt || event.Meta {
+		return false
+	}
+	if event.Shift {
+		el.FocusPrevious()
+	} else {
+		el.FocusNext()
+	}
+	return true
+}
+
+// TextInput
+// en: Sends typed text to the focused widget, like the data of the DOM input
+// event or the key of a KeyEvent that is a single character
+//
+// pt_br: Envia texto digitado ao widget com foco, como o data do evento input do
+// DOM ou a key de um KeyEvent que é um único caractere
+func (el *Toolkit) TextInput(text string) {
+	if el.focus != nil && text != "" {
+		el.focus.TextInput(el.context(), text)
+		el.dirty = true
+	}
+}
+
+// Invalidate
+// en: Asks for a redraw
+//
+// pt_br: Pede um redesenho
+func (el *Toolkit) Invalidate() {
+	el.dirty = true
+}
+
+// NeedsRedraw
+// en: Returns true when something changed since the last Draw()
+//
+// pt_br: Retorna true quando algo mudou desde o último Draw()
+func (el *Toolkit) NeedsRedraw() bool {
+	return el.dirty
+}
+
+// state
+// en: Returns the state of the widget
+//
+// pt_br: Retorna o estado do widget
+func (el *Toolkit) state(widget Widget) (state State) {
+	if !widget.GetEnabled() {
+		return KStateDisabled
+	}
+	if widget == el.hover {
+		state |= KStateHover
+		if widget == el.pressed {
+			state |= KStatePressed
+		}
+	}
+	if widget == el.focus {
+		state |= KStateFocused
+	}
+	return
+}
+
+// Draw
+// en: Draws the widgets in order and then the open overlays. The background is
+// not cleared, the application draws it first
+//
+// pt_br: Desenha os widgets em ordem e depois as sobreposições abertas. O fundo
+// não é limpo, a aplicação o desenha antes
+func (el *Toolkit) Draw() {
+	context := el.context()
+	for _, widget := range el.widgets {
+		el.platform.Save()
+		context.ApplyFont()
+		widget.Draw(context, el.state(widget))
+		el.platform.Restore()
+	}
+	for _, widget := range el.widgets {
+		overlay, ok := widget.(Overlay)
+		if !ok {
+			continue
+		}
+		if _, open := overlay.GetOverlay(context); open {
+			el.platform.Save()
+			context.ApplyFont()
+			overlay.DrawOverlay(context, el.state(widget))
+			el.platform.Restore()
+		}
+	}
+	el.dirty = false
+}
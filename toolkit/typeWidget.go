@@ -0,0 +1,339 @@
+package toolkit
+
+import (
+	"image/color"
+
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/collision"
+	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/browserMouse"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+)
+
+// Platform
+// en: Methods of IDraw used by the toolkit; any IDraw implementation works, so
+// the widgets run in every backend
+//
+// pt_br: Métodos de IDraw usados pelo toolkit; qualquer implementação de IDraw
+// funciona, assim os widgets rodam em todo backend
+type Platform interface {
+	BeginPath()
+	MoveTo(x, y interface{})
+	LineTo(x, y interface{})
+	ClosePath(x, y interface{})
+	Fill()
+	Stroke()
+	SetFillStyle(value interface{})
+	SetStrokeStyle(value interface{})
+	SetLineWidth(value interface{})
+	Font(font font.Font)
+	MeasureText(text string) iotmakerPlatformTextMetrics.TextMetrics
+	FillText(text string, x, y int, maxWidth ...int)
+	Save()
+	Restore()
+	SetMouseCursor(cursor browserMouse.CursorType)
+	GetMouseCursor() browserMouse.CursorType
+}
+
+// State
+// en: Interaction state of a widget when it is drawn, as bit flags
+//
+// pt_br: Estado de interação de um widget quando ele é desenhado, como bits
+type State int
+
+const (
+	// KStateHover
+	// en: The pointer is over the widget
+	//
+	// pt_br: O ponteiro está sobre o widget
+	KStateHover State = 1 << iota
+
+	// KStatePressed
+	// en: The widget was pressed and the pointer is still over it
+	//
+	// pt_br: O widget foi pressionado e o ponteiro ainda está sobre ele
+	KStatePressed
+
+	// KStateFocused
+	// en: The widget receives the keyboard events
+	//
+	// pt_br: O widget recebe os eventos de teclado
+	KStateFocused
+
+	// KStateDisabled
+	// en: The widget ignores events
+	//
+	// pt_br: O widget ignora eventos
+	KStateDisabled
+)
+
+// Has
+// en: Returns true when the flag is set
+//
+// pt_br: Retorna true quando o bit está ligado
+func (el State) Has(flag State) bool {
+	return el&flag != 0
+}
+
+// Widget
+// en: Retained element of the interface. Custom widgets embed Base, which
+// provides every method except Draw() and links the widget to its toolkit
+//
+// pt_br: Elemento retido da interface. Widgets personalizados incorporam Base,
+// que fornece todos os métodos exceto Draw() e liga o widget ao seu toolkit
+type Widget interface {
+	GetId() string
+	GetBounds() collision.AABB
+	SetBounds(bounds collision.AABB)
+	GetEnabled() bool
+	SetEnabled(enabled bool)
+
+	// Focusable
+	// en: Returns true when the widget accepts the keyboard focus
+	//
+	// pt_br: Retorna true quando o widget aceita o foco do teclado
+	Focusable() bool
+
+	// Cursor
+	// en: Returns the role of the cursor over the point
+	//
+	// pt_br: Retorna o papel do cursor sobre o ponto
+	Cursor(x, y float64) Cursor
+
+	// Contains
+	// en: Returns true when the point hits the widget
+	//
+	// pt_br: Retorna true quando o ponto atinge o widget
+	Contains(x, y float64) bool
+
+	Draw(context *Context, state State)
+	PointerDown(context *Context, x, y float64)
+	PointerMove(context *Context, x, y float64, pressed bool)
+	PointerUp(context *Context, x, y float64, inside bool)
+
+	// KeyDown
+	// en: Receives a key of the focused widget; returns false to let the toolkit
+	// handle it, like Tab
+	//
+	// pt_br: Recebe uma tecla do widget com foco; retorna false para deixar o
+	// toolkit tratá-la, como Tab
+	KeyDown(context *Context, event KeyEvent) bool
+	TextInput(context *Context, text string)
+	Blur(context *Context)
+
+	base() *Base
+}
+
+// Overlay
+// en: Optional interface of widgets that draw over the others, like the open list
+// of a dropdown. The overlay is drawn last and receives the pointer first
+//
+// pt_br: Interface opcional de widgets que desenham sobre os outros, como a lista
+// aberta de um dropdown. A sobreposição é desenhada por último e recebe o
+// ponteiro primeiro
+type Overlay interface {
+	GetOverlay(context *Context) (bounds collision.AABB, open bool)
+	DrawOverlay(context *Context, state State)
+}
+
+// Base
+// en: Identity, bounds and default behavior of a widget, to be embedded
+//
+// pt_br: Identidade, limites e comportamento padrão de um widget, para ser
+// incorporado
+type Base struct {
+	id       string
+	bounds   collision.AABB
+	disabled bool
+	toolkit  *Toolkit
+}
+
+// NewBase
+// en: Returns the base of a widget with the id and the bounds given
+//
+// pt_br: Retorna a base de um widget com o id e os limites dados
+func NewBase(id string, bounds collision.AABB) Base {
+	return Base{id: id, bounds: bounds}
+}
+
+func (el *Base) base() *Base {
+	return el
+}
+
+// GetId
+// en: Returns the id of the widget
+//
+// pt_br: Retorna o id do widget
+func (el *Base) GetId() string {
+	return el.id
+}
+
+// GetBounds
+// en: Returns the rectangle of the widget
+//
+// pt_br: Retorna o retângulo do widget
+func (el *Base) GetBounds() collision.AABB {
+	return el.bounds
+}
+
+// SetBounds
+// en: Moves or resizes the widget
+//
+// pt_br: Move ou redimensiona o widget
+func (el *Base) SetBounds(bounds collision.AABB) {
+	el.bounds = bounds
+	el.Invalidate()
+}
+
+// GetEnabled
+// en: Returns false when the widget is disabled
+//
+// pt_br: Retorna false quando o widget está desabilitado
+func (el *Base) GetEnabled() bool {
+	return !el.disabled
+}
+
+// SetEnabled
+// en: Enables or disables the widget; a disabled widget loses the focus
+//
+// pt_br: Habilita ou desabilita o widget; um widget desabilitado perde o foco
+func (el *Base) SetEnabled(enabled bool) {
+	el.disabled = !enabled
+	if el.toolkit != nil && !enabled && el.toolkit.focus != nil && el.toolkit.focus.base() == el {
+		el.toolkit.setFocus(nil)
+	}
+	el.Invalidate()
+}
+
+// Invalidate
+// en: Asks the toolkit to redraw; setters of the widgets call it
+//
+// pt_br: Pede ao toolkit para redesenhar; os setters dos widgets a chamam
+func (el *Base) Invalidate() {
+	if el.toolkit != nil {
+		el.toolkit.dirty = true
+	}
+}
+
+// Focusable
+// en: Widgets accept the focus by default
+//
+// pt_br: Widgets aceitam o foco por padrão
+func (el *Base) Focusable() bool {
+	return true
+}
+
+// Cursor
+// en: Widgets show the pointer cursor by default
+//
+// pt_br: Widgets mostram o cursor de ponteiro por padrão
+func (el *Base) Cursor(x, y float64) Cursor {
+	return KCursorPointer
+}
+
+// Contains
+// en: Tests the point against the bounds
+//
+// pt_br: Testa o ponto contra os limites
+func (el *Base) Contains(x, y float64) bool {
+	return el.bounds.ContainsPoint(x, y)
+}
+
+// PointerDown
+// en: Ignores the event
+//
+// pt_br: Ignora o evento
+func (el *Base) PointerDown(context *Context, x, y float64) {}
+
+// PointerMove
+// en: Ignores the event
+//
+// pt_br: Ignora o evento
+func (el *Base) PointerMove(context *Context, x, y float64, pressed bool) {}
+
+// PointerUp
+// en: Ignores the event
+//
+// pt_br: Ignora o evento
+func (el *Base) PointerUp(context *Context, x, y float64, inside bool) {}
+
+// KeyDown
+// en: Leaves every key to the toolkit
+//
+// pt_br: Deixa toda tecla para o toolkit
+func (el *Base) KeyDown(context *Context, event KeyEvent) bool {
+	return false
+}
+
+// TextInput
+// en: Ignores the text
+//
+// pt_br: Ignora o texto
+func (el *Base) TextInput(context *Context, text string) {}
+
+// Blur
+// en: Ignores the loss of focus
+//
+// pt_br: Ignora a perda de foco
+func (el *Base) Blur(context *Context) {}
+
+// Context
+// en: Platform and theme given to the widgets while they draw and handle events
+//
+// pt_br: Plataforma e tema dados aos widgets enquanto desenham e tratam eventos
+type Context struct {
+	Platform Platform
+	Theme    *Theme
+}
+
+// ApplyFont
+// en: Sets the font of the theme, when defined; call it inside Save() and
+// Restore()
+//
+// pt_br: Define a fonte do tema, quando definida; chame-a dentro de Save() e
+// Restore()
+func (el *Context) ApplyFont() {
+	if el.Theme.Font != nil {
+		el.Platform.Font(*el.Theme.Font)
+	}
+}
+
+// MeasureText
+// en: Returns the width of the text in the font of the theme
+//
+// pt_br: Retorna a largura do texto na fonte do tema
+func (el *Context) MeasureText(text string) float64 {
+	if text == "" {
+		return 0
+	}
+	el.Platform.Save()
+	defer el.Platform.Restore()
+	el.ApplyFont()
+	return el.Platform.MeasureText(text).Width
+}
+
+// textColor
+// en: Returns the text color of the theme for the state
+//
+// pt_br: Retorna a cor de texto do tema para o estado
+func (el *Context) textColor(state State) color.RGBA {
+	if state.Has(KStateDisabled) {
+		return el.Theme.DisabledTextColor
+	}
+	return el.Theme.TextColor
+}
+
+// surfaceColor
+// en: Returns the background color of the theme for the state
+//
+// pt_br: Retorna a cor de fundo do tema para o estado
+func (el *Context) surfaceColor(state State) color.RGBA {
+	switch {
+	case state.Has(KStateDisabled):
+		return el.Theme.SurfaceColor
+	case state.Has(KStatePressed):
+		return el.Theme.PressedColor
+	case state.Has(KStateHover):
+		return el.Theme.HoverColor
+	}
+	return el.Theme.SurfaceColor
+}
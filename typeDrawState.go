@@ -0,0 +1,155 @@
+package iotmaker_platform_IDraw
+
+import (
+	"fmt"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/bidi"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/cssFilter"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
+	"image/color"
+	"reflect"
+	"strings"
+)
+
+// DrawState
+// en: Complete drawing state of a context, the same properties saved by Save() and
+// restored by Restore(). Use IDraw.GetState() to read the current state as a value
+// and IDraw.SetState() to apply it back
+//
+// pt_br: Estado completo de desenho de um contexto, as mesmas propriedades salvas
+// por Save() e restauradas por Restore(). Use IDraw.GetState() para ler o estado
+// atual como um valor e IDraw.SetState() para aplicá-lo de volta
+type DrawState struct {
+	// en: color.RGBA{} struct, gradient or pattern
+	// pt_br: struct color.RGBA{}, gradiente ou padrão
+	FillStyle interface{}
+
+	// en: color.RGBA{} struct, gradient or pattern
+	// pt_br: struct color.RGBA{}, gradiente ou padrão
+	StrokeStyle interface{}
+
+	LineWidth     int
+	ShadowBlur    int
+	ShadowColor   color.RGBA
+	ShadowOffsetX int
+	ShadowOffsetY int
+	Font          font.Font
+
+	// en: Value between 0.0 (fully transparent) and 1.0 (fully opaque)
+	// pt_br: Valor entre 0.0 (totalmente transparente) e 1.0 (totalmente opaco)
+	GlobalAlpha float64
+
+	// en: Filter effects, an empty list means "none"
+	// pt_br: Efeitos de filtro, uma lista vazia significa "none"
+	Filter cssFilter.List
+
+	// en: Base direction of the text
+	// pt_br: Direção base do texto
+	Direction bidi.Direction
+
+	// en: a, b, c, d, e, f values of the current transformation matrix, in logical
+	// pixels and relative to the base scale of IDraw.SetDevicePixelRatio()
+	// pt_br: valores a, b, c, d, e, f da matriz de transformação atual, em pixels
+	// lógicos e relativa à escala base de IDraw.SetDevicePixelRatio()
+	Transform [6]float64
+}
+
+// DrawStateDifference
+// en: Property with different values in two DrawState, returned by DrawState.Diff()
+//
+// pt_br: Propriedade com valores diferentes em dois DrawState, retornada por
+// DrawState.Diff()
+type DrawStateDifference struct {
+	Name  string
+	Value interface{}
+	Other interface{}
+}
+
+// String
+// en: Returns the difference formatted as "Name: value -> other"
+//
+// pt_br: Retorna a diferença formatada como "Name: value -> other"
+func (el DrawStateDifference) String() string {
+	return fmt.Sprintf("%v: %v -> %v", el.Name, el.Value, el.Other)
+}
+
+// NewDrawState
+// en: Returns the drawing state of a new context
+//
+//	FillStyle: #000000
+//	StrokeStyle: #000000
+//	LineWidth: 1
+//	ShadowColor: #000000
+//	GlobalAlpha: 1.0
+//	Direction: bidi.KDirectionAuto
+//	Transform: identity matrix in logical pixels; the device pixel ratio is kept
+//
+// pt_br: Retorna o estado de desenho de um novo contexto
+//
+//	FillStyle: #000000
+//	StrokeStyle: #000000
+//	LineWidth: 1
+//	ShadowColor: #000000
+//	GlobalAlpha: 1.0
+//	Direction: bidi.KDirectionAuto
+//	Transform: matriz identidade em pixels lógicos; a razão de pixels do
+//	           dispositivo é mantida
+func NewDrawState() DrawState {
+	return DrawState{
+		FillStyle:   color.RGBA{A: 255},
+		StrokeStyle: color.RGBA{A: 255},
+		LineWidth:   1,
+		ShadowColor: color.RGBA{A: 255},
+		GlobalAlpha: 1,
+		Direction:   bidi.KDirectionAuto,
+		Transform:   [6]float64{1, 0, 0, 1, 0, 0},
+	}
+}
+
+// Diff
+// en: Returns the list of properties with different values in the two states, in
+// the order they are declared in DrawState. An empty list means equal states
+//
+// pt_br: Retorna a lista de propriedades com valores diferentes nos dois estados,
+// na ordem em que são declaradas em DrawState. Uma lista vazia significa estados
+// iguais
+func (el DrawState) Diff(other DrawState) []DrawStateDifference {
+	list := make([]DrawStateDifference, 0)
+	value := reflect.ValueOf(el)
+	otherValue := reflect.ValueOf(other)
+	for i := 0; i < value.NumField(); i += 1 {
+		field := value.Field(i).Interface()
+		otherField := otherValue.Field(i).Interface()
+		if reflect.DeepEqual(field, otherField) {
+			continue
+		}
+		list = append(list, DrawStateDifference{
+			Name:  value.Type().Field(i).Name,
+			Value: field,
+			Other: otherField,
+		})
+	}
+	return list
+}
+
+// Equal
+// en: Returns true if the two states have the same values
+//
+// pt_br: Retorna true se os dois estados têm os mesmos valores
+func (el DrawState) Equal(other DrawState) bool {
+	return len(el.Diff(other)) == 0
+}
+
+// String
+// en: Returns every property in the format "Name: value", one per line, for debug
+// tools
+//
+// pt_br: Retorna todas as propriedades no formato "Name: value", uma por linha,
+// para ferramentas de depuração
+func (el DrawState) String() string {
+	var builder strings.Builder
+	value := reflect.ValueOf(el)
+	for i := 0; i < value.NumField(); i += 1 {
+		builder.WriteString(fmt.Sprintf("%v: %v\n", value.Type().Field(i).Name, value.Field(i).Interface()))
+	}
+	return builder.String()
+}
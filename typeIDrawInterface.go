@@ -1,6 +1,8 @@
 package iotmaker_platform_IDraw
 
 import (
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/bidi"
+	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.IDraw/cssFilter"
 	iotmakerPlatformTextMetrics "github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.textMetrics"
 	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/browserMouse"
 	"github.com/helmutkemper/iotmaker.santa_isabel_theater.platform.webbrowser/font"
@@ -172,6 +174,14 @@ type IDraw interface {
 	//     Valor padrão: #000000
 	SetShadowColor(value color.RGBA)
 
+	// GetShadowColor
+	// en: Returns the color used for shadows
+	//     Default value: #000000
+	//
+	// pt_br: Retorna a cor da sombra
+	//     Valor padrão: #000000
+	GetShadowColor() color.RGBA
+
 	// ShadowOffsetX
 	// en: Sets the horizontal distance of the shadow from the shape
 	//     shadowOffsetX = 0 indicates that the shadow is right behind the shape.
@@ -194,6 +204,14 @@ type IDraw interface {
 	//     Valor padrão: 0
 	ShadowOffsetX(value int)
 
+	// GetShadowOffsetX
+	// en: Returns the horizontal distance of the shadow from the shape
+	//     Default value: 0
+	//
+	// pt_br: Retorna a distância horizontal entre a forma e a sua sombra
+	//     Valor padrão: 0
+	GetShadowOffsetX() int
+
 	// ShadowOffsetY
 	// en: Sets or returns the vertical distance of the shadow from the shape
 	//     The shadowOffsetY property sets or returns the vertical distance of the
@@ -218,6 +236,14 @@ type IDraw interface {
 	//     Valor padrão: 0
 	ShadowOffsetY(value int)
 
+	// GetShadowOffsetY
+	// en: Returns the vertical distance of the shadow from the shape
+	//     Default value: 0
+	//
+	// pt_br: Retorna a distância vertical entre a forma e a sua sombra
+	//     Valor padrão: 0
+	GetShadowOffsetY() int
+
 	//AddColorStopPosition
 	// en: Specifies the colors and stop positions in a gradient object
 	//     gradient: A gradient object created by CreateLinearGradient() or
@@ -333,6 +359,14 @@ type IDraw interface {
 	//     Valor padrão: #000000
 	SetFillStyle(value interface{})
 
+	// GetFillStyle
+	// en: Returns the color, gradient, or pattern used to fill the drawing
+	//     Default value: color.RGBA{A: 255}
+	//
+	// pt_br: Retorna a cor, gradiente ou padrão usado para preencher o desenho
+	//     Valor padrão: color.RGBA{A: 255}
+	GetFillStyle() interface{}
+
 	// SetStrokeStyle
 	// en: Sets the color, gradient, or pattern used for strokes
 	//     value: a valid JavaScript value or a color.RGBA{} struct
@@ -343,6 +377,14 @@ type IDraw interface {
 	//     Valor padrão: #000000
 	SetStrokeStyle(value interface{})
 
+	// GetStrokeStyle
+	// en: Returns the color, gradient, or pattern used for strokes
+	//     Default value: color.RGBA{A: 255}
+	//
+	// pt_br: Retorna a cor, gradiente ou padrão usado para o contorno
+	//     Valor padrão: color.RGBA{A: 255}
+	GetStrokeStyle() interface{}
+
 	// en: Returns an ImageData map[x][y]color.RGBA that copies the pixel data for the
 	// specified rectangle on a canvas
 	//     x: The x coordinate (in pixels) of the upper-left corner to start copy from
@@ -368,6 +410,11 @@ type IDraw interface {
 	//     map[x][y], you can copy the image data back onto the canvas with the
 	//     putImageData() method.
 	//
+	//     Note: x, y, width, height and the returned map use logical pixels. When the
+	//     device pixel ratio is not 1, each returned pixel is the average of the
+	//     physical pixels it covers. Use GetImageDataPhysical() for the raw backing
+	//     store
+	//
 	// pr_br: Retorna um mapa map[x][y]color.RGBA com parte dos dados da imagem contida
 	// no retângulo especificado.
 	//     x: Coordenada x (em pixels) do canto superior esquerdo de onde os dados vão
@@ -393,6 +440,11 @@ type IDraw interface {
 	//
 	//     Dica: Depois de manipular as informações de cor/alpha contidas no map[x][y],
 	//     elas podem ser colocadas de volta no canvas com o método putImageData().
+	//
+	//     Nota: x, y, width, height e o mapa retornado usam pixels lógicos. Quando a
+	//     razão de pixels do dispositivo não é 1, cada pixel retornado é a média dos
+	//     pixels físicos que ele cobre. Use GetImageDataPhysical() para ler a memória
+	//     de imagem sem conversão
 	GetImageData(x, y, width, height int) map[int]map[int]color.RGBA
 
 	// GetImageDataJsValue
@@ -709,6 +761,12 @@ type IDraw interface {
 	// pt_br: Define as propriedades da fonte atual
 	Font(font font.Font)
 
+	// GetFont
+	// en: Returns the current font properties for text content
+	//
+	// pt_br: Retorna as propriedades da fonte atual
+	GetFont() font.Font
+
 	// MeasureText
 	// en: Returns a struct TextMetrics that contains the width of the specified text
 	//     text: The text to be measured
@@ -722,6 +780,130 @@ type IDraw interface {
 	ResetShadow()
 	ResetLineWidth()
 	SetMouseCursor(cursor browserMouse.CursorType)
+	GetMouseCursor() browserMouse.CursorType
+
+	// SetGlobalAlpha
+	// en: Sets the alpha (transparency) value applied to everything drawn after
+	// this call
+	//     value: A number between 0.0 (fully transparent) and 1.0 (fully opaque)
+	//     Default value: 1.0
+	//     JavaScript syntax: context.globalAlpha = number;
+	//
+	// pt_br: Define o valor alpha (transparência) aplicado a tudo que for desenhado
+	// após esta chamada
+	//     value: Um número entre 0.0 (totalmente transparente) e 1.0 (totalmente
+	//     opaco)
+	//     Valor padrão: 1.0
+	//     Sintaxe JavaScript: context.globalAlpha = número;
+	SetGlobalAlpha(value float64)
+
+	// GetGlobalAlpha
+	// en: Returns the current alpha (transparency) value
+	//     Default value: 1.0
+	//
+	// pt_br: Retorna o valor alpha (transparência) atual
+	//     Valor padrão: 1.0
+	GetGlobalAlpha() float64
+
+	// SetFilter
+	// en: Sets the filter effects, like blur and grayscale, applied to everything
+	// drawn after this call
+	//     filter: Ordered list of filter functions. Use cssFilter.Parse() to create
+	//             the list from a CSS filter string
+	//     Default value: empty list, "none"
+	//     JavaScript syntax: context.filter = "grayscale(100%) blur(2px)";
+	//
+	//     Note: Web browsers apply the list natively. Platforms without native
+	//     support apply it in software with cssFilter.List.Apply()
+	//
+	// pt_br: Define os efeitos de filtro, como borrão e escala de cinza, aplicados a
+	// tudo que for desenhado após esta chamada
+	//     filter: Lista ordenada de funções de filtro. Use cssFilter.Parse() para
+	//             criar a lista a partir de uma string de filtro CSS
+	//     Valor padrão: lista vazia, "none"
+	//     Sintaxe JavaScript: context.filter = "grayscale(100%) blur(2px)";
+	//
+	//     Nota: Navegadores web aplicam a lista nativamente. Plataformas sem suporte
+	//     nativo a aplicam em software com cssFilter.List.Apply()
+	SetFilter(filter cssFilter.List)
+
+	// GetFilter
+	// en: Returns the current filter effects
+	//     Default value: empty list, "none"
+	//
+	// pt_br: Retorna os efeitos de filtro atuais
+	//     Valor padrão: lista vazia, "none"
+	GetFilter() cssFilter.List
+
+	// SetDirection
+	// en: Sets the base direction used to draw and measure text
+	//     direction: bidi.KDirectionLTR, bidi.KDirectionRTL or bidi.KDirectionAuto,
+	//                the "inherit" value of the canvas
+	//     Default value: bidi.KDirectionAuto
+	//     JavaScript syntax: context.direction = "ltr|rtl|inherit";
+	//
+	//     Note: Web browsers reorder and shape the text natively. Platforms without
+	//     native support use bidi.Visual() in FillText(), StrokeText() and
+	//     MeasureText()
+	//
+	// pt_br: Define a direção base usada para desenhar e medir texto
+	//     direction: bidi.KDirectionLTR, bidi.KDirectionRTL ou bidi.KDirectionAuto,
+	//                o valor "inherit" do canvas
+	//     Valor padrão: bidi.KDirectionAuto
+	//     Sintaxe JavaScript: context.direction = "ltr|rtl|inherit";
+	//
+	//     Nota: Navegadores web reordenam e aplicam o shaping no texto nativamente.
+	//     Plataformas sem suporte nativo usam bidi.Visual() em FillText(),
+	//     StrokeText() e MeasureText()
+	SetDirection(direction bidi.Direction)
+
+	// GetDirection
+	// en: Returns the base direction used to draw and measure text
+	//     Default value: bidi.KDirectionAuto
+	//
+	// pt_br: Retorna a direção base usada para desenhar e medir texto
+	//     Valor padrão: bidi.KDirectionAuto
+	GetDirection() bidi.Direction
+
+	// SetTransform
+	// en: Resets the current transformation to the identity matrix and then applies
+	// the matrix described by the arguments. The matrix is in logical pixels and is
+	// relative to the base scale of SetDevicePixelRatio(): the platform applies the
+	// base scale before the matrix, so the identity matrix means identity in
+	// logical pixels and an absolute matrix never drops the device pixel ratio
+	//     a: Horizontal scaling
+	//     b: Vertical skewing
+	//     c: Horizontal skewing
+	//     d: Vertical scaling
+	//     e: Horizontal translation
+	//     f: Vertical translation
+	//     JavaScript syntax: context.setTransform(a, b, c, d, e, f);
+	//
+	// pt_br: Reinicia a transformação atual para a matriz identidade e então aplica a
+	// matriz descrita pelos argumentos. A matriz está em pixels lógicos e é relativa
+	// à escala base de SetDevicePixelRatio(): a plataforma aplica a escala base antes
+	// da matriz, assim a matriz identidade significa identidade em pixels lógicos e
+	// uma matriz absoluta nunca perde a razão de pixels do dispositivo
+	//     a: Escala horizontal
+	//     b: Inclinação vertical
+	//     c: Inclinação horizontal
+	//     d: Escala vertical
+	//     e: Translação horizontal
+	//     f: Translação vertical
+	//     Sintaxe JavaScript: context.setTransform(a, b, c, d, e, f);
+	SetTransform(a, b, c, d, e, f float64)
+
+	// GetTransform
+	// en: Returns the a, b, c, d, e, f values of the current transformation matrix,
+	// relative to the base scale of SetDevicePixelRatio() as in SetTransform(); the
+	// base scale is not included
+	//     Default value: [6]float64{1, 0, 0, 1, 0, 0}
+	//
+	// pt_br: Retorna os valores a, b, c, d, e, f da matriz de transformação atual,
+	// relativa à escala base de SetDevicePixelRatio() como em SetTransform(); a
+	// escala base não é incluída
+	//     Valor padrão: [6]float64{1, 0, 0, 1, 0, 0}
+	GetTransform() [6]float64
 	AddEventListener(eventType interface{}, mouseMoveEvt interface{})
 	SetPixel(x, y int, pixel interface{})
 	MakePixel(pixelColor color.RGBA) interface{}
@@ -731,6 +913,82 @@ type IDraw interface {
 	NewCanvasWith2DContext(document interface{}, id string, width, height int) (canvas *canvas.Canvas)
 	GetContext() interface{}
 
+	// CreateOffscreen
+	// en: Creates a new transparent surface not attached to the document, see
+	// IOffscreenSurface
+	//     width: The width of the surface, in pixels
+	//     height: The height of the surface, in pixels
+	//
+	//     Tip: Use the offscreenPool package to reuse surfaces of the same size
+	//
+	// pt_br: Cria uma nova superfície transparente não ligada ao documento, veja
+	// IOffscreenSurface
+	//     width: Comprimento da superfície em pixels
+	//     height: Altura da superfície em pixels
+	//
+	//     Dica: Use o pacote offscreenPool para reaproveitar superfícies do mesmo
+	//     tamanho
+	CreateOffscreen(width, height int) IOffscreenSurface
+
+	// SetDevicePixelRatio
+	// en: Sets the number of physical pixels for each logical pixel. The backing
+	// store of the canvas is resized to the logical size multiplied by ratio and the
+	// context is scaled, so all drawing methods keep working with logical pixels and
+	// the result is sharp on high density displays. The scale is a base under the
+	// current transformation: SetTransform(), GetTransform() and
+	// DrawState.Transform do not include it and keep their values when the ratio
+	// changes
+	//     ratio: Normally the value of window.devicePixelRatio
+	//     Default value: 1
+	//
+	//     Tip: Use the pixelRatio package to convert coordinates between logical and
+	//     physical pixels
+	//
+	// pt_br: Define o número de pixels físicos para cada pixel lógico. A memória de
+	// imagem do canvas é redimensionada para o tamanho lógico multiplicado por ratio e
+	// o contexto é escalado, assim todos os métodos de desenho continuam trabalhando
+	// com pixels lógicos e o resultado fica nítido em telas de alta densidade. A
+	// escala é uma base sob a transformação atual: SetTransform(), GetTransform() e
+	// DrawState.Transform não a incluem e mantêm os seus valores quando a razão muda
+	//     ratio: Normalmente o valor de window.devicePixelRatio
+	//     Valor padrão: 1
+	//
+	//     Dica: Use o pacote pixelRatio para converter coordenadas entre pixels
+	//     lógicos e físicos
+	SetDevicePixelRatio(ratio float64)
+
+	// GetDevicePixelRatio
+	// en: Returns the number of physical pixels for each logical pixel
+	//     Default value: 1
+	//
+	// pt_br: Retorna o número de pixels físicos para cada pixel lógico
+	//     Valor padrão: 1
+	GetDevicePixelRatio() float64
+
+	// GetImageDataPhysical
+	// en: Same as GetImageData(), but x, y, width, height and the returned map use
+	// physical pixels of the backing store, without averaging pixels when the device
+	// pixel ratio is not 1
+	//
+	// pt_br: Igual a GetImageData(), porém x, y, width, height e o mapa retornado usam
+	// pixels físicos da memória de imagem, sem calcular a média dos pixels quando a
+	// razão de pixels do dispositivo não é 1
+	GetImageDataPhysical(x, y, width, height int) map[int]map[int]color.RGBA
+
+	// PutImageDataPhysical
+	// en: Writes a map in the format of GetImageDataPhysical() back onto the backing
+	// store, in one call, replacing the pixels like putImageData(): the
+	// transformation, alpha and composition of the context are ignored
+	//     data: map[x][y]color.RGBA, relative to x and y
+	//     x, y, width, height: Area written, in physical pixels
+	//
+	// pt_br: Escreve um mapa no formato de GetImageDataPhysical() de volta na memória
+	// de imagem, em uma chamada, substituindo os pixels como putImageData(): a
+	// transformação, o alfa e a composição do contexto são ignorados
+	//     data: map[x][y]color.RGBA, relativo a x e y
+	//     x, y, width, height: Área escrita, em pixels físicos
+	PutImageDataPhysical(data map[int]map[int]color.RGBA, x, y, width, height int)
+
 	// Save
 	// en: Saves the state of the current context
 	//
@@ -742,4 +1000,22 @@ type IDraw interface {
 	//
 	// pt_br: Restaura o contexto e atributos previamente salvos
 	Restore()
+
+	// GetState
+	// en: Returns the complete current drawing state as a value. Unlike Save(), the
+	// state is not pushed on the context stack, so it can be inspected, compared with
+	// DrawState.Diff() and applied later with SetState()
+	//
+	// pt_br: Retorna o estado de desenho atual completo como um valor. Diferente de
+	// Save(), o estado não é empilhado no contexto, então ele pode ser inspecionado,
+	// comparado com DrawState.Diff() e aplicado depois com SetState()
+	GetState() DrawState
+
+	// SetState
+	// en: Applies every property of the drawing state. The context stack used by
+	// Save() and Restore() is not changed
+	//
+	// pt_br: Aplica todas as propriedades do estado de desenho. A pilha do contexto
+	// usada por Save() e Restore() não é alterada
+	SetState(state DrawState)
 }
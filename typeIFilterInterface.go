@@ -0,0 +1,89 @@
+package iotmaker_platform_IDraw
+
+import "image/color"
+
+// IFilter
+// en: Effect applied to the drawing of a Primitive. Filters are grouped and ordered
+// by filterChain.Chain. A filter is executed natively by PrepareFilter(), which
+// configures the platform before the drawing, or, when it also implements
+// IFilterSoftware and the platform does not support it natively, emulated in
+// software over the pixels of the drawing
+//
+// pt_br: Efeito aplicado ao desenho de um Primitive. Filtros são agrupados e
+// ordenados por filterChain.Chain. Um filtro é executado nativamente por
+// PrepareFilter(), que configura a plataforma antes do desenho, ou, quando ele
+// também implementa IFilterSoftware e a plataforma não o suporta nativamente,
+// emulado em software sobre os pixels do desenho
+type IFilter interface {
+
+	// GetName
+	// en: Returns the name of the filter, used to find it inside a chain
+	//
+	// pt_br: Retorna o nome do filtro, usado para encontrá-lo dentro de uma cadeia
+	GetName() string
+
+	// SetEnabled
+	// en: Enables or disables the filter without removing it from the chain
+	//
+	// pt_br: Habilita ou desabilita o filtro sem removê-lo da cadeia
+	SetEnabled(enabled bool)
+
+	// GetEnabled
+	// en: Returns true if the filter is enabled
+	//
+	// pt_br: Retorna true se o filtro estiver habilitado
+	GetEnabled() bool
+
+	// SetParameter
+	// en: Sets a parameter of the filter and returns an error for unknown names or
+	// invalid values
+	//
+	// pt_br: Define um parâmetro do filtro e retorna um erro para nomes
+	// desconhecidos ou valores inválidos
+	SetParameter(name string, value interface{}) (err error)
+
+	// GetParameter
+	// en: Returns the value of a parameter and false for unknown names
+	//
+	// pt_br: Retorna o valor de um parâmetro e false para nomes desconhecidos
+	GetParameter(name string) (value interface{}, found bool)
+
+	// PrepareFilter
+	// en: Configures the platform before the drawing, executing the filter natively
+	//
+	// pt_br: Configura a plataforma antes do desenho, executando o filtro
+	// nativamente
+	PrepareFilter(platform IDraw)
+}
+
+// IFilterSoftware
+// en: Filter that can be emulated in software, over the pixels of the drawing
+//
+// pt_br: Filtro que pode ser emulado em software, sobre os pixels do desenho
+type IFilterSoftware interface {
+	IFilter
+
+	// ApplyToImageData
+	// en: Changes the pixels of the drawing
+	//     data: map[x][y]color.RGBA, in the format of IDraw.GetImageData()
+	//     width: The width of the area, in pixels
+	//     height: The height of the area, in pixels
+	//
+	// pt_br: Altera os pixels do desenho
+	//     data: map[x][y]color.RGBA, no formato de IDraw.GetImageData()
+	//     width: Comprimento da área em pixels
+	//     height: Altura da área em pixels
+	ApplyToImageData(data map[int]map[int]color.RGBA, width, height int)
+}
+
+// IFilterNative
+// en: Optional interface of the platform, tells which filters are executed
+// natively. Filters implementing IFilterSoftware are emulated in software on
+// platforms without this interface
+//
+// pt_br: Interface opcional da plataforma, informa quais filtros são executados
+// nativamente. Filtros que implementam IFilterSoftware são emulados em software
+// em plataformas sem esta interface
+type IFilterNative interface {
+	SupportsNativeFilter(filter IFilter) bool
+}
@@ -0,0 +1,50 @@
+package iotmaker_platform_IDraw
+
+// IOffscreenSurface
+// en: Drawing surface not attached to the document, created by
+// IDraw.CreateOffscreen(). Use off-screen surfaces to cache expensive widgets and
+// pre-render sprite effects. All drawing methods of IDraw are available and the
+// result is drawn on the main surface with DrawImage(surface.GetImageSource(), x, y)
+//
+// pt_br: Superfície de desenho não ligada ao documento, criada por
+// IDraw.CreateOffscreen(). Use superfícies fora da tela para guardar em cache
+// widgets caros e pré-renderizar efeitos de sprites. Todos os métodos de desenho de
+// IDraw estão disponíveis e o resultado é desenhado na superfície principal com
+// DrawImage(surface.GetImageSource(), x, y)
+type IOffscreenSurface interface {
+	IDraw
+
+	// GetImageSource
+	// en: Returns the value accepted by the image parameter of DrawImage()
+	//     Golang Syntax: platform.DrawImage(surface.GetImageSource(), x, y)
+	//
+	// pt_br: Retorna o valor aceito pelo parâmetro image de DrawImage()
+	//     Golang Sintaxe: platform.DrawImage(surface.GetImageSource(), x, y)
+	GetImageSource() interface{}
+
+	// GetWidth
+	// en: Returns the width of the surface, in pixels
+	//
+	// pt_br: Retorna o comprimento da superfície em pixels
+	GetWidth() int
+
+	// GetHeight
+	// en: Returns the height of the surface, in pixels
+	//
+	// pt_br: Retorna a altura da superfície em pixels
+	GetHeight() int
+
+	// Resize
+	// en: Changes the size of the surface. The content of the surface is lost
+	//
+	// pt_br: Muda o tamanho da superfície. O conteúdo da superfície é perdido
+	Resize(width, height int)
+
+	// Release
+	// en: Frees the resources of the surface. The surface must not be used after
+	// this call
+	//
+	// pt_br: Libera os recursos da superfície. A superfície não deve ser usada após
+	// esta chamada
+	Release()
+}